@@ -6,7 +6,6 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/blobstorage/cmd/flags"
 	"github.com/taikoxyz/taiko-mono/packages/blobstorage/pkg/db/db"
 	"github.com/urfave/cli/v2"
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
@@ -22,6 +21,7 @@ type Config struct {
 	DatabasePassword        string
 	DatabaseName            string
 	DatabaseHost            string
+	DatabaseDialect         string
 	DatabaseMaxIdleConns    uint64
 	DatabaseMaxOpenConns    uint64
 	DatabaseMaxConnLifetime uint64
@@ -33,6 +33,7 @@ type Config struct {
 func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 	return &Config{
 		DatabaseHost:            c.String(flags.DatabaseHost.Name),
+		DatabaseDialect:         c.String(flags.DatabaseDialect.Name),
 		DatabaseUsername:        c.String(flags.DatabaseUsername.Name),
 		DatabasePassword:        c.String(flags.DatabasePassword.Name),
 		DatabaseName:            c.String(flags.DatabaseName.Name),
@@ -46,11 +47,12 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 				Password:        c.String(flags.DatabasePassword.Name),
 				Database:        c.String(flags.DatabaseName.Name),
 				Host:            c.String(flags.DatabaseHost.Name),
+				Dialect:         c.String(flags.DatabaseDialect.Name),
 				MaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
 				MaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
 				MaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
-				OpenFunc: func(dsn string) (*db.DB, error) {
-					gormDB, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+				OpenFunc: func(dialector gorm.Dialector) (*db.DB, error) {
+					gormDB, err := gorm.Open(dialector, &gorm.Config{
 						Logger: logger.Default.LogMode(logger.Silent),
 					})
 					if err != nil {
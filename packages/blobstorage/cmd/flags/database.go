@@ -37,6 +37,13 @@ var (
 		Category: commonCategory,
 		EnvVars:  []string{"DATABASE_NAME"},
 	}
+	DatabaseDialect = &cli.StringFlag{
+		Name:     "db.dialect",
+		Usage:    "Database dialect, one of: mysql, postgres",
+		Value:    "mysql",
+		Category: commonCategory,
+		EnvVars:  []string{"DATABASE_DIALECT"},
+	}
 	DatabaseMaxIdleConns = &cli.Uint64Flag{
 		Name:     "db.maxIdleConns",
 		Usage:    "Database max idle connections",
@@ -65,6 +72,7 @@ var DatabaseFlags = []cli.Flag{
 	DatabaseUsername,
 	DatabasePassword,
 	DatabaseName,
+	DatabaseDialect,
 	DatabaseConnMaxLifetime,
 	DatabaseMaxOpenConns,
 	DatabaseMaxIdleConns,
@@ -0,0 +1,30 @@
+-- +goose Up
+-- Postgres schema of the blobstorage, equivalent to the MySQL migrations up to 16671_create_unique_blob_hashes_table.sql.
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS blob_hashes (
+    id SERIAL PRIMARY KEY,
+    blob_hash VARCHAR(100) NOT NULL UNIQUE,
+    kzg_commitment TEXT NOT NULL,
+    blob_data TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX blob_hash_index ON blob_hashes (blob_hash);
+
+CREATE TABLE IF NOT EXISTS blocks_meta (
+    block_id BIGINT NOT NULL PRIMARY KEY,
+    blob_hash VARCHAR(100) NOT NULL,
+    emitted_block_id BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX block_id_index ON blocks_meta (block_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE blocks_meta;
+DROP TABLE blob_hashes;
+-- +goose StatementEnd
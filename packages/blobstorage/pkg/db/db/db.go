@@ -2,11 +2,12 @@ package db
 
 import (
 	"database/sql"
-	"fmt"
 	"time"
 
 	"github.com/cyberhorsey/errors"
 	"gorm.io/gorm"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db/dialect"
 )
 
 type DB struct {
@@ -32,6 +33,7 @@ var (
 )
 
 type DBConnectionOpts struct {
+	Dialect         string
 	Name            string
 	Password        string
 	Host            string
@@ -39,31 +41,22 @@ type DBConnectionOpts struct {
 	MaxIdleConns    uint64
 	MaxOpenConns    uint64
 	MaxConnLifetime uint64
-	OpenFunc        func(dsn string) (*DB, error)
+	OpenFunc        func(dialector gorm.Dialector) (*DB, error)
 }
 
 func OpenDBConnection(opts DBConnectionOpts) (*DB, error) {
-	dsn := ""
-	if opts.Password == "" {
-		dsn = fmt.Sprintf(
-			"%v@tcp(%v)/%v?charset=utf8mb4&parseTime=True&loc=%v",
-			opts.Name,
-			opts.Host,
-			opts.Database,
-			"UTC",
-		)
-	} else {
-		dsn = fmt.Sprintf(
-			"%v:%v@tcp(%v)/%v?charset=utf8mb4&parseTime=True&loc=%v",
-			opts.Name,
-			opts.Password,
-			opts.Host,
-			opts.Database,
-			"UTC",
-		)
+	dialector, err := dialect.New(dialect.Opts{
+		Dialect:  opts.Dialect,
+		Username: opts.Name,
+		Password: opts.Password,
+		Host:     opts.Host,
+		Database: opts.Database,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	db, err := opts.OpenFunc(dsn)
+	db, err := opts.OpenFunc(dialector)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,15 @@ type Account struct {
 	TransactedAt time.Time `json:"transactedAt"`
 }
 
+// FindAccountsOpts filters the accounts, the unset fields match every account. The accounts
+// are returned latest first, BeforeID is the cursor of the next page.
+type FindAccountsOpts struct {
+	Address  *string
+	BeforeID int
+	Limit    int
+}
+
 type AccountRepository interface {
 	Save(ctx context.Context, address common.Address, transactedAt time.Time) error
+	Find(ctx context.Context, opts FindAccountsOpts) ([]*Account, error)
 }
@@ -76,6 +76,16 @@ func InitFromConfig(ctx context.Context, api *API, cfg *Config) error {
 		return err
 	}
 
+	accountRepository, err := repo.NewAccountRepository(db)
+	if err != nil {
+		return err
+	}
+
+	batchRepository, err := repo.NewBatchRepository(db)
+	if err != nil {
+		return err
+	}
+
 	ethClient, err := ethclient.Dial(cfg.RPCUrl)
 	if err != nil {
 		return err
@@ -86,6 +96,8 @@ func InitFromConfig(ctx context.Context, api *API, cfg *Config) error {
 		NFTBalanceRepo:   nftBalanceRepository,
 		ERC20BalanceRepo: erc20BalanceRepository,
 		ChartRepo:        chartRepository,
+		AccountRepo:      accountRepository,
+		BatchRepo:        batchRepository,
 		Echo:             echo.New(),
 		CorsOrigins:      cfg.CORSOrigins,
 		EthClient:        ethClient,
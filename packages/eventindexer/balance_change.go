@@ -0,0 +1,48 @@
+package eventindexer
+
+import (
+	"context"
+	"time"
+)
+
+// BalanceChange is a transfer the indexer applied to the NFT or ERC20 balances. The balance
+// changes are kept as long as the indexed blocks, so the transfers of blocks orphaned by a
+// reorg can be reversed.
+type BalanceChange struct {
+	ID              int       `json:"id"`
+	ChainID         int64     `json:"chainID"`
+	BlockID         uint64    `json:"blockID"`
+	ContractType    string    `json:"contractType"`
+	ContractAddress string    `json:"contractAddress"`
+	TokenID         int64     `json:"tokenID"`
+	ERC20MetadataID int64     `json:"erc20MetadataID"`
+	FromAddress     string    `json:"fromAddress"`
+	ToAddress       string    `json:"toAddress"`
+	Amount          string    `json:"amount"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+type SaveBalanceChangeOpts struct {
+	ChainID         int64
+	BlockID         uint64
+	ContractType    string
+	ContractAddress string
+	TokenID         int64
+	ERC20MetadataID int64
+	FromAddress     string
+	ToAddress       string
+	Amount          string
+}
+
+// BalanceChangeRepository is used to interact with the balance changes in the store
+type BalanceChangeRepository interface {
+	// Apply applies the transfer to the NFT or ERC20 balances, and saves the balance change,
+	// in one transaction.
+	Apply(ctx context.Context, opts SaveBalanceChangeOpts) error
+	// FindAllAfterBlockID returns the balance changes after the given block, latest first.
+	FindAllAfterBlockID(ctx context.Context, chainID uint64, blockID uint64) ([]*BalanceChange, error)
+	// RollbackAfterBlockID reverses the transfers of the balance changes after the given block,
+	// latest first, and deletes them, in one transaction.
+	RollbackAfterBlockID(ctx context.Context, chainID uint64, blockID uint64) error
+	DeleteBeforeBlockID(ctx context.Context, chainID uint64, blockID uint64) error
+}
@@ -0,0 +1,126 @@
+package eventindexer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/morkid/paginate"
+)
+
+// Batch is the lifecycle of a proposed batch, from its BatchProposed event to the
+// BatchesProved and BatchesVerified events which proved and verified it. The proof is the
+// latest one, and the proof and verification fields are nil until they happen.
+type Batch struct {
+	ID              int        `json:"id"`
+	ChainID         int64      `json:"chainID"`
+	BatchID         int64      `json:"batchID"`
+	Proposer        string     `json:"proposer"`
+	FirstBlockID    int64      `json:"firstBlockID"`
+	LastBlockID     int64      `json:"lastBlockID"`
+	NumBlocks       int64      `json:"numBlocks"`
+	BlobCount       int64      `json:"blobCount"`
+	ProposedTxHash  string     `json:"proposedTxHash"`
+	ProposedBlockID uint64     `json:"proposedBlockID"`
+	ProposedAt      time.Time  `json:"proposedAt"`
+	ProvedTxHash    *string    `json:"provedTxHash"`
+	Prover          *string    `json:"prover"`
+	Verifier        *string    `json:"verifier"`
+	ProvedBlockID   *uint64    `json:"provedBlockID"`
+	ProvedAt        *time.Time `json:"provedAt"`
+	VerifiedTxHash  *string    `json:"verifiedTxHash"`
+	VerifiedBlockID *uint64    `json:"verifiedBlockID"`
+	VerifiedAt      *time.Time `json:"verifiedAt"`
+}
+
+// MarshalJSON adds the latencies between the stages of the batch, in seconds.
+func (b Batch) MarshalJSON() ([]byte, error) {
+	type batch Batch
+
+	return json.Marshal(struct {
+		batch
+		ProveLatency  *int64 `json:"proveLatency"`
+		VerifyLatency *int64 `json:"verifyLatency"`
+		TotalLatency  *int64 `json:"totalLatency"`
+	}{
+		batch:         batch(b),
+		ProveLatency:  latency(&b.ProposedAt, b.ProvedAt),
+		VerifyLatency: latency(b.ProvedAt, b.VerifiedAt),
+		TotalLatency:  latency(&b.ProposedAt, b.VerifiedAt),
+	})
+}
+
+func latency(from *time.Time, to *time.Time) *int64 {
+	if from == nil || to == nil {
+		return nil
+	}
+
+	seconds := int64(to.Sub(*from).Seconds())
+
+	return &seconds
+}
+
+type SaveBatchProposedOpts struct {
+	ChainID         int64
+	BatchID         int64
+	Proposer        string
+	LastBlockID     int64
+	NumBlocks       int64
+	BlobCount       int64
+	ProposedTxHash  string
+	ProposedBlockID uint64
+	ProposedAt      time.Time
+}
+
+type SaveBatchesProvedOpts struct {
+	ChainID       int64
+	BatchIDs      []int64
+	ProvedTxHash  string
+	Prover        string
+	Verifier      string
+	ProvedBlockID uint64
+	ProvedAt      time.Time
+}
+
+// SaveBatchesVerifiedOpts verifies the batch BatchID, and all the batches before it.
+type SaveBatchesVerifiedOpts struct {
+	ChainID         int64
+	BatchID         int64
+	VerifiedTxHash  string
+	VerifiedBlockID uint64
+	VerifiedAt      time.Time
+}
+
+// FindBatchesOpts filters the batches, the unset fields match every batch. StartBatchID and
+// EndBatchID are inclusive, the time windows include their start and exclude their end.
+type FindBatchesOpts struct {
+	ChainID      *int64
+	Proposer     *string
+	Prover       *string
+	StartBatchID *int64
+	EndBatchID   *int64
+	ProposedFrom *time.Time
+	ProposedTo   *time.Time
+	ProvedFrom   *time.Time
+	ProvedTo     *time.Time
+}
+
+// BatchRepository is used to interact with the batches in the store
+type BatchRepository interface {
+	SaveProposed(ctx context.Context, opts SaveBatchProposedOpts) error
+	SaveProved(ctx context.Context, opts SaveBatchesProvedOpts) error
+	SaveVerified(ctx context.Context, opts SaveBatchesVerifiedOpts) error
+	FindByBatchID(ctx context.Context, batchID int64, chainID *int64) (*Batch, error)
+	// Find returns a page of the batches, latest first.
+	Find(ctx context.Context, req *http.Request, opts FindBatchesOpts) (paginate.Page, error)
+	// FindProverStats returns the stats of the provers of the batches matching the filters,
+	// ranked by batches proven. The batches which are not proven yet are ignored.
+	FindProverStats(ctx context.Context, opts FindBatchesOpts) ([]*ProverStats, error)
+	// FindProposerStats returns the stats of the proposers of the batches matching the
+	// filters, ranked by batches proposed.
+	FindProposerStats(ctx context.Context, opts FindBatchesOpts) ([]*ProposerStats, error)
+	// RollbackAfterBlockID deletes the batches proposed after the given block, and resets
+	// their proofs and verifications which happened after it.
+	RollbackAfterBlockID(ctx context.Context, chainID uint64, blockID uint64) error
+}
@@ -5,9 +5,10 @@ import (
 )
 
 var (
-	commonCategory  = "COMMON"
-	indexerCategory = "INDEXER"
-	txmgrCategory   = "TX_MANAGER"
+	commonCategory    = "COMMON"
+	indexerCategory   = "INDEXER"
+	generatorCategory = "GENERATOR"
+	txmgrCategory     = "TX_MANAGER"
 )
 
 var (
@@ -39,6 +40,13 @@ var (
 		Category: commonCategory,
 		EnvVars:  []string{"DATABASE_NAME"},
 	}
+	DatabaseDialect = &cli.StringFlag{
+		Name:     "db.dialect",
+		Usage:    "Database dialect, one of: mysql, postgres",
+		Value:    "mysql",
+		Category: commonCategory,
+		EnvVars:  []string{"DATABASE_DIALECT"},
+	}
 )
 
 var (
@@ -88,6 +96,7 @@ var CommonFlags = []cli.Flag{
 	DatabasePassword,
 	DatabaseHost,
 	DatabaseName,
+	DatabaseDialect,
 	DatabaseMaxIdleConns,
 	DatabaseConnMaxLifetime,
 	DatabaseMaxOpenConns,
@@ -0,0 +1,57 @@
+package flags
+
+import "github.com/urfave/cli/v2"
+
+// required flags
+var (
+	GenesisDate = &cli.StringFlag{
+		Name:     "genesisDate",
+		Usage:    "Date, in the format 2006-01-02, to start generating the time series data from",
+		Required: true,
+		Category: generatorCategory,
+		EnvVars:  []string{"GENESIS_DATE"},
+	}
+)
+
+// optional flags
+var (
+	Regenerate = &cli.BoolFlag{
+		Name:     "regenerate",
+		Usage:    "Whether to delete the generated time series data and generate it again from the genesis date",
+		Required: false,
+		Category: generatorCategory,
+		EnvVars:  []string{"REGENERATE"},
+	}
+	Hourly = &cli.BoolFlag{
+		Name:     "hourly",
+		Usage:    "Whether to generate hourly time series data as well as the daily data",
+		Required: false,
+		Category: generatorCategory,
+		EnvVars:  []string{"HOURLY"},
+	}
+	TrailingPeriods = &cli.Uint64Flag{
+		Name:     "trailingPeriods",
+		Usage:    "Number of the latest complete periods generated again on each run, to include late or reorged events",
+		Value:    2,
+		Required: false,
+		Category: generatorCategory,
+		EnvVars:  []string{"TRAILING_PERIODS"},
+	}
+	GenerateInterval = &cli.Uint64Flag{
+		Name:     "generateInterval",
+		Usage:    "Interval in seconds to generate the time series data of the periods completed since the last run",
+		Value:    3600,
+		Required: false,
+		Category: generatorCategory,
+		EnvVars:  []string{"GENERATE_INTERVAL_IN_SECONDS"},
+	}
+)
+
+var GeneratorFlags = MergeFlags(CommonFlags, []cli.Flag{
+	GenesisDate,
+	// optional
+	Regenerate,
+	Hourly,
+	TrailingPeriods,
+	GenerateInterval,
+})
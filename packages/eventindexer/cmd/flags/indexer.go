@@ -81,6 +81,20 @@ var (
 		Category: indexerCategory,
 		EnvVars:  []string{"ONTAKE_FORK_HEIGHT"},
 	}
+	Confirmations = &cli.Uint64Flag{
+		Name:     "confirmations",
+		Usage:    "Number of blocks behind the head of the chain to index up to, to avoid indexing reorged blocks",
+		Value:    0,
+		Category: indexerCategory,
+		EnvVars:  []string{"CONFIRMATIONS"},
+	}
+	GenericContracts = &cli.StringFlag{
+		Name:     "genericContracts",
+		Usage:    "Path to a JSON file of the contracts, with their ABIs and events, to index without a binding",
+		Required: false,
+		Category: indexerCategory,
+		EnvVars:  []string{"GENERIC_CONTRACTS"},
+	}
 	PacayaForkHeight = &cli.Uint64Flag{
 		Name:     "pacayaForkHeight",
 		Usage:    "Block number pacaya fork height happened",
@@ -102,4 +116,6 @@ var IndexerFlags = MergeFlags(CommonFlags, []cli.Flag{
 	IndexNFTs,
 	IndexERC20s,
 	OntakeForkHeight,
+	Confirmations,
+	GenericContracts,
 })
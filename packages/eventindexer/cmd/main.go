@@ -9,6 +9,7 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer/api"
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer/cmd/flags"
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer/cmd/utils"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/generator"
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer/indexer"
 	"github.com/urfave/cli/v2"
 )
@@ -50,6 +51,13 @@ func main() {
 			Description: "Taiko indexer software",
 			Action:      utils.SubcommandAction(new(indexer.Indexer)),
 		},
+		{
+			Name:        "generator",
+			Flags:       flags.GeneratorFlags,
+			Usage:       "Starts the time series data generator software",
+			Description: "Taiko eventindexer time series data generator software",
+			Action:      utils.SubcommandAction(new(generator.Generator)),
+		},
 	}
 
 	if err := app.Run(os.Args); err != nil {
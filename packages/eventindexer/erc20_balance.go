@@ -46,6 +46,7 @@ type ERC20BalanceRepository interface {
 		address string,
 		chainID string,
 	) (paginate.Page, error)
+	Find(ctx context.Context, opts FindBalancesOpts) ([]*ERC20Balance, error)
 	FindMetadata(ctx context.Context, chainID int64, contractAddress string) (*ERC20Metadata, error)
 	CreateMetadata(
 		ctx context.Context,
@@ -77,6 +77,20 @@ type SaveEventOpts struct {
 	BatchID         *int64
 }
 
+// FindEventsOpts filters the events, the unset fields match every event. The events are
+// returned latest first, BeforeID is the cursor of the next page, and a zero Limit returns
+// all of them.
+type FindEventsOpts struct {
+	ChainID    *int64
+	Events     []string
+	Address    *string
+	BlockID    *int64
+	BatchID    *int64
+	MinBatchID *int64
+	BeforeID   int
+	Limit      int
+}
+
 type UniqueProversResponse struct {
 	Address string `json:"address"`
 	Count   int    `json:"count"`
@@ -128,4 +142,5 @@ type EventRepository interface {
 	) (uint64, error)
 	GetBlockProvenBy(ctx context.Context, blockID int) ([]*Event, error)
 	GetBlockProposedBy(ctx context.Context, blockID int) (*Event, error)
+	FindEvents(ctx context.Context, opts FindEventsOpts) ([]*Event, error)
 }
@@ -0,0 +1,142 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/contracts/bridge"
+)
+
+// onMessageInvocationSelector is the selector of the function the vaults receive their
+// bridged tokens with.
+var onMessageInvocationSelector = crypto.Keccak256([]byte("onMessageInvocation(bytes)"))[:4]
+
+var (
+	bytesType, _          = abi.NewType("bytes", "", nil)
+	addressType, _        = abi.NewType("address", "", nil)
+	uint256Type, _        = abi.NewType("uint256", "", nil)
+	bytes32Type, _        = abi.NewType("bytes32", "", nil)
+	canonicalERC20Type, _ = abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "chainId", Type: "uint64"},
+		{Name: "addr", Type: "address"},
+		{Name: "decimals", Type: "uint8"},
+		{Name: "symbol", Type: "string"},
+		{Name: "name", Type: "string"},
+	})
+
+	// onMessageInvocationArgs are the arguments of onMessageInvocation.
+	onMessageInvocationArgs = abi.Arguments{{Type: bytesType}}
+	// erc20TransferArgs are the arguments the ERC20 vault encodes a transfer with: the
+	// canonical token, the sender, the recipient, the amount, the solver fee and the
+	// solver condition.
+	erc20TransferArgs = abi.Arguments{
+		{Type: canonicalERC20Type},
+		{Type: addressType},
+		{Type: addressType},
+		{Type: uint256Type},
+		{Type: uint256Type},
+		{Type: bytes32Type},
+	}
+	// legacyERC20TransferArgs are the arguments the ERC20 vault encoded a transfer with
+	// before the solver fields were added.
+	legacyERC20TransferArgs = erc20TransferArgs[:4]
+)
+
+// canonicalERC20 is the canonical token of a bridged ERC20 transfer.
+type canonicalERC20 struct {
+	ChainId  uint64 // nolint
+	Addr     common.Address
+	Decimals uint8
+	Symbol   string
+	Name     string
+}
+
+// bridgedAmounts returns the amounts of ETH, as the zero address, and of the canonical ERC20
+// token, bridged by the message of the given MessageSent event data.
+func bridgedAmounts(data []byte) (map[common.Address]*big.Int, error) {
+	var event struct {
+		Message bridge.IBridgeMessage
+	}
+
+	if err := json.Unmarshal(data, &event); err != nil {
+		return nil, errors.Wrap(err, "json.Unmarshal")
+	}
+
+	amounts := make(map[common.Address]*big.Int)
+
+	if event.Message.Value != nil && event.Message.Value.Sign() > 0 {
+		amounts[common.Address{}] = event.Message.Value
+	}
+
+	if token, amount, ok := decodeERC20Transfer(event.Message.Data); ok && amount.Sign() > 0 {
+		if a, ok := amounts[token]; ok {
+			amount = new(big.Int).Add(a, amount)
+		}
+
+		amounts[token] = amount
+	}
+
+	return amounts, nil
+}
+
+// decodeERC20Transfer decodes the canonical token and the amount of an ERC20 vault transfer
+// from the data of a message, in the current layout or in the legacy one without the solver
+// fields. The NFT vaults encode their transfers differently, so the data must encode back to
+// itself to be an ERC20 transfer.
+func decodeERC20Transfer(data []byte) (common.Address, *big.Int, bool) {
+	if len(data) < 4 || !bytes.Equal(data[:4], onMessageInvocationSelector) {
+		return common.Address{}, nil, false
+	}
+
+	args, err := onMessageInvocationArgs.Unpack(data[4:])
+	if err != nil {
+		return common.Address{}, nil, false
+	}
+
+	transfer, ok := args[0].([]byte)
+	if !ok {
+		return common.Address{}, nil, false
+	}
+
+	values, ok := unpackExact(erc20TransferArgs, transfer)
+	if !ok {
+		values, ok = unpackExact(legacyERC20TransferArgs, transfer)
+	}
+
+	if !ok {
+		return common.Address{}, nil, false
+	}
+
+	token, ok := abi.ConvertType(values[0], new(canonicalERC20)).(*canonicalERC20)
+	if !ok {
+		return common.Address{}, nil, false
+	}
+
+	amount, ok := values[3].(*big.Int)
+	if !ok {
+		return common.Address{}, nil, false
+	}
+
+	return token.Addr, amount, true
+}
+
+// unpackExact unpacks the given data with the given arguments, if it is exactly their encoding.
+func unpackExact(args abi.Arguments, data []byte) ([]interface{}, bool) {
+	values, err := args.Unpack(data)
+	if err != nil {
+		return nil, false
+	}
+
+	encoded, err := args.Pack(values...)
+	if err != nil || !bytes.Equal(encoded, data) {
+		return nil, false
+	}
+
+	return values, true
+}
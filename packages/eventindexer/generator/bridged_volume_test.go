@@ -0,0 +1,140 @@
+package generator
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/contracts/bridge"
+)
+
+var (
+	token = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	from  = common.HexToAddress("0x00000000000000000000000000000000000000b2")
+	to    = common.HexToAddress("0x00000000000000000000000000000000000000c3")
+)
+
+func onMessageInvocation(t *testing.T, transfer []byte) []byte {
+	data, err := onMessageInvocationArgs.Pack(transfer)
+	assert.Nil(t, err)
+
+	return append(common.CopyBytes(onMessageInvocationSelector), data...)
+}
+
+var ctoken = canonicalERC20{
+	ChainId:  1,
+	Addr:     token,
+	Decimals: 18,
+	Symbol:   "TKO",
+	Name:     "Taiko Token",
+}
+
+func erc20Transfer(t *testing.T, amount *big.Int) []byte {
+	transfer, err := erc20TransferArgs.Pack(ctoken, from, to, amount, big.NewInt(10), common.Hash{0x1})
+	assert.Nil(t, err)
+
+	return onMessageInvocation(t, transfer)
+}
+
+func legacyERC20Transfer(t *testing.T, amount *big.Int) []byte {
+	transfer, err := legacyERC20TransferArgs.Pack(ctoken, from, to, amount)
+	assert.Nil(t, err)
+
+	return onMessageInvocation(t, transfer)
+}
+
+func erc721Transfer(t *testing.T, tokenIDs []*big.Int) []byte {
+	canonicalNFTType, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "chainId", Type: "uint64"},
+		{Name: "addr", Type: "address"},
+		{Name: "symbol", Type: "string"},
+		{Name: "name", Type: "string"},
+	})
+	assert.Nil(t, err)
+
+	uint256ArrayType, err := abi.NewType("uint256[]", "", nil)
+	assert.Nil(t, err)
+
+	transfer, err := abi.Arguments{
+		{Type: canonicalNFTType},
+		{Type: addressType},
+		{Type: addressType},
+		{Type: uint256ArrayType},
+	}.Pack(struct {
+		ChainId uint64 // nolint
+		Addr    common.Address
+		Symbol  string
+		Name    string
+	}{1, token, "NFT", "Taiko NFT"}, from, to, tokenIDs)
+	assert.Nil(t, err)
+
+	return onMessageInvocation(t, transfer)
+}
+
+func messageSentData(t *testing.T, value *big.Int, data []byte) []byte {
+	marshaled, err := json.Marshal(bridge.BridgeMessageSent{
+		Message: bridge.IBridgeMessage{
+			Value: value,
+			Data:  data,
+		},
+	})
+	assert.Nil(t, err)
+
+	return marshaled
+}
+
+func Test_bridgedAmounts(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		amounts map[common.Address]*big.Int
+	}{
+		{
+			"eth",
+			messageSentData(t, big.NewInt(100), nil),
+			map[common.Address]*big.Int{{}: big.NewInt(100)},
+		},
+		{
+			"erc20",
+			messageSentData(t, big.NewInt(0), erc20Transfer(t, big.NewInt(200))),
+			map[common.Address]*big.Int{token: big.NewInt(200)},
+		},
+		{
+			"legacyERC20",
+			messageSentData(t, big.NewInt(0), legacyERC20Transfer(t, big.NewInt(200))),
+			map[common.Address]*big.Int{token: big.NewInt(200)},
+		},
+		{
+			"erc20AndEth",
+			messageSentData(t, big.NewInt(100), erc20Transfer(t, big.NewInt(200))),
+			map[common.Address]*big.Int{{}: big.NewInt(100), token: big.NewInt(200)},
+		},
+		{
+			"erc721",
+			messageSentData(t, big.NewInt(0), erc721Transfer(t, []*big.Int{big.NewInt(1), big.NewInt(2)})),
+			map[common.Address]*big.Int{},
+		},
+		{
+			"otherCall",
+			messageSentData(t, big.NewInt(0), []byte{0x1, 0x2, 0x3, 0x4, 0x5}),
+			map[common.Address]*big.Int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amounts, err := bridgedAmounts(tt.data)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.amounts, amounts)
+		})
+	}
+}
+
+func Test_bridgedAmounts_invalidData(t *testing.T) {
+	_, err := bridgedAmounts([]byte("not json"))
+	assert.NotNil(t, err)
+}
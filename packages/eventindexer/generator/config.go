@@ -0,0 +1,79 @@
+package generator
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/db"
+)
+
+type Config struct {
+	// db configs
+	DatabaseUsername        string
+	DatabasePassword        string
+	DatabaseName            string
+	DatabaseHost            string
+	DatabaseDialect         string
+	DatabaseMaxIdleConns    uint64
+	DatabaseMaxOpenConns    uint64
+	DatabaseMaxConnLifetime uint64
+	MetricsHTTPPort         uint64
+	GenesisDate             time.Time
+	Regenerate              bool
+	Hourly                  bool
+	TrailingPeriods         uint64
+	GenerateInterval        uint64
+	OpenDBFunc              func() (db.DB, error)
+}
+
+// NewConfigFromCliContext creates a new config instance from command line flags.
+func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
+	genesisDate, err := time.Parse(dateFormat, c.String(flags.GenesisDate.Name))
+	if err != nil {
+		return nil, errors.Wrap(err, "time.Parse(genesisDate)")
+	}
+
+	return &Config{
+		DatabaseUsername:        c.String(flags.DatabaseUsername.Name),
+		DatabasePassword:        c.String(flags.DatabasePassword.Name),
+		DatabaseName:            c.String(flags.DatabaseName.Name),
+		DatabaseHost:            c.String(flags.DatabaseHost.Name),
+		DatabaseDialect:         c.String(flags.DatabaseDialect.Name),
+		DatabaseMaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
+		DatabaseMaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
+		DatabaseMaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
+		MetricsHTTPPort:         c.Uint64(flags.MetricsHTTPPort.Name),
+		GenesisDate:             genesisDate,
+		Regenerate:              c.Bool(flags.Regenerate.Name),
+		Hourly:                  c.Bool(flags.Hourly.Name),
+		TrailingPeriods:         c.Uint64(flags.TrailingPeriods.Name),
+		GenerateInterval:        c.Uint64(flags.GenerateInterval.Name),
+		OpenDBFunc: func() (db.DB, error) {
+			return db.OpenDBConnection(db.DBConnectionOpts{
+				Name:            c.String(flags.DatabaseUsername.Name),
+				Password:        c.String(flags.DatabasePassword.Name),
+				Database:        c.String(flags.DatabaseName.Name),
+				Host:            c.String(flags.DatabaseHost.Name),
+				Dialect:         c.String(flags.DatabaseDialect.Name),
+				MaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
+				MaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
+				MaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
+				OpenFunc: func(dialector gorm.Dialector) (db.DB, error) {
+					gormDB, err := gorm.Open(dialector, &gorm.Config{
+						Logger: logger.Default.LogMode(logger.Silent),
+					})
+					if err != nil {
+						return nil, err
+					}
+
+					return db.New(gormDB), nil
+				},
+			})
+		},
+	}, nil
+}
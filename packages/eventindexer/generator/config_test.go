@@ -0,0 +1,85 @@
+package generator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/cmd/flags"
+)
+
+var (
+	databaseMaxIdleConns    = "10"
+	databaseMaxOpenConns    = "10"
+	databaseMaxConnLifetime = "30"
+	genesisDate             = "2024-05-01"
+	generateInterval        = "600"
+	trailingPeriods         = "3"
+)
+
+func setupApp() *cli.App {
+	app := cli.NewApp()
+	app.Flags = flags.GeneratorFlags
+	app.Action = func(ctx *cli.Context) error {
+		_, err := NewConfigFromCliContext(ctx)
+		return err
+	}
+
+	return app
+}
+
+func TestNewConfigFromCliContext(t *testing.T) {
+	app := setupApp()
+
+	app.Action = func(ctx *cli.Context) error {
+		c, err := NewConfigFromCliContext(ctx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "dbuser", c.DatabaseUsername)
+		assert.Equal(t, "dbpass", c.DatabasePassword)
+		assert.Equal(t, "dbname", c.DatabaseName)
+		assert.Equal(t, "dbhost", c.DatabaseHost)
+		assert.Equal(t, uint64(10), c.DatabaseMaxIdleConns)
+		assert.Equal(t, uint64(10), c.DatabaseMaxOpenConns)
+		assert.Equal(t, uint64(30), c.DatabaseMaxConnLifetime)
+		assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), c.GenesisDate)
+		assert.Equal(t, true, c.Regenerate)
+		assert.Equal(t, true, c.Hourly)
+		assert.Equal(t, uint64(600), c.GenerateInterval)
+		assert.Equal(t, uint64(3), c.TrailingPeriods)
+		assert.NotNil(t, c.OpenDBFunc)
+
+		return err
+	}
+
+	assert.Nil(t, app.Run([]string{
+		"TestNewConfigFromCliContext",
+		"--" + flags.DatabaseUsername.Name, "dbuser",
+		"--" + flags.DatabasePassword.Name, "dbpass",
+		"--" + flags.DatabaseHost.Name, "dbhost",
+		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.DatabaseMaxIdleConns.Name, databaseMaxIdleConns,
+		"--" + flags.DatabaseMaxOpenConns.Name, databaseMaxOpenConns,
+		"--" + flags.DatabaseConnMaxLifetime.Name, databaseMaxConnLifetime,
+		"--" + flags.GenesisDate.Name, genesisDate,
+		"--" + flags.Regenerate.Name,
+		"--" + flags.Hourly.Name,
+		"--" + flags.GenerateInterval.Name, generateInterval,
+		"--" + flags.TrailingPeriods.Name, trailingPeriods,
+	}))
+}
+
+func TestNewConfigFromCliContext_invalidGenesisDate(t *testing.T) {
+	app := setupApp()
+
+	assert.NotNil(t, app.Run([]string{
+		"TestNewConfigFromCliContext",
+		"--" + flags.DatabaseUsername.Name, "dbuser",
+		"--" + flags.DatabasePassword.Name, "dbpass",
+		"--" + flags.DatabaseHost.Name, "dbhost",
+		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.GenesisDate.Name, "05/01/2024",
+	}))
+}
@@ -0,0 +1,320 @@
+package generator
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/urfave/cli/v2"
+	"gorm.io/gorm"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/db"
+)
+
+// Generator aggregates the indexed events and transactions into the time series data the
+// charts of the API are served from. Only the periods whose events are indexed are generated:
+// the periods ending before the time of the latest indexed block, of the chain indexed the least
+// far. The generator backfills the periods since the genesis date on its first run, and then
+// generates the periods indexed since its last run, as well as the trailing periods again, so
+// they include the events indexed late, or rolled back by a reorg.
+type Generator struct {
+	db db.DB
+
+	genesisDate     time.Time
+	regenerate      bool
+	periods         []period
+	trailingPeriods uint64
+	interval        time.Duration
+
+	wg     *sync.WaitGroup
+	cancel context.CancelFunc
+}
+
+func (g *Generator) Name() string {
+	return "generator"
+}
+
+func (g *Generator) InitFromCli(ctx context.Context, c *cli.Context) error {
+	cfg, err := NewConfigFromCliContext(c)
+	if err != nil {
+		return err
+	}
+
+	return InitFromConfig(ctx, g, cfg)
+}
+
+func InitFromConfig(ctx context.Context, g *Generator, cfg *Config) error {
+	db, err := cfg.OpenDBFunc()
+	if err != nil {
+		return err
+	}
+
+	g.db = db
+	g.genesisDate = cfg.GenesisDate
+	g.regenerate = cfg.Regenerate
+	g.periods = []period{day}
+	g.trailingPeriods = cfg.TrailingPeriods
+	g.interval = time.Duration(cfg.GenerateInterval) * time.Second
+	g.wg = &sync.WaitGroup{}
+
+	if cfg.Hourly {
+		g.periods = append(g.periods, hour)
+	}
+
+	return nil
+}
+
+func (g *Generator) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	g.cancel = cancel
+
+	if g.regenerate {
+		if err := g.deleteTimeSeriesData(ctx); err != nil {
+			return errors.Wrap(err, "g.deleteTimeSeriesData")
+		}
+	}
+
+	g.wg.Add(1)
+
+	go g.generateLoop(ctx)
+
+	return nil
+}
+
+func (g *Generator) Close(ctx context.Context) {
+	g.cancel()
+
+	g.wg.Wait()
+
+	// Close db connection.
+	if err := g.db.Close(); err != nil {
+		slog.Error("Failed to close db connection", "err", err)
+	}
+}
+
+func (g *Generator) generateLoop(ctx context.Context) {
+	defer g.wg.Done()
+
+	t := time.NewTicker(g.interval)
+
+	defer t.Stop()
+
+	for {
+		if err := g.generate(ctx, time.Now()); err != nil {
+			slog.Error("error generating time series data", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("generate loop context done")
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// generate generates the time series data of every task, for the periods completed before the
+// given time and the latest indexed block which were not generated yet, and the trailing periods.
+func (g *Generator) generate(ctx context.Context, now time.Time) error {
+	until, err := g.indexedUntil(ctx)
+	if err != nil {
+		return errors.Wrap(err, "g.indexedUntil")
+	}
+
+	if until.After(now) {
+		until = now
+	}
+
+	for _, p := range g.periods {
+		for _, t := range tasks {
+			if err := g.generateTask(ctx, t, p, until); err != nil {
+				return errors.Wrapf(err, "g.generateTask(%s)", taskName(t, p))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g *Generator) generateTask(ctx context.Context, t task, p period, until time.Time) error {
+	name := taskName(t, p)
+
+	next, err := g.nextPeriodStart(ctx, name, p)
+	if err != nil {
+		return err
+	}
+
+	start, end := g.window(p, next, until)
+
+	if !start.Before(end) {
+		return nil
+	}
+
+	slog.Info("generating time series data", "task", name, "from", start, "to", end)
+
+	for s := start; s.Before(end); s = p.next(s) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		points, err := t.query(ctx, g.db.GormDB(), s, p.next(s))
+		if err != nil {
+			return err
+		}
+
+		if err := g.save(ctx, name, s.Format(p.format), points); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// window returns the start and the end of the periods to generate, from the given start of the
+// next period not generated yet, or of the trailing periods if it is after them, to the start of
+// the period the given time is in, which is not complete yet.
+func (g *Generator) window(p period, next time.Time, until time.Time) (time.Time, time.Time) {
+	end := p.truncate(until)
+
+	start := end
+	for n := uint64(0); n < g.trailingPeriods; n++ {
+		start = p.previous(start)
+	}
+
+	if next.Before(start) {
+		start = next
+	}
+
+	// no data is generated before the genesis date.
+	if genesis := p.truncate(g.genesisDate); start.Before(genesis) {
+		start = genesis
+	}
+
+	return start, end
+}
+
+// indexedUntil returns the time of the latest indexed block of the chain indexed the least far,
+// the events before it are indexed. It is the zero time if no block is indexed yet.
+func (g *Generator) indexedUntil(ctx context.Context) (time.Time, error) {
+	var rows []struct {
+		BlockTimestamp uint64
+	}
+
+	if err := g.db.GormDB().WithContext(ctx).
+		Table("indexed_blocks").
+		Select("MAX(block_timestamp) AS block_timestamp").
+		Group("chain_id").
+		Scan(&rows).Error; err != nil {
+		return time.Time{}, errors.Wrap(err, "g.db.Scan")
+	}
+
+	if len(rows) == 0 {
+		return time.Time{}, nil
+	}
+
+	until := rows[0].BlockTimestamp
+
+	for _, r := range rows[1:] {
+		if r.BlockTimestamp < until {
+			until = r.BlockTimestamp
+		}
+	}
+
+	// the blocks indexed before their timestamp was saved, until the next block is indexed.
+	if until == 0 {
+		return time.Time{}, nil
+	}
+
+	return time.Unix(int64(until), 0).UTC(), nil
+}
+
+// nextPeriodStart returns the start of the period after the latest one generated for the
+// given task, or of the period of the genesis date if none was generated yet.
+func (g *Generator) nextPeriodStart(ctx context.Context, name string, p period) (time.Time, error) {
+	var latest sql.NullString
+
+	if err := g.db.GormDB().WithContext(ctx).
+		Table("time_series_data").
+		Select("MAX(date)").
+		Where("task = ?", name).
+		Row().
+		Scan(&latest); err != nil {
+		return time.Time{}, errors.Wrap(err, "g.db.Row.Scan")
+	}
+
+	if !latest.Valid {
+		return p.truncate(g.genesisDate), nil
+	}
+
+	date, err := p.parse(latest.String)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "p.parse")
+	}
+
+	return p.next(date), nil
+}
+
+// save replaces the time series data of the given task and date with the given points. A zero
+// point is saved for a period without data, so it is not generated again.
+func (g *Generator) save(ctx context.Context, name string, date string, points []point) error {
+	if len(points) == 0 {
+		points = []point{{value: decimal.Zero}}
+	}
+
+	data := make([]*eventindexer.TimeSeriesData, 0, len(points))
+
+	for _, p := range points {
+		data = append(data, &eventindexer.TimeSeriesData{
+			Task:            name,
+			Value:           decimal.NullDecimal{Valid: true, Decimal: p.value},
+			Date:            date,
+			FeeTokenAddress: p.address,
+			Tier:            p.tier,
+		})
+	}
+
+	return g.db.GormDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("time_series_data").
+			Where("task = ? AND date = ?", name, date).
+			Delete(&eventindexer.TimeSeriesData{}).Error; err != nil {
+			return errors.Wrap(err, "tx.Delete")
+		}
+
+		if err := tx.Table("time_series_data").Create(data).Error; err != nil {
+			return errors.Wrap(err, "tx.Create")
+		}
+
+		return nil
+	})
+}
+
+// deleteTimeSeriesData deletes the time series data of every task, so it is generated again
+// from the genesis date.
+func (g *Generator) deleteTimeSeriesData(ctx context.Context) error {
+	names := make([]string, 0, len(tasks)*len(g.periods))
+
+	for _, p := range g.periods {
+		for _, t := range tasks {
+			names = append(names, taskName(t, p))
+		}
+	}
+
+	slog.Info("deleting time series data", "tasks", names)
+
+	if err := g.db.GormDB().WithContext(ctx).
+		Table("time_series_data").
+		Where("task IN ?", names).
+		Delete(&eventindexer.TimeSeriesData{}).Error; err != nil {
+		return errors.Wrap(err, "g.db.Delete")
+	}
+
+	return nil
+}
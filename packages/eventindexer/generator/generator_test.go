@@ -0,0 +1,62 @@
+package generator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_window(t *testing.T) {
+	g := &Generator{
+		genesisDate:     time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		trailingPeriods: 2,
+	}
+
+	until := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		next      time.Time
+		until     time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			"backfill",
+			time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			until,
+			time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			"trailingPeriods",
+			time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC),
+			until,
+			time.Date(2024, 5, 8, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			"notBeforeGenesis",
+			time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC),
+			time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			"nothingIndexed",
+			time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			time.Time{},
+			time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			time.Time{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := g.window(day, tt.next, tt.until)
+			assert.Equal(t, tt.wantStart, start)
+			assert.Equal(t, tt.wantEnd, end)
+		})
+	}
+}
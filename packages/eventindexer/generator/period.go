@@ -0,0 +1,58 @@
+package generator
+
+import "time"
+
+var (
+	dateFormat = "2006-01-02"
+	hourFormat = "2006-01-02 15:00"
+)
+
+// period is the length of time the time series data is aggregated over. The date of the data
+// of a period is the start of the period, in UTC.
+type period struct {
+	name   string
+	format string
+
+	// truncate returns the start of the period the given time is in.
+	truncate func(t time.Time) time.Time
+	// next returns the start of the period after the one starting at the given time.
+	next func(t time.Time) time.Time
+	// previous returns the start of the period before the one starting at the given time.
+	previous func(t time.Time) time.Time
+}
+
+var (
+	day = period{
+		name:   "day",
+		format: dateFormat,
+		truncate: func(t time.Time) time.Time {
+			t = t.UTC()
+
+			return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		},
+		next: func(t time.Time) time.Time {
+			return t.AddDate(0, 0, 1)
+		},
+		previous: func(t time.Time) time.Time {
+			return t.AddDate(0, 0, -1)
+		},
+	}
+	hour = period{
+		name:   "hour",
+		format: hourFormat,
+		truncate: func(t time.Time) time.Time {
+			return t.UTC().Truncate(time.Hour)
+		},
+		next: func(t time.Time) time.Time {
+			return t.Add(time.Hour)
+		},
+		previous: func(t time.Time) time.Time {
+			return t.Add(-time.Hour)
+		},
+	}
+)
+
+// parse parses the date of the data of a period.
+func (p period) parse(date string) (time.Time, error) {
+	return time.Parse(p.format, date)
+}
@@ -0,0 +1,54 @@
+package generator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_period(t *testing.T) {
+	now := time.Date(2024, 5, 31, 23, 42, 10, 0, time.FixedZone("UTC+2", 2*60*60))
+
+	tests := []struct {
+		name      string
+		period    period
+		wantStart time.Time
+		wantNext  time.Time
+		wantDate  string
+	}{
+		{
+			"day",
+			day,
+			time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			"2024-05-31",
+		},
+		{
+			"hour",
+			hour,
+			time.Date(2024, 5, 31, 21, 0, 0, 0, time.UTC),
+			time.Date(2024, 5, 31, 22, 0, 0, 0, time.UTC),
+			"2024-05-31 21:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := tt.period.truncate(now)
+			assert.Equal(t, tt.wantStart, start)
+			assert.Equal(t, tt.wantNext, tt.period.next(start))
+			assert.Equal(t, start, tt.period.previous(tt.wantNext))
+			assert.Equal(t, tt.wantDate, start.Format(tt.period.format))
+
+			parsed, err := tt.period.parse(tt.wantDate)
+			assert.Nil(t, err)
+			assert.Equal(t, start, parsed)
+		})
+	}
+}
+
+func Test_taskName(t *testing.T) {
+	assert.Equal(t, "proposals-per-day", taskName(task{name: TaskProposals}, day))
+	assert.Equal(t, "bridged-volume-per-hour", taskName(task{name: TaskBridgedVolume}, hour))
+}
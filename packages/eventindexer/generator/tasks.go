@@ -0,0 +1,231 @@
+package generator
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+// The tasks the generator aggregates for each period. The data of a task is stored in the
+// time_series_data table under the task name suffixed with the period, e.g. "proposals-per-day".
+var (
+	// TaskProposals is the number of proposed blocks and batches.
+	TaskProposals = "proposals"
+	// TaskProofs is the number of proofs, grouped by tier. The proofs of batches have no tier.
+	TaskProofs = "proofs"
+	// TaskProofsByVerifier is the number of proofs of batches, grouped by the verifier address.
+	TaskProofsByVerifier = "proofs-by-verifier"
+	// TaskUniqueProposers is the number of distinct proposers.
+	TaskUniqueProposers = "unique-proposers"
+	// TaskUniqueProvers is the number of distinct provers of blocks and batches.
+	TaskUniqueProvers = "unique-provers"
+	// TaskBridgedVolume is the amount bridged by the sent messages, grouped by the canonical
+	// token address, the zero address being ETH.
+	TaskBridgedVolume = "bridged-volume"
+	// TaskTransactions is the number of L2 transactions.
+	TaskTransactions = "transactions"
+	// TaskActiveAccounts is the number of distinct senders of L2 transactions.
+	TaskActiveAccounts = "active-accounts"
+)
+
+// point is the value of a task for a period. Grouped tasks store their group in the tier or the
+// fee_token_address column of the time series data.
+type point struct {
+	value   decimal.Decimal
+	tier    sql.NullInt64
+	address string
+}
+
+// queryFunc returns the points of a task for the period between start, inclusive, and end.
+type queryFunc func(ctx context.Context, db *gorm.DB, start time.Time, end time.Time) ([]point, error)
+
+type task struct {
+	name  string
+	query queryFunc
+}
+
+var tasks = []task{
+	{TaskProposals, countEvents(eventindexer.EventNameBlockProposed, eventindexer.EventNameBatchProposed)},
+	{TaskProofs, countProofsByTier},
+	{TaskProofsByVerifier, countProofsByVerifier},
+	{TaskUniqueProposers, countEventAddresses(eventindexer.EventNameBlockProposed, eventindexer.EventNameBatchProposed)},
+	{TaskUniqueProvers, countUniqueProvers},
+	{TaskBridgedVolume, sumBridgedVolume},
+	{TaskTransactions, countTransactions},
+	{TaskActiveAccounts, countSenders},
+}
+
+// taskName returns the name the data of the given task and period is stored under.
+func taskName(t task, p period) string {
+	return fmt.Sprintf("%s-per-%s", t.name, p.name)
+}
+
+func events(ctx context.Context, db *gorm.DB, start time.Time, end time.Time, names ...string) *gorm.DB {
+	return db.WithContext(ctx).
+		Table("events").
+		Where("event IN ?", names).
+		Where("transacted_at >= ? AND transacted_at < ?", start, end)
+}
+
+func transactions(ctx context.Context, db *gorm.DB, start time.Time, end time.Time) *gorm.DB {
+	return db.WithContext(ctx).
+		Table("transactions").
+		Where("transacted_at >= ? AND transacted_at < ?", start, end)
+}
+
+func countEvents(names ...string) queryFunc {
+	return func(ctx context.Context, db *gorm.DB, start time.Time, end time.Time) ([]point, error) {
+		var count int64
+
+		if err := events(ctx, db, start, end, names...).Count(&count).Error; err != nil {
+			return nil, errors.Wrap(err, "db.Count")
+		}
+
+		return []point{{value: decimal.NewFromInt(count)}}, nil
+	}
+}
+
+func countEventAddresses(names ...string) queryFunc {
+	return func(ctx context.Context, db *gorm.DB, start time.Time, end time.Time) ([]point, error) {
+		var count int64
+
+		if err := events(ctx, db, start, end, names...).Distinct("address").Count(&count).Error; err != nil {
+			return nil, errors.Wrap(err, "db.Count")
+		}
+
+		return []point{{value: decimal.NewFromInt(count)}}, nil
+	}
+}
+
+// countUniqueProvers counts the distinct provers of the TransitionProved and BatchesProved events.
+// The address of a BatchesProved event is its verifier, the prover of a batch is the sender of the
+// proof transaction, saved with the batch.
+func countUniqueProvers(ctx context.Context, db *gorm.DB, start time.Time, end time.Time) ([]point, error) {
+	blockProvers := events(ctx, db, start, end, eventindexer.EventNameTransitionProved).Select("address")
+
+	batchProvers := db.WithContext(ctx).
+		Table("batches").
+		Select("prover AS address").
+		Where("proved_at >= ? AND proved_at < ?", start, end)
+
+	var count int64
+
+	if err := db.WithContext(ctx).
+		Raw("SELECT COUNT(DISTINCT address) FROM (? UNION ?) AS provers", blockProvers, batchProvers).
+		Scan(&count).Error; err != nil {
+		return nil, errors.Wrap(err, "db.Scan")
+	}
+
+	return []point{{value: decimal.NewFromInt(count)}}, nil
+}
+
+func countProofsByTier(ctx context.Context, db *gorm.DB, start time.Time, end time.Time) ([]point, error) {
+	var rows []struct {
+		Tier  sql.NullInt64
+		Count int64
+	}
+
+	if err := events(ctx, db, start, end, eventindexer.EventNameTransitionProved, eventindexer.EventNameBatchesProven).
+		Select("tier, COUNT(*) AS count").
+		Group("tier").
+		Scan(&rows).Error; err != nil {
+		return nil, errors.Wrap(err, "db.Scan")
+	}
+
+	points := make([]point, 0, len(rows))
+
+	for _, r := range rows {
+		points = append(points, point{value: decimal.NewFromInt(r.Count), tier: r.Tier})
+	}
+
+	return points, nil
+}
+
+func countProofsByVerifier(ctx context.Context, db *gorm.DB, start time.Time, end time.Time) ([]point, error) {
+	var rows []struct {
+		Address string
+		Count   int64
+	}
+
+	if err := events(ctx, db, start, end, eventindexer.EventNameBatchesProven).
+		Select("address, COUNT(*) AS count").
+		Group("address").
+		Scan(&rows).Error; err != nil {
+		return nil, errors.Wrap(err, "db.Scan")
+	}
+
+	points := make([]point, 0, len(rows))
+
+	for _, r := range rows {
+		points = append(points, point{value: decimal.NewFromInt(r.Count), address: r.Address})
+	}
+
+	return points, nil
+}
+
+func sumBridgedVolume(ctx context.Context, db *gorm.DB, start time.Time, end time.Time) ([]point, error) {
+	var data [][]byte
+
+	if err := events(ctx, db, start, end, eventindexer.EventNameMessageSent).Pluck("data", &data).Error; err != nil {
+		return nil, errors.Wrap(err, "db.Pluck")
+	}
+
+	volumes := make(map[common.Address]*big.Int)
+
+	for _, d := range data {
+		amounts, err := bridgedAmounts(d)
+		if err != nil {
+			return nil, errors.Wrap(err, "bridgedAmounts")
+		}
+
+		for token, amount := range amounts {
+			if _, ok := volumes[token]; !ok {
+				volumes[token] = new(big.Int)
+			}
+
+			volumes[token].Add(volumes[token], amount)
+		}
+	}
+
+	points := make([]point, 0, len(volumes))
+
+	for token, volume := range volumes {
+		points = append(points, point{value: decimal.NewFromBigInt(volume, 0), address: token.Hex()})
+	}
+
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].address < points[j].address
+	})
+
+	return points, nil
+}
+
+func countTransactions(ctx context.Context, db *gorm.DB, start time.Time, end time.Time) ([]point, error) {
+	var count int64
+
+	if err := transactions(ctx, db, start, end).Count(&count).Error; err != nil {
+		return nil, errors.Wrap(err, "db.Count")
+	}
+
+	return []point{{value: decimal.NewFromInt(count)}}, nil
+}
+
+func countSenders(ctx context.Context, db *gorm.DB, start time.Time, end time.Time) ([]point, error) {
+	var count int64
+
+	if err := transactions(ctx, db, start, end).Distinct("sender").Count(&count).Error; err != nil {
+		return nil, errors.Wrap(err, "db.Count")
+	}
+
+	return []point{{value: decimal.NewFromInt(count)}}, nil
+}
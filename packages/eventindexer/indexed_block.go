@@ -0,0 +1,36 @@
+package eventindexer
+
+import (
+	"context"
+	"time"
+)
+
+// IndexedBlock is the last block of a block range filtered by the indexer. Its hash is
+// compared against the canonical chain on the next filter pass to detect reorgs.
+type IndexedBlock struct {
+	ID         int    `json:"id"`
+	ChainID    uint64 `json:"chainID"`
+	BlockID    uint64 `json:"blockID"`
+	BlockHash  string `json:"blockHash"`
+	ParentHash string `json:"parentHash"`
+	// BlockTimestamp is the time of the block, the events before it are indexed.
+	BlockTimestamp uint64    `json:"blockTimestamp"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+type SaveIndexedBlockOpts struct {
+	ChainID        uint64
+	BlockID        uint64
+	BlockHash      string
+	ParentHash     string
+	BlockTimestamp uint64
+}
+
+// IndexedBlockRepository is used to interact with the indexed blocks in the store
+type IndexedBlockRepository interface {
+	Save(ctx context.Context, opts SaveIndexedBlockOpts) error
+	// FindAll returns the indexed blocks of a chain, latest first.
+	FindAll(ctx context.Context, chainID uint64) ([]*IndexedBlock, error)
+	DeleteAfterBlockID(ctx context.Context, chainID uint64, blockID uint64) error
+	DeleteBeforeBlockID(ctx context.Context, chainID uint64, blockID uint64) error
+}
@@ -2,8 +2,8 @@ package indexer
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
 	"github.com/urfave/cli/v2"
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
@@ -17,6 +17,7 @@ type Config struct {
 	DatabasePassword        string
 	DatabaseName            string
 	DatabaseHost            string
+	DatabaseDialect         string
 	DatabaseMaxIdleConns    uint64
 	DatabaseMaxOpenConns    uint64
 	DatabaseMaxConnLifetime uint64
@@ -33,16 +34,29 @@ type Config struct {
 	Layer                   string
 	OntakeForkHeight        uint64
 	PacayaForkHeight        uint64
+	Confirmations           uint64
+	GenericContracts        []*GenericContract
 	OpenDBFunc              func() (db.DB, error)
 }
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
 func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
+	var genericContracts []*GenericContract
+
+	if path := c.String(flags.GenericContracts.Name); path != "" {
+		var err error
+
+		if genericContracts, err = LoadGenericContracts(path); err != nil {
+			return nil, errors.Wrap(err, "LoadGenericContracts")
+		}
+	}
+
 	return &Config{
 		DatabaseUsername:        c.String(flags.DatabaseUsername.Name),
 		DatabasePassword:        c.String(flags.DatabasePassword.Name),
 		DatabaseName:            c.String(flags.DatabaseName.Name),
 		DatabaseHost:            c.String(flags.DatabaseHost.Name),
+		DatabaseDialect:         c.String(flags.DatabaseDialect.Name),
 		DatabaseMaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
 		DatabaseMaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
 		DatabaseMaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
@@ -59,17 +73,20 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		Layer:                   c.String(flags.Layer.Name),
 		OntakeForkHeight:        c.Uint64(flags.OntakeForkHeight.Name),
 		PacayaForkHeight:        c.Uint64(flags.PacayaForkHeight.Name),
+		Confirmations:           c.Uint64(flags.Confirmations.Name),
+		GenericContracts:        genericContracts,
 		OpenDBFunc: func() (db.DB, error) {
 			return db.OpenDBConnection(db.DBConnectionOpts{
 				Name:            c.String(flags.DatabaseUsername.Name),
 				Password:        c.String(flags.DatabasePassword.Name),
 				Database:        c.String(flags.DatabaseName.Name),
 				Host:            c.String(flags.DatabaseHost.Name),
+				Dialect:         c.String(flags.DatabaseDialect.Name),
 				MaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
 				MaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
 				MaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
-				OpenFunc: func(dsn string) (db.DB, error) {
-					gormDB, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+				OpenFunc: func(dialector gorm.Dialector) (db.DB, error) {
+					gormDB, err := gorm.Open(dialector, &gorm.Config{
 						Logger: logger.Default.LogMode(logger.Silent),
 					})
 					if err != nil {
@@ -22,6 +22,7 @@ var (
 	syncMode                = "sync"
 	layer                   = "l1"
 	rpcUrl                  = "rpcUrl"
+	confirmations           = "12"
 )
 
 func setupApp() *cli.App {
@@ -60,6 +61,7 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		assert.Equal(t, true, c.IndexNFTs)
 		assert.Equal(t, layer, c.Layer)
 		assert.Equal(t, rpcUrl, c.RPCUrl)
+		assert.Equal(t, uint64(12), c.Confirmations)
 		assert.NotNil(t, c.OpenDBFunc)
 
 		// assert.Nil(t, InitFromConfig(context.Background(), new(Indexer), c))
@@ -86,5 +88,6 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		"--" + flags.IndexNFTs.Name,
 		"--" + flags.Layer.Name, layer,
 		"--" + flags.IndexerRPCUrl.Name, rpcUrl,
+		"--" + flags.Confirmations.Name, confirmations,
 	}))
 }
@@ -0,0 +1,205 @@
+package indexer
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+// indexedBlocksHistory is how many blocks behind the last indexed block the indexed block
+// hashes and balance changes are kept for, which is the deepest reorg the indexer can roll back.
+var indexedBlocksHistory uint64 = 1024
+
+var (
+	errReorgDetected = errors.New("reorg detected")
+	errReorgTooDeep  = errors.New("reorg deeper than the indexed block history, resync required")
+)
+
+// detectReorg checks the hashes of the indexed blocks against the canonical chain, latest
+// first. If the last indexed block is no longer canonical, it rolls the indexer back to the
+// latest indexed block which still is.
+func (i *Indexer) detectReorg(ctx context.Context) error {
+	blocks, err := i.indexedBlockRepo.FindAll(ctx, i.srcChainID)
+	if err != nil {
+		return errors.Wrap(err, "i.indexedBlockRepo.FindAll")
+	}
+
+	for n, block := range blocks {
+		header, err := i.ethClient.HeaderByNumber(ctx, new(big.Int).SetUint64(block.BlockID))
+		if err != nil {
+			return errors.Wrap(err, "i.ethClient.HeaderByNumber")
+		}
+
+		if header.Hash() != common.HexToHash(block.BlockHash) {
+			continue
+		}
+
+		if n > 0 {
+			return i.rollback(ctx, block, blocks[n-1])
+		}
+
+		i.lastIndexedBlock = block
+
+		return nil
+	}
+
+	if len(blocks) > 0 {
+		eventindexer.ReorgsTooDeep.Inc()
+
+		slog.Error("no indexed block is canonical anymore",
+			"oldestIndexedBlock", blocks[len(blocks)-1].BlockID,
+			"latestIndexedBlock", blocks[0].BlockID,
+		)
+
+		return errReorgTooDeep
+	}
+
+	i.lastIndexedBlock = nil
+
+	return nil
+}
+
+// rollback reverses the balance changes, and deletes the events, batches and transactions,
+// indexed after the given common ancestor of the canonical chain and the orphaned blocks, and makes
+// the indexer filter them again.
+func (i *Indexer) rollback(
+	ctx context.Context,
+	ancestor *eventindexer.IndexedBlock,
+	orphaned *eventindexer.IndexedBlock,
+) error {
+	eventindexer.ReorgsDetected.Inc()
+
+	slog.Warn("reorg detected, rolling back",
+		"ancestorBlock", ancestor.BlockID,
+		"ancestorHash", ancestor.BlockHash,
+		"orphanedBlock", orphaned.BlockID,
+		"orphanedHash", orphaned.BlockHash,
+		"latestIndexedBlockNumber", i.latestIndexedBlockNumber,
+	)
+
+	if err := i.balanceChangeRepo.RollbackAfterBlockID(ctx, i.srcChainID, ancestor.BlockID); err != nil {
+		return errors.Wrap(err, "i.balanceChangeRepo.RollbackAfterBlockID")
+	}
+
+	if err := i.eventRepo.DeleteAllAfterBlockID(ctx, ancestor.BlockID, i.srcChainID); err != nil {
+		return errors.Wrap(err, "i.eventRepo.DeleteAllAfterBlockID")
+	}
+
+	if err := i.batchRepo.RollbackAfterBlockID(ctx, i.srcChainID, ancestor.BlockID); err != nil {
+		return errors.Wrap(err, "i.batchRepo.RollbackAfterBlockID")
+	}
+
+	// transactions are only indexed on L2
+	if i.layer == Layer2 {
+		if err := i.txRepo.DeleteAllAfterBlockID(ctx, ancestor.BlockID, i.srcChainID); err != nil {
+			return errors.Wrap(err, "i.txRepo.DeleteAllAfterBlockID")
+		}
+	}
+
+	if err := i.indexedBlockRepo.DeleteAfterBlockID(ctx, i.srcChainID, ancestor.BlockID); err != nil {
+		return errors.Wrap(err, "i.indexedBlockRepo.DeleteAfterBlockID")
+	}
+
+	if i.latestIndexedBlockNumber > ancestor.BlockID {
+		i.latestIndexedBlockNumber = ancestor.BlockID
+	}
+
+	// filter switches to the filter of a fork again if the rollback went back before it.
+	if i.latestIndexedBlockNumber+1 < i.ontakeForkHeight {
+		i.isPostOntakeForkHeightReached = false
+	}
+
+	if i.latestIndexedBlockNumber+1 < i.pacayaForkHeight {
+		i.isPostPacayaForkHeightReached = false
+	}
+
+	i.lastIndexedBlock = ancestor
+
+	return nil
+}
+
+// batchEndHeader checks the first block of a batch builds on the last indexed block, and
+// returns the header of the last block of the batch. The header is fetched before the events
+// of the batch are filtered, so a reorg while filtering is detected by the next filter pass.
+func (i *Indexer) batchEndHeader(ctx context.Context, start uint64, end uint64) (*types.Header, error) {
+	if last := i.lastIndexedBlock; last != nil && last.BlockID+1 == start {
+		header, err := i.ethClient.HeaderByNumber(ctx, new(big.Int).SetUint64(start))
+		if err != nil {
+			return nil, errors.Wrap(err, "i.ethClient.HeaderByNumber")
+		}
+
+		if header.ParentHash != common.HexToHash(last.BlockHash) {
+			slog.Warn("block does not build on the last indexed block",
+				"blockNumber", start,
+				"parentHash", header.ParentHash.Hex(),
+				"lastIndexedBlockHash", last.BlockHash,
+			)
+
+			return nil, errReorgDetected
+		}
+
+		if start == end {
+			return header, nil
+		}
+	}
+
+	header, err := i.ethClient.HeaderByNumber(ctx, new(big.Int).SetUint64(end))
+	if err != nil {
+		return nil, errors.Wrap(err, "i.ethClient.HeaderByNumber")
+	}
+
+	return header, nil
+}
+
+// saveIndexedBlock saves the hash of the last block of an indexed batch, and prunes the
+// hashes and balance changes older than indexedBlocksHistory.
+func (i *Indexer) saveIndexedBlock(ctx context.Context, header *types.Header) error {
+	opts := eventindexer.SaveIndexedBlockOpts{
+		ChainID:        i.srcChainID,
+		BlockID:        header.Number.Uint64(),
+		BlockHash:      header.Hash().Hex(),
+		ParentHash:     header.ParentHash.Hex(),
+		BlockTimestamp: header.Time,
+	}
+
+	if err := i.indexedBlockRepo.Save(ctx, opts); err != nil {
+		return errors.Wrap(err, "i.indexedBlockRepo.Save")
+	}
+
+	i.lastIndexedBlock = &eventindexer.IndexedBlock{
+		ChainID:    opts.ChainID,
+		BlockID:    opts.BlockID,
+		BlockHash:  opts.BlockHash,
+		ParentHash: opts.ParentHash,
+	}
+
+	if opts.BlockID > indexedBlocksHistory {
+		before := opts.BlockID - indexedBlocksHistory
+
+		if err := i.indexedBlockRepo.DeleteBeforeBlockID(ctx, opts.ChainID, before); err != nil {
+			return errors.Wrap(err, "i.indexedBlockRepo.DeleteBeforeBlockID")
+		}
+
+		if err := i.balanceChangeRepo.DeleteBeforeBlockID(ctx, opts.ChainID, before); err != nil {
+			return errors.Wrap(err, "i.balanceChangeRepo.DeleteBeforeBlockID")
+		}
+	}
+
+	return nil
+}
+
+// applyBalanceChange applies a transfer to the balances, and records it, so it can be reversed
+// if its block is orphaned.
+func (i *Indexer) applyBalanceChange(ctx context.Context, opts eventindexer.SaveBalanceChangeOpts) error {
+	if err := i.balanceChangeRepo.Apply(ctx, opts); err != nil {
+		return errors.Wrap(err, "i.balanceChangeRepo.Apply")
+	}
+
+	return nil
+}
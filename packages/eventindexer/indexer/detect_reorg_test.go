@@ -0,0 +1,123 @@
+package indexer
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/mock"
+)
+
+var (
+	alice = "0x000000000000000000000000000000000000a11c"
+	bob   = "0x0000000000000000000000000000000000000b0b"
+)
+
+func newTestIndexer() *Indexer {
+	return &Indexer{
+		eventRepo:         mock.NewEventRepository(),
+		nftBalanceRepo:    mock.NewNFTBalanceRepository(),
+		erc20BalanceRepo:  mock.NewERC20BalanceRepository(),
+		indexedBlockRepo:  mock.NewIndexedBlockRepository(),
+		balanceChangeRepo: mock.NewBalanceChangeRepository(),
+		batchRepo:         mock.NewBatchRepository(),
+		srcChainID:        mock.MockChainID.Uint64(),
+		layer:             Layer1,
+	}
+}
+
+func Test_rollback(t *testing.T) {
+	i := newTestIndexer()
+	ctx := context.Background()
+
+	for _, blockID := range []uint64{10, 20, 30} {
+		assert.Nil(t, i.indexedBlockRepo.Save(ctx, eventindexer.SaveIndexedBlockOpts{
+			ChainID:   i.srcChainID,
+			BlockID:   blockID,
+			BlockHash: common.BigToHash(new(big.Int).SetUint64(blockID)).Hex(),
+		}))
+	}
+
+	for _, opts := range []eventindexer.SaveBalanceChangeOpts{
+		{BlockID: 5, ContractType: "ERC721", FromAddress: alice, ToAddress: bob, Amount: "1"},
+		{BlockID: 15, ContractType: "ERC20", FromAddress: ZeroAddress.Hex(), ToAddress: bob, Amount: "100"},
+		{BlockID: 25, ContractType: "ERC1155", TokenID: 1, FromAddress: alice, ToAddress: bob, Amount: "3"},
+	} {
+		opts.ChainID = mock.MockChainID.Int64()
+		assert.Nil(t, i.balanceChangeRepo.Apply(ctx, opts))
+	}
+
+	i.latestIndexedBlockNumber = 30
+	i.ontakeForkHeight = 15
+	i.isPostOntakeForkHeightReached = true
+
+	blocks, err := i.indexedBlockRepo.FindAll(ctx, i.srcChainID)
+	assert.Nil(t, err)
+
+	assert.Nil(t, i.rollback(ctx, blocks[2], blocks[1]))
+
+	assert.Equal(t, uint64(10), i.latestIndexedBlockNumber)
+	assert.Equal(t, blocks[2], i.lastIndexedBlock)
+	assert.False(t, i.isPostOntakeForkHeightReached)
+
+	// the balance changes after the ancestor are reversed, latest first.
+	reversed := i.balanceChangeRepo.(*mock.BalanceChangeRepository).Reversed
+	assert.Equal(t, 2, len(reversed))
+	assert.Equal(t, uint64(25), reversed[0].BlockID)
+	assert.Equal(t, uint64(15), reversed[1].BlockID)
+
+	changes, err := i.balanceChangeRepo.FindAllAfterBlockID(ctx, i.srcChainID, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(changes))
+	assert.Equal(t, uint64(5), changes[0].BlockID)
+
+	blocks, err = i.indexedBlockRepo.FindAll(ctx, i.srcChainID)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(blocks))
+	assert.Equal(t, uint64(10), blocks[0].BlockID)
+}
+
+func Test_saveIndexedBlock(t *testing.T) {
+	i := newTestIndexer()
+	ctx := context.Background()
+
+	for _, blockID := range []uint64{1, 100} {
+		assert.Nil(t, i.indexedBlockRepo.Save(ctx, eventindexer.SaveIndexedBlockOpts{
+			ChainID: i.srcChainID,
+			BlockID: blockID,
+		}))
+
+		assert.Nil(t, i.balanceChangeRepo.Apply(ctx, eventindexer.SaveBalanceChangeOpts{
+			ChainID: mock.MockChainID.Int64(),
+			BlockID: blockID,
+		}))
+	}
+
+	header := &types.Header{
+		Number:     big.NewInt(1100),
+		ParentHash: common.HexToHash("0x1"),
+		Time:       1700000000,
+	}
+
+	assert.Nil(t, i.saveIndexedBlock(ctx, header))
+
+	assert.Equal(t, uint64(1100), i.lastIndexedBlock.BlockID)
+	assert.Equal(t, header.Hash().Hex(), i.lastIndexedBlock.BlockHash)
+
+	// the block and balance change older than the history are pruned.
+	blocks, err := i.indexedBlockRepo.FindAll(ctx, i.srcChainID)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(blocks))
+	assert.Equal(t, uint64(1700000000), blocks[0].BlockTimestamp)
+	assert.Equal(t, uint64(100), blocks[1].BlockID)
+
+	changes, err := i.balanceChangeRepo.FindAllAfterBlockID(ctx, i.srcChainID, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(changes))
+	assert.Equal(t, uint64(100), changes[0].BlockID)
+}
@@ -126,11 +126,22 @@ func filterFunc(
 func (i *Indexer) filter(
 	ctx context.Context,
 ) error {
+	if err := i.detectReorg(ctx); err != nil {
+		return errors.Wrap(err, "i.detectReorg")
+	}
+
 	endBlockID, err := i.ethClient.BlockNumber(ctx)
 	if err != nil {
 		return errors.Wrap(err, "i.ethClient.BlockNumber")
 	}
 
+	// only index the blocks with enough confirmations
+	if endBlockID < i.confirmations {
+		return nil
+	}
+
+	endBlockID -= i.confirmations
+
 	slog.Info("getting batch of events",
 		"startBlock", i.latestIndexedBlockNumber,
 		"endBlock", endBlockID,
@@ -203,10 +214,19 @@ func (i *Indexer) filter(
 			filter = filterFunc
 		}
 
+		header, err := i.batchEndHeader(ctx, j, end)
+		if err != nil {
+			return errors.Wrap(err, "i.batchEndHeader")
+		}
+
 		if err := filter(ctx, new(big.Int).SetUint64(i.srcChainID), i, filterOpts); err != nil {
 			return errors.Wrap(err, "filter")
 		}
 
+		if err := i.saveIndexedBlock(ctx, header); err != nil {
+			return errors.Wrap(err, "i.saveIndexedBlock")
+		}
+
 		i.latestIndexedBlockNumber = end
 	}
 
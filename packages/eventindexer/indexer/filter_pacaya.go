@@ -20,6 +20,21 @@ func filterFuncPacaya(
 	wg, ctx := errgroup.WithContext(ctx)
 
 	if i.taikoInbox != nil {
+		// dont run in goroutines, as the batchProposed events need to be processed in order and
+		// saved to the DB in order, as we need the previous one's "lastBlockId" to calculate
+		// the blockIds of the next batchProposed event, since they are no longer
+		// emitted in the event themself. They are also saved before the batchesProved and
+		// batchesVerified events, which update the batches proposed in the same block range.
+		batchProposedEvent, err := i.taikoInbox.FilterBatchProposed(filterOpts)
+		if err != nil {
+			return errors.Wrap(err, "i.taikoInbox.FilterBatchProposed")
+		}
+
+		err = i.saveBatchProposedEvents(ctx, chainID, batchProposedEvent)
+		if err != nil {
+			return errors.Wrap(err, "i.savebatchProposedEvent")
+		}
+
 		wg.Go(func() error {
 			batchesProvedEvents, err := i.taikoInbox.FilterBatchesProved(filterOpts)
 			if err != nil {
@@ -47,20 +62,6 @@ func filterFuncPacaya(
 
 			return nil
 		})
-
-		// dont run in goroutines, as the batchProposed events need to be processed in order and
-		// saved to the DB in order, as we need the previous one's "lastBlockId" to calculate
-		// the blockIds of the next batchProposed event, since they are no longer
-		// emitted in the event themself.
-		batchProposedEvent, err := i.taikoInbox.FilterBatchProposed(filterOpts)
-		if err != nil {
-			return errors.Wrap(err, "i.taikoInbox.FilterBatchProposed")
-		}
-
-		err = i.saveBatchProposedEvents(ctx, chainID, batchProposedEvent)
-		if err != nil {
-			return errors.Wrap(err, "i.savebatchProposedEvent")
-		}
 	}
 
 	if i.bridge != nil {
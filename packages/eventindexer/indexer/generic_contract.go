@@ -0,0 +1,252 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+// GenericContract is a contract whose events are indexed from its ABI, without a binding.
+// Its events are saved with the name of the contract, and the address of the event is the
+// value of its AddressArg argument, or the address of the contract when it is not set.
+type GenericContract struct {
+	Name    string
+	Address common.Address
+	ABI     abi.ABI
+	// Events are the events to index, by their ID, the hash of their signature.
+	Events map[common.Hash]*GenericEvent
+}
+
+// GenericEvent is an event of a GenericContract.
+type GenericEvent struct {
+	Event      abi.Event
+	AddressArg string
+}
+
+// genericContractConfig is a contract of the generic contracts config file. The ABI is either
+// inline, or in the ABIFile, relative to the config file, which can also be a compiler artifact
+// with the ABI in its "abi" field.
+type genericContractConfig struct {
+	Name    string          `json:"name"`
+	Address string          `json:"address"`
+	ABI     json.RawMessage `json:"abi"`
+	ABIFile string          `json:"abiFile"`
+	Events  []struct {
+		Name       string `json:"name"`
+		AddressArg string `json:"addressArg"`
+	} `json:"events"`
+}
+
+// LoadGenericContracts loads the generic contracts config file, an array of the contracts with
+// their name, address, ABI and events to index.
+func LoadGenericContracts(path string) ([]*GenericContract, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "os.ReadFile")
+	}
+
+	var configs []genericContractConfig
+
+	if err := json.Unmarshal(b, &configs); err != nil {
+		return nil, errors.Wrap(err, "json.Unmarshal")
+	}
+
+	contracts := make([]*GenericContract, 0, len(configs))
+
+	for _, config := range configs {
+		contract, err := newGenericContract(config, filepath.Dir(path))
+		if err != nil {
+			return nil, errors.Wrapf(err, "contract %v", config.Name)
+		}
+
+		contracts = append(contracts, contract)
+	}
+
+	return contracts, nil
+}
+
+func newGenericContract(config genericContractConfig, dir string) (*GenericContract, error) {
+	if config.Name == "" {
+		return nil, errors.New("missing name")
+	}
+
+	if isBuiltinEventName(config.Name) {
+		return nil, fmt.Errorf("name %v is the name of built-in events", config.Name)
+	}
+
+	if !common.IsHexAddress(config.Address) {
+		return nil, fmt.Errorf("invalid address %v", config.Address)
+	}
+
+	abiJSON := config.ABI
+
+	if config.ABIFile != "" {
+		b, err := os.ReadFile(filepath.Join(dir, config.ABIFile))
+		if err != nil {
+			return nil, errors.Wrap(err, "os.ReadFile")
+		}
+
+		artifact := struct {
+			ABI json.RawMessage `json:"abi"`
+		}{}
+
+		if err := json.Unmarshal(b, &artifact); err == nil && artifact.ABI != nil {
+			b = artifact.ABI
+		}
+
+		abiJSON = b
+	}
+
+	parsedABI, err := abi.JSON(strings.NewReader(string(abiJSON)))
+	if err != nil {
+		return nil, errors.Wrap(err, "abi.JSON")
+	}
+
+	contract := &GenericContract{
+		Name:    config.Name,
+		Address: common.HexToAddress(config.Address),
+		ABI:     parsedABI,
+		Events:  make(map[common.Hash]*GenericEvent),
+	}
+
+	if len(config.Events) == 0 {
+		return nil, errors.New("no events")
+	}
+
+	for _, e := range config.Events {
+		event, ok := parsedABI.Events[e.Name]
+		if !ok {
+			return nil, fmt.Errorf("event %v not in the ABI", e.Name)
+		}
+
+		// anonymous events have no ID to be recognized by.
+		if event.Anonymous {
+			return nil, fmt.Errorf("event %v is anonymous", e.Name)
+		}
+
+		if e.AddressArg != "" && !hasAddressArg(event, e.AddressArg) {
+			return nil, fmt.Errorf("event %v has no address argument %v", e.Name, e.AddressArg)
+		}
+
+		contract.Events[event.ID] = &GenericEvent{
+			Event:      event,
+			AddressArg: e.AddressArg,
+		}
+	}
+
+	return contract, nil
+}
+
+// isBuiltinEventName returns whether the name is one the indexer saves its own events with,
+// which the generic events would be mixed up with. The names are compared case insensitively,
+// as the databases do.
+func isBuiltinEventName(name string) bool {
+	for _, builtin := range []string{
+		eventindexer.EventNameTransitionProved,
+		eventindexer.EventNameTransitionContested,
+		eventindexer.EventNameBlockProposed,
+		eventindexer.EventNameBatchProposed,
+		eventindexer.EventNameBatchesProven,
+		eventindexer.EventNameBatchesVerified,
+		eventindexer.EventNameBlockAssigned,
+		eventindexer.EventNameBlockVerified,
+		eventindexer.EventNameMessageSent,
+		eventindexer.EventNameSwap,
+		eventindexer.EventNameMint,
+		eventindexer.EventNameNFTTransfer,
+		eventindexer.EventNameInstanceAdded,
+	} {
+		if strings.EqualFold(name, builtin) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasAddressArg(event abi.Event, name string) bool {
+	for _, arg := range event.Inputs {
+		if arg.Name == name && arg.Type.T == abi.AddressTy {
+			return true
+		}
+	}
+
+	return false
+}
+
+// decode returns the event of the log, with its arguments by name, and the address it is
+// saved with. The event is nil when the log is not one of the indexed events.
+func (c *GenericContract) decode(vLog types.Log) (*GenericEvent, map[string]interface{}, common.Address, error) {
+	if vLog.Address != c.Address || len(vLog.Topics) == 0 {
+		return nil, nil, common.Address{}, nil
+	}
+
+	event, ok := c.Events[vLog.Topics[0]]
+	if !ok {
+		return nil, nil, common.Address{}, nil
+	}
+
+	args := make(map[string]interface{})
+
+	if err := event.Event.Inputs.UnpackIntoMap(args, vLog.Data); err != nil {
+		return nil, nil, common.Address{}, errors.Wrap(err, "event.Event.Inputs.UnpackIntoMap")
+	}
+
+	var indexed abi.Arguments
+
+	for _, arg := range event.Event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+
+	if err := abi.ParseTopicsIntoMap(args, indexed, vLog.Topics[1:]); err != nil {
+		return nil, nil, common.Address{}, errors.Wrap(err, "abi.ParseTopicsIntoMap")
+	}
+
+	for name, v := range args {
+		args[name] = hexBytes(v)
+	}
+
+	address := c.Address
+
+	if event.AddressArg != "" {
+		address = args[event.AddressArg].(common.Address)
+	}
+
+	return event, args, address, nil
+}
+
+// hexBytes encodes the byte arrays and slices as hex, which would be marshaled as arrays of
+// numbers and base64 otherwise.
+func hexBytes(v interface{}) interface{} {
+	rv := reflect.ValueOf(v)
+
+	switch {
+	case rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8:
+		return hexutil.Encode(rv.Bytes())
+	case rv.Kind() == reflect.Array && rv.Type().Elem().Kind() == reflect.Uint8 && rv.Type() != addressType &&
+		rv.Type() != hashType:
+		b := make([]byte, rv.Len())
+		reflect.Copy(reflect.ValueOf(b), rv)
+
+		return hexutil.Encode(b)
+	default:
+		return v
+	}
+}
+
+var (
+	addressType = reflect.TypeOf(common.Address{})
+	hashType    = reflect.TypeOf(common.Hash{})
+)
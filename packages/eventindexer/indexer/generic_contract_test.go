@@ -0,0 +1,137 @@
+package indexer
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// nolint: lll
+const depositedABI = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"user","type":"address"},{"indexed":true,"name":"id","type":"bytes32"},{"indexed":false,"name":"amount","type":"uint256"},{"indexed":false,"name":"memo","type":"bytes"}],"name":"Deposited","type":"event"}]`
+
+var genericContractAddress = common.HexToAddress("0x63FaC9201494f0bd17B9892B9fae4d52fe3BD377")
+
+func writeGenericContracts(t *testing.T, config string) string {
+	dir := t.TempDir()
+
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "Vault.json"), []byte(`{"abi":`+depositedABI+`}`), 0600))
+
+	path := filepath.Join(dir, "contracts.json")
+	assert.Nil(t, os.WriteFile(path, []byte(config), 0600))
+
+	return path
+}
+
+func Test_LoadGenericContracts(t *testing.T) {
+	address := genericContractAddress.Hex()
+
+	tests := []struct {
+		name    string
+		config  string
+		wantErr bool
+	}{
+		{
+			"inlineABI",
+			`[{"name":"Vault","address":"` + address + `","abi":` + depositedABI +
+				`,"events":[{"name":"Deposited","addressArg":"user"}]}]`,
+			false,
+		},
+		{
+			"abiFile",
+			`[{"name":"Vault","address":"` + address + `","abiFile":"Vault.json","events":[{"name":"Deposited"}]}]`,
+			false,
+		},
+		{
+			"builtinName",
+			`[{"name":"blockProposed","address":"` + address + `","abiFile":"Vault.json",` +
+				`"events":[{"name":"Deposited"}]}]`,
+			true,
+		},
+		{
+			"invalidAddress",
+			`[{"name":"Vault","address":"0x1","abiFile":"Vault.json","events":[{"name":"Deposited"}]}]`,
+			true,
+		},
+		{
+			"eventNotInABI",
+			`[{"name":"Vault","address":"` + address + `","abiFile":"Vault.json","events":[{"name":"Withdrawn"}]}]`,
+			true,
+		},
+		{
+			"addressArgNotAnAddress",
+			`[{"name":"Vault","address":"` + address + `","abiFile":"Vault.json",` +
+				`"events":[{"name":"Deposited","addressArg":"amount"}]}]`,
+			true,
+		},
+		{
+			"noEvents",
+			`[{"name":"Vault","address":"` + address + `","abiFile":"Vault.json","events":[]}]`,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contracts, err := LoadGenericContracts(writeGenericContracts(t, tt.config))
+			assert.Equal(t, tt.wantErr, err != nil)
+
+			if !tt.wantErr {
+				assert.Equal(t, 1, len(contracts))
+				assert.Equal(t, genericContractAddress, contracts[0].Address)
+				assert.Equal(t, 1, len(contracts[0].Events))
+			}
+		})
+	}
+}
+
+func Test_GenericContract_decode(t *testing.T) {
+	contracts, err := LoadGenericContracts(writeGenericContracts(t,
+		`[{"name":"Vault","address":"`+genericContractAddress.Hex()+`","abiFile":"Vault.json",`+
+			`"events":[{"name":"Deposited","addressArg":"user"}]}]`,
+	))
+	assert.Nil(t, err)
+
+	contract := contracts[0]
+	deposited := contract.ABI.Events["Deposited"]
+
+	data, err := deposited.Inputs.NonIndexed().Pack(big.NewInt(100), []byte{0x01, 0x02})
+	assert.Nil(t, err)
+
+	user := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	id := common.HexToHash("0xff")
+
+	vLog := types.Log{
+		Address: genericContractAddress,
+		Topics:  []common.Hash{deposited.ID, common.BytesToHash(user.Bytes()), id},
+		Data:    data,
+	}
+
+	event, args, address, err := contract.decode(vLog)
+	assert.Nil(t, err)
+	assert.Equal(t, "Deposited", event.Event.Name)
+	assert.Equal(t, user, address)
+	assert.Equal(t, map[string]interface{}{
+		"user":   user,
+		"id":     id.Hex(),
+		"amount": big.NewInt(100),
+		"memo":   "0x0102",
+	}, args)
+
+	vLog.Address = common.HexToAddress("0x0000000000000000000000000000000000000002")
+
+	event, _, _, err = contract.decode(vLog)
+	assert.Nil(t, err)
+	assert.Nil(t, event)
+
+	vLog.Address = genericContractAddress
+	vLog.Topics = []common.Hash{common.HexToHash("0x1")}
+
+	event, _, _, err = contract.decode(vLog)
+	assert.Nil(t, err)
+	assert.Nil(t, event)
+}
@@ -160,34 +160,17 @@ func (i *Indexer) saveERC20Transfer(ctx context.Context, chainID *big.Int, vLog
 	}
 
 	// increment To address's balance
-	// decrement From address's balance
-	increaseOpts := eventindexer.UpdateERC20BalanceOpts{
-		ERC20MetadataID: int64(pk),
+	// decrement From address's balance, unless it is the zero address of a "mint"
+	return i.applyBalanceChange(ctx, eventindexer.SaveBalanceChangeOpts{
 		ChainID:         chainID.Int64(),
-		Address:         to,
+		BlockID:         vLog.BlockNumber,
+		ContractType:    "ERC20",
 		ContractAddress: vLog.Address.Hex(),
+		ERC20MetadataID: int64(pk),
+		FromAddress:     from,
+		ToAddress:       to,
 		Amount:          amount,
-	}
-
-	decreaseOpts := eventindexer.UpdateERC20BalanceOpts{}
-
-	// ignore zero address since that is usually the "mint"
-	if from != ZeroAddress.Hex() {
-		decreaseOpts = eventindexer.UpdateERC20BalanceOpts{
-			ERC20MetadataID: int64(pk),
-			ChainID:         chainID.Int64(),
-			Address:         from,
-			ContractAddress: vLog.Address.Hex(),
-			Amount:          amount,
-		}
-	}
-
-	_, _, err = i.erc20BalanceRepo.IncreaseAndDecreaseBalancesInTx(ctx, increaseOpts, decreaseOpts)
-	if err != nil {
-		return errors.Wrap(err, "i.erc20BalanceRepo.IncreaseAndDecreaseBalancesInTx")
-	}
-
-	return nil
+	})
 }
 
 func getERC20Symbol(ctx context.Context, client *ethclient.Client, contractAddress string) (string, error) {
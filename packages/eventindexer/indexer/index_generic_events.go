@@ -0,0 +1,117 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"log/slog"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"golang.org/x/sync/errgroup"
+)
+
+// indexGenericEvents parses the logs emitted by the generic contracts into their events,
+// with the ABIs of the contracts, and saves them.
+func (i *Indexer) indexGenericEvents(
+	ctx context.Context,
+	chainID *big.Int,
+	logs []types.Log,
+) error {
+	wg, ctx := errgroup.WithContext(ctx)
+
+	for _, vLog := range logs {
+		l := vLog
+
+		for _, c := range i.genericContracts {
+			if l.Address != c.Address {
+				continue
+			}
+
+			contract := c
+
+			wg.Go(func() error {
+				if err := i.saveGenericEvent(ctx, chainID, contract, l); err != nil {
+					eventindexer.GenericEventsProcessedError.Inc()
+
+					return errors.Wrap(err, "i.saveGenericEvent")
+				}
+
+				return nil
+			})
+		}
+	}
+
+	if err := wg.Wait(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (i *Indexer) saveGenericEvent(
+	ctx context.Context,
+	chainID *big.Int,
+	contract *GenericContract,
+	vLog types.Log,
+) error {
+	event, args, address, err := contract.decode(vLog)
+	if err != nil {
+		// a log which does not match the ABI of the contract is skipped, rather than halting
+		// the indexing of the blocks after it.
+		slog.Error("error decoding generic event",
+			"contract", contract.Name,
+			"txHash", vLog.TxHash.Hex(),
+			"logIndex", vLog.Index,
+			"error", err,
+		)
+
+		eventindexer.GenericEventsProcessedError.Inc()
+
+		return nil
+	}
+
+	if event == nil {
+		return nil
+	}
+
+	slog.Info("generic event found",
+		"contract", contract.Name,
+		"event", event.Event.Name,
+		"address", address.Hex(),
+		"blockNum", vLog.BlockNumber,
+	)
+
+	marshaled, err := json.Marshal(args)
+	if err != nil {
+		return errors.Wrap(err, "json.Marshal(args)")
+	}
+
+	header, err := i.ethClient.HeaderByNumber(ctx, new(big.Int).SetUint64(vLog.BlockNumber))
+	if err != nil {
+		return errors.Wrap(err, "i.ethClient.HeaderByNumber")
+	}
+
+	contractAddress := contract.Address.Hex()
+
+	_, err = i.eventRepo.Save(ctx, eventindexer.SaveEventOpts{
+		Name:            contract.Name,
+		Data:            string(marshaled),
+		ChainID:         chainID,
+		Event:           event.Event.Name,
+		Address:         address.Hex(),
+		ContractAddress: &contractAddress,
+		TransactedAt:    time.Unix(int64(header.Time), 0),
+		EmittedBlockID:  vLog.BlockNumber,
+	})
+	if err != nil {
+		return errors.Wrap(err, "i.eventRepo.Save")
+	}
+
+	eventindexer.GenericEventsProcessed.Inc()
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package indexer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/mock"
+)
+
+func Test_indexGenericEvents_undecodable(t *testing.T) {
+	contracts, err := LoadGenericContracts(writeGenericContracts(t,
+		`[{"name":"Vault","address":"`+genericContractAddress.Hex()+`","abiFile":"Vault.json",`+
+			`"events":[{"name":"Deposited","addressArg":"user"}]}]`,
+	))
+	assert.Nil(t, err)
+
+	i := newTestIndexer()
+	i.genericContracts = contracts
+
+	// the log is missing its indexed arguments and its data.
+	vLog := types.Log{
+		Address: genericContractAddress,
+		Topics:  []common.Hash{contracts[0].ABI.Events["Deposited"].ID},
+	}
+
+	assert.Nil(t, i.indexGenericEvents(context.Background(), mock.MockChainID, []types.Log{vLog}))
+}
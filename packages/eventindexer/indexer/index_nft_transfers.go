@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"strconv"
 	"strings"
 
 	"log/slog"
@@ -127,35 +128,17 @@ func (i *Indexer) saveERC721Transfer(ctx context.Context, chainID *big.Int, vLog
 	)
 
 	// increment To address's balance
-	// decrement From address's balance
-	increaseOpts := eventindexer.UpdateNFTBalanceOpts{
+	// decrement From address's balance, unless it is the zero address of a "mint"
+	return i.applyBalanceChange(ctx, eventindexer.SaveBalanceChangeOpts{
 		ChainID:         chainID.Int64(),
-		Address:         to,
-		TokenID:         tokenID,
-		ContractAddress: vLog.Address.Hex(),
+		BlockID:         vLog.BlockNumber,
 		ContractType:    "ERC721",
-		Amount:          1, // ERC721 is always 1
-	}
-	decreaseOpts := eventindexer.UpdateNFTBalanceOpts{}
-
-	// ignore zero address since that is usually the "mint"
-	if from != ZeroAddress.Hex() {
-		decreaseOpts = eventindexer.UpdateNFTBalanceOpts{
-			ChainID:         chainID.Int64(),
-			Address:         from,
-			TokenID:         tokenID,
-			ContractAddress: vLog.Address.Hex(),
-			ContractType:    "ERC721",
-			Amount:          1, // ERC721 is always 1
-		}
-	}
-
-	_, _, err := i.nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(ctx, increaseOpts, decreaseOpts)
-	if err != nil {
-		return err
-	}
-
-	return nil
+		ContractAddress: vLog.Address.Hex(),
+		TokenID:         tokenID,
+		FromAddress:     from,
+		ToAddress:       to,
+		Amount:          "1", // ERC721 is always 1
+	})
 }
 
 // saveERC1155Transfer parses and saves either a TransferSingle or TransferBatch event to
@@ -189,29 +172,16 @@ func (i *Indexer) saveERC1155Transfer(ctx context.Context, chainID *big.Int, vLo
 			return err
 		}
 
-		increaseOpts := eventindexer.UpdateNFTBalanceOpts{
+		err = i.applyBalanceChange(ctx, eventindexer.SaveBalanceChangeOpts{
 			ChainID:         chainID.Int64(),
-			Address:         to,
-			TokenID:         t.Id.Int64(),
-			ContractAddress: vLog.Address.Hex(),
+			BlockID:         vLog.BlockNumber,
 			ContractType:    "ERC1155",
-			Amount:          t.Value.Int64(),
-		}
-		decreaseOpts := eventindexer.UpdateNFTBalanceOpts{}
-
-		if from != ZeroAddress.Hex() {
-			// decrement From address's balance
-			decreaseOpts = eventindexer.UpdateNFTBalanceOpts{
-				ChainID:         chainID.Int64(),
-				Address:         from,
-				TokenID:         t.Id.Int64(),
-				ContractAddress: vLog.Address.Hex(),
-				ContractType:    "ERC1155",
-				Amount:          t.Value.Int64(),
-			}
-		}
-
-		_, _, err = i.nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(ctx, increaseOpts, decreaseOpts)
+			ContractAddress: vLog.Address.Hex(),
+			TokenID:         t.Id.Int64(),
+			FromAddress:     from,
+			ToAddress:       to,
+			Amount:          strconv.FormatInt(t.Value.Int64(), 10),
+		})
 		if err != nil {
 			return err
 		}
@@ -232,29 +202,16 @@ func (i *Indexer) saveERC1155Transfer(ctx context.Context, chainID *big.Int, vLo
 		}
 
 		for idx, id := range t.Ids {
-			increaseOpts := eventindexer.UpdateNFTBalanceOpts{
+			err = i.applyBalanceChange(ctx, eventindexer.SaveBalanceChangeOpts{
 				ChainID:         chainID.Int64(),
-				Address:         to,
-				TokenID:         id.Int64(),
-				ContractAddress: vLog.Address.Hex(),
+				BlockID:         vLog.BlockNumber,
 				ContractType:    "ERC1155",
-				Amount:          t.Values[idx].Int64(),
-			}
-			decreaseOpts := eventindexer.UpdateNFTBalanceOpts{}
-
-			if from != ZeroAddress.Hex() {
-				// decrement From address's balance
-				decreaseOpts = eventindexer.UpdateNFTBalanceOpts{
-					ChainID:         chainID.Int64(),
-					Address:         from,
-					TokenID:         id.Int64(),
-					ContractAddress: vLog.Address.Hex(),
-					ContractType:    "ERC1155",
-					Amount:          t.Values[idx].Int64(),
-				}
-			}
-
-			_, _, err = i.nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(ctx, increaseOpts, decreaseOpts)
+				ContractAddress: vLog.Address.Hex(),
+				TokenID:         id.Int64(),
+				FromAddress:     from,
+				ToAddress:       to,
+				Amount:          strconv.FormatInt(t.Values[idx].Int64(), 10),
+			})
 			if err != nil {
 				return err
 			}
@@ -116,6 +116,16 @@ func (i *Indexer) indexRawBlockData(
 		})
 	}
 
+	if len(i.genericContracts) > 0 {
+		wg.Go(func() error {
+			if err := i.indexGenericEvents(ctx, chainID, logs); err != nil {
+				return errors.Wrap(err, "svc.indexGenericEvents")
+			}
+
+			return nil
+		})
+	}
+
 	if err := wg.Wait(); err != nil {
 		if errors.Is(err, context.Canceled) {
 			slog.Error("index raw block data context cancelled")
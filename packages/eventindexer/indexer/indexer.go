@@ -40,17 +40,27 @@ var (
 type Indexer struct {
 	db db.DB
 
-	accountRepo      eventindexer.AccountRepository
-	eventRepo        eventindexer.EventRepository
-	nftBalanceRepo   eventindexer.NFTBalanceRepository
-	erc20BalanceRepo eventindexer.ERC20BalanceRepository
-	txRepo           eventindexer.TransactionRepository
+	accountRepo       eventindexer.AccountRepository
+	eventRepo         eventindexer.EventRepository
+	nftBalanceRepo    eventindexer.NFTBalanceRepository
+	erc20BalanceRepo  eventindexer.ERC20BalanceRepository
+	txRepo            eventindexer.TransactionRepository
+	indexedBlockRepo  eventindexer.IndexedBlockRepository
+	balanceChangeRepo eventindexer.BalanceChangeRepository
+	batchRepo         eventindexer.BatchRepository
 
 	ethClient  *ethclient.Client
 	srcChainID uint64
 
 	latestIndexedBlockNumber uint64
 
+	// lastIndexedBlock is the last block of the last indexed batch, the first block of the
+	// next batch must build on it.
+	lastIndexedBlock *eventindexer.IndexedBlock
+
+	// confirmations is how many blocks behind the head of the chain the indexer stays.
+	confirmations uint64
+
 	blockBatchSize      uint64
 	subscriptionBackoff time.Duration
 
@@ -63,6 +73,9 @@ type Indexer struct {
 	indexERC20s bool
 	layer       string
 
+	// genericContracts are the contracts whose events are indexed from their ABIs.
+	genericContracts []*GenericContract
+
 	wg  *sync.WaitGroup
 	ctx context.Context
 
@@ -158,6 +171,21 @@ func InitFromConfig(ctx context.Context, i *Indexer, cfg *Config) error {
 		return err
 	}
 
+	indexedBlockRepository, err := repo.NewIndexedBlockRepository(db)
+	if err != nil {
+		return err
+	}
+
+	balanceChangeRepository, err := repo.NewBalanceChangeRepository(db)
+	if err != nil {
+		return err
+	}
+
+	batchRepository, err := repo.NewBatchRepository(db)
+	if err != nil {
+		return err
+	}
+
 	ethClient, err := ethclient.Dial(cfg.RPCUrl)
 	if err != nil {
 		return err
@@ -211,6 +239,9 @@ func InitFromConfig(ctx context.Context, i *Indexer, cfg *Config) error {
 	i.nftBalanceRepo = nftBalanceRepository
 	i.erc20BalanceRepo = erc20BalanceRepository
 	i.txRepo = txRepository
+	i.indexedBlockRepo = indexedBlockRepository
+	i.balanceChangeRepo = balanceChangeRepository
+	i.batchRepo = batchRepository
 
 	i.srcChainID = chainID.Uint64()
 
@@ -220,6 +251,7 @@ func InitFromConfig(ctx context.Context, i *Indexer, cfg *Config) error {
 	i.taikol1V2 = taikol1V2
 	i.bridge = bridgeContract
 	i.blockBatchSize = cfg.BlockBatchSize
+	i.confirmations = cfg.Confirmations
 	i.subscriptionBackoff = time.Duration(cfg.SubscriptionBackoff) * time.Second
 	i.wg = &sync.WaitGroup{}
 
@@ -227,6 +259,7 @@ func InitFromConfig(ctx context.Context, i *Indexer, cfg *Config) error {
 	i.indexNfts = cfg.IndexNFTs
 	i.indexERC20s = cfg.IndexERC20s
 	i.layer = cfg.Layer
+	i.genericContracts = cfg.GenericContracts
 	i.contractToMetadata = make(map[common.Address]*eventindexer.ERC20Metadata, 0)
 	i.contractToMetadataMutex = &sync.Mutex{}
 	i.ontakeForkHeight = cfg.OntakeForkHeight
@@ -104,6 +104,21 @@ func (i *Indexer) saveBatchProposedEvent(
 		return errors.Wrap(err, "i.eventRepo.Save")
 	}
 
+	err = i.batchRepo.SaveProposed(ctx, eventindexer.SaveBatchProposedOpts{
+		ChainID:         chainID.Int64(),
+		BatchID:         batchId,
+		Proposer:        sender.Hex(),
+		LastBlockID:     lastBlockId,
+		NumBlocks:       numBlocks,
+		BlobCount:       int64(len(event.Info.BlobHashes)),
+		ProposedTxHash:  event.Raw.TxHash.Hex(),
+		ProposedBlockID: event.Raw.BlockNumber,
+		ProposedAt:      time.Unix(int64(block.Time()), 0).UTC(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "i.batchRepo.SaveProposed")
+	}
+
 	eventindexer.BatchProposedEventsProcessed.Inc()
 
 	return nil
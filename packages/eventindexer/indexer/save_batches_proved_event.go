@@ -70,9 +70,23 @@ func (i *Indexer) saveBatchesProvedEvent(
 		return errors.Wrap(err, "i.ethClient.BlockByNumber")
 	}
 
+	tx, _, err := i.ethClient.TransactionByHash(ctx, event.Raw.TxHash)
+	if err != nil {
+		return errors.Wrap(err, "i.ethClient.TransactionByHash")
+	}
+
+	prover, err := i.ethClient.TransactionSender(ctx, tx, event.Raw.BlockHash, event.Raw.TxIndex)
+	if err != nil {
+		return errors.Wrap(err, "i.ethClient.TransactionSender")
+	}
+
+	batchIDs := make([]int64, 0, len(event.BatchIds))
+
 	for _, batchID := range event.BatchIds {
 		bID := int64(batchID)
 
+		batchIDs = append(batchIDs, bID)
+
 		_, err = i.eventRepo.Save(ctx, eventindexer.SaveEventOpts{
 			Name:           eventindexer.EventNameBatchesProven,
 			Data:           string(marshaled),
@@ -88,6 +102,19 @@ func (i *Indexer) saveBatchesProvedEvent(
 		}
 	}
 
+	err = i.batchRepo.SaveProved(ctx, eventindexer.SaveBatchesProvedOpts{
+		ChainID:       chainID.Int64(),
+		BatchIDs:      batchIDs,
+		ProvedTxHash:  event.Raw.TxHash.Hex(),
+		Prover:        prover.Hex(),
+		Verifier:      event.Verifier.Hex(),
+		ProvedBlockID: event.Raw.BlockNumber,
+		ProvedAt:      time.Unix(int64(block.Time()), 0),
+	})
+	if err != nil {
+		return errors.Wrap(err, "i.batchRepo.SaveProved")
+	}
+
 	eventindexer.BatchesProvedEventsProcessed.Inc()
 
 	return nil
@@ -84,6 +84,17 @@ func (i *Indexer) saveBatchesVerifiedEvent(
 		return errors.Wrap(err, "i.eventRepo.Save")
 	}
 
+	err = i.batchRepo.SaveVerified(ctx, eventindexer.SaveBatchesVerifiedOpts{
+		ChainID:         chainID.Int64(),
+		BatchID:         batchID,
+		VerifiedTxHash:  event.Raw.TxHash.Hex(),
+		VerifiedBlockID: event.Raw.BlockNumber,
+		VerifiedAt:      time.Unix(int64(block.Time()), 0),
+	})
+	if err != nil {
+		return errors.Wrap(err, "i.batchRepo.SaveVerified")
+	}
+
 	eventindexer.BatchesVerifiedEventsProcessed.Inc()
 
 	return nil
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS indexed_blocks (
+    id int NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    chain_id BIGINT UNSIGNED NOT NULL,
+    block_id BIGINT UNSIGNED NOT NULL,
+    block_hash VARCHAR(66) NOT NULL,
+    parent_hash VARCHAR(66) NOT NULL,
+    created_at DATETIME(3) NOT NULL,
+    UNIQUE KEY `indexed_blocks_chain_id_block_id_index` (`chain_id`, `block_id`)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE indexed_blocks;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS balance_changes (
+    id int NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    chain_id int NOT NULL,
+    block_id BIGINT UNSIGNED NOT NULL,
+    contract_type VARCHAR(7) NOT NULL,
+    contract_address VARCHAR(42) NOT NULL,
+    token_id BIGINT NOT NULL DEFAULT 0,
+    erc20_metadata_id int NOT NULL DEFAULT 0,
+    from_address VARCHAR(42) NOT NULL,
+    to_address VARCHAR(42) NOT NULL,
+    amount VARCHAR(200) NOT NULL,
+    created_at DATETIME(3) NOT NULL,
+    INDEX `balance_changes_chain_id_block_id_index` (`chain_id`, `block_id`)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE balance_changes;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS batches (
+    id int NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    chain_id int NOT NULL,
+    batch_id BIGINT NOT NULL,
+    proposer VARCHAR(42) NOT NULL,
+    first_block_id BIGINT NOT NULL,
+    last_block_id BIGINT NOT NULL,
+    num_blocks BIGINT NOT NULL,
+    blob_count BIGINT NOT NULL,
+    proposed_tx_hash VARCHAR(66) NOT NULL,
+    proposed_block_id BIGINT UNSIGNED NOT NULL,
+    proposed_at DATETIME NOT NULL,
+    proved_tx_hash VARCHAR(66),
+    prover VARCHAR(42),
+    verifier VARCHAR(42),
+    proved_block_id BIGINT UNSIGNED,
+    proved_at DATETIME,
+    verified_tx_hash VARCHAR(66),
+    verified_block_id BIGINT UNSIGNED,
+    verified_at DATETIME,
+    UNIQUE KEY `batches_chain_id_batch_id_key` (`chain_id`, `batch_id`),
+    INDEX `batches_proposer_index` (`proposer`),
+    INDEX `batches_prover_index` (`prover`)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE batches;
+-- +goose StatementEnd
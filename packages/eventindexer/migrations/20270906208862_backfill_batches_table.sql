@@ -0,0 +1,79 @@
+-- +goose Up
+-- Backfills the batches table from the batch events indexed before it was created, from the
+-- latest event of each batch. The prover is the sender of the prove transaction, which the
+-- events do not keep, so it is left empty until the batch is proved again or the indexer resyncs.
+-- +goose StatementBegin
+INSERT IGNORE INTO batches (
+    chain_id,
+    batch_id,
+    proposer,
+    first_block_id,
+    last_block_id,
+    num_blocks,
+    blob_count,
+    proposed_tx_hash,
+    proposed_block_id,
+    proposed_at
+)
+SELECT
+    e.chain_id,
+    e.batch_id,
+    e.address,
+    e.block_id - e.num_blocks + 1,
+    e.block_id,
+    e.num_blocks,
+    CASE
+        WHEN JSON_TYPE(JSON_EXTRACT(e.data, '$.Info.BlobHashes')) = 'ARRAY'
+        THEN JSON_LENGTH(e.data, '$.Info.BlobHashes')
+        ELSE 0
+    END,
+    JSON_UNQUOTE(JSON_EXTRACT(e.data, '$.Raw.transactionHash')),
+    e.emitted_block_id,
+    e.transacted_at
+FROM events e
+WHERE e.event = 'BatchProposed'
+    AND e.batch_id IS NOT NULL
+    AND e.block_id IS NOT NULL
+    AND e.num_blocks IS NOT NULL
+    AND e.id = (
+        SELECT MAX(l.id) FROM events l
+        WHERE l.event = 'BatchProposed' AND l.chain_id = e.chain_id AND l.batch_id = e.batch_id
+    );
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE batches b
+JOIN events e ON e.event = 'BatchesProved'
+    AND e.chain_id = b.chain_id
+    AND e.batch_id = b.batch_id
+    AND e.id = (
+        SELECT MAX(l.id) FROM events l
+        WHERE l.event = 'BatchesProved' AND l.chain_id = b.chain_id AND l.batch_id = b.batch_id
+    )
+SET
+    b.proved_tx_hash = JSON_UNQUOTE(JSON_EXTRACT(e.data, '$.Raw.transactionHash')),
+    b.verifier = e.address,
+    b.proved_block_id = e.emitted_block_id,
+    b.proved_at = e.transacted_at
+WHERE b.proved_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE batches b
+JOIN events e ON e.event = 'BatchesVerified'
+    AND e.chain_id = b.chain_id
+    AND e.id = (
+        SELECT l.id FROM events l
+        WHERE l.event = 'BatchesVerified' AND l.chain_id = b.chain_id AND l.batch_id >= b.batch_id
+        ORDER BY l.batch_id, l.id
+        LIMIT 1
+    )
+SET
+    b.verified_tx_hash = JSON_UNQUOTE(JSON_EXTRACT(e.data, '$.Raw.transactionHash')),
+    b.verified_block_id = e.emitted_block_id,
+    b.verified_at = e.transacted_at
+WHERE b.verified_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- The backfilled batches are kept, they cannot be told apart from the indexed ones.
@@ -0,0 +1,140 @@
+-- +goose Up
+-- Postgres schema of the eventindexer, equivalent to the MySQL migrations up to
+-- 20270906208857_alter_events_table_add_event_batch_id_index.sql.
+-- Addresses are compared case-insensitively by the MySQL default collation, so they are CITEXT here.
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS citext;
+
+CREATE TABLE IF NOT EXISTS events (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(24) NOT NULL,
+    event VARCHAR(24) NOT NULL DEFAULT '',
+    chain_id INT NOT NULL,
+    data JSONB NOT NULL,
+    address CITEXT NOT NULL DEFAULT '',
+    block_id INT DEFAULT NULL,
+    emitted_block_id BIGINT NOT NULL,
+    amount NUMERIC(65, 0) DEFAULT NULL,
+    proof_reward VARCHAR(255) DEFAULT NULL,
+    proposer_reward VARCHAR(255) DEFAULT NULL,
+    assigned_prover CITEXT NOT NULL DEFAULT '',
+    fee_token_address CITEXT DEFAULT '',
+    token_id INT DEFAULT NULL,
+    "to" CITEXT DEFAULT '',
+    contract_address CITEXT DEFAULT '',
+    tier INT DEFAULT NULL,
+    num_blocks INT DEFAULT NULL,
+    batch_id INT DEFAULT NULL,
+    transacted_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX address_index ON events (address);
+CREATE INDEX event_index ON events (event);
+CREATE INDEX block_id_index ON events (block_id);
+CREATE INDEX address_and_event_index ON events (address, event);
+CREATE INDEX event_transacted_at_tier_index ON events (transacted_at, event, tier);
+CREATE INDEX event_transacted_at_index ON events (transacted_at, event);
+CREATE INDEX event_block_id_index ON events (event, block_id);
+CREATE INDEX event_tier_index ON events (event, tier);
+CREATE INDEX events_block_id_event_index ON events (block_id, event);
+CREATE INDEX events_block_id_event_num_blocks_index ON events (block_id, event, num_blocks);
+CREATE INDEX event_event_batch_id_index ON events (event, batch_id);
+
+CREATE TABLE IF NOT EXISTS nft_balances (
+    id SERIAL PRIMARY KEY,
+    chain_id INT NOT NULL,
+    address CITEXT NOT NULL DEFAULT '',
+    amount NUMERIC(65, 0) DEFAULT NULL,
+    contract_address CITEXT NOT NULL DEFAULT '',
+    contract_type VARCHAR(7) NOT NULL DEFAULT 'ERC721',
+    token_id NUMERIC(65, 0) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX address_and_chain_id_index ON nft_balances (address, chain_id);
+CREATE INDEX address_contract_address_token_id_and_chain_id_index
+    ON nft_balances (address, contract_address, token_id, chain_id);
+
+CREATE TABLE IF NOT EXISTS transactions (
+    id SERIAL PRIMARY KEY,
+    chain_id INT NOT NULL,
+    sender CITEXT NOT NULL,
+    recipient CITEXT DEFAULT NULL,
+    block_id INT NOT NULL,
+    amount NUMERIC(65, 0) DEFAULT NULL,
+    gas_price VARCHAR(20) NOT NULL,
+    contract_address CITEXT DEFAULT '',
+    transacted_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX transacted_at_index ON transactions (transacted_at);
+CREATE INDEX transacted_at_contract_address_index ON transactions (transacted_at, contract_address);
+
+CREATE TABLE IF NOT EXISTS time_series_data (
+    id SERIAL PRIMARY KEY,
+    task VARCHAR(40) NOT NULL,
+    value NUMERIC(65, 0) NOT NULL,
+    date VARCHAR(20) NOT NULL,
+    fee_token_address CITEXT DEFAULT '',
+    tier INT DEFAULT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT task_date UNIQUE (task, date, tier, fee_token_address)
+);
+
+CREATE TABLE IF NOT EXISTS accounts (
+    id SERIAL PRIMARY KEY,
+    address CITEXT NOT NULL UNIQUE,
+    transacted_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX accounts_transacted_at_index ON accounts (transacted_at);
+
+CREATE TABLE IF NOT EXISTS erc20_metadata (
+    id SERIAL PRIMARY KEY,
+    chain_id INT NOT NULL,
+    symbol VARCHAR(42) NOT NULL,
+    decimals INT NOT NULL,
+    contract_address CITEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (id, chain_id)
+);
+
+CREATE INDEX erc20_balance_chain_id_contract_address_index ON erc20_metadata (chain_id, contract_address);
+
+-- amount is numeric, so the balances can be compared with numbers as MySQL does with its VARCHAR column
+CREATE TABLE IF NOT EXISTS erc20_balances (
+    id SERIAL PRIMARY KEY,
+    erc20_metadata_id INT NOT NULL,
+    chain_id INT NOT NULL,
+    address CITEXT NOT NULL DEFAULT '',
+    amount NUMERIC(78, 0) DEFAULT 0,
+    contract_address CITEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (erc20_metadata_id, chain_id) REFERENCES erc20_metadata (id, chain_id)
+);
+
+CREATE INDEX erc20_balance_chain_id_amount_address_index ON erc20_balances (chain_id, amount, address);
+CREATE INDEX erc20_balances_contract_address_address_chain_id_index
+    ON erc20_balances (contract_address, address, chain_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE erc20_balances;
+DROP TABLE erc20_metadata;
+DROP TABLE accounts;
+DROP TABLE time_series_data;
+DROP TABLE transactions;
+DROP TABLE nft_balances;
+DROP TABLE events;
+-- +goose StatementEnd
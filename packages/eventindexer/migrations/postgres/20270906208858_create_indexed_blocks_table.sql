@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS indexed_blocks (
+    id SERIAL PRIMARY KEY,
+    chain_id NUMERIC(20, 0) NOT NULL,
+    block_id NUMERIC(20, 0) NOT NULL,
+    block_hash CITEXT NOT NULL,
+    parent_hash CITEXT NOT NULL,
+    created_at TIMESTAMP(3) NOT NULL
+);
+
+CREATE UNIQUE INDEX indexed_blocks_chain_id_block_id_index ON indexed_blocks (chain_id, block_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE indexed_blocks;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS balance_changes (
+    id SERIAL PRIMARY KEY,
+    chain_id INT NOT NULL,
+    block_id NUMERIC(20, 0) NOT NULL,
+    contract_type VARCHAR(7) NOT NULL,
+    contract_address CITEXT NOT NULL,
+    token_id BIGINT NOT NULL DEFAULT 0,
+    erc20_metadata_id INT NOT NULL DEFAULT 0,
+    from_address CITEXT NOT NULL,
+    to_address CITEXT NOT NULL,
+    amount NUMERIC(78, 0) NOT NULL,
+    created_at TIMESTAMP(3) NOT NULL
+);
+
+CREATE INDEX balance_changes_chain_id_block_id_index ON balance_changes (chain_id, block_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE balance_changes;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS batches (
+    id SERIAL PRIMARY KEY,
+    chain_id INT NOT NULL,
+    batch_id BIGINT NOT NULL,
+    proposer CITEXT NOT NULL,
+    first_block_id BIGINT NOT NULL,
+    last_block_id BIGINT NOT NULL,
+    num_blocks BIGINT NOT NULL,
+    blob_count BIGINT NOT NULL,
+    proposed_tx_hash CITEXT NOT NULL,
+    proposed_block_id NUMERIC(20, 0) NOT NULL,
+    proposed_at TIMESTAMP NOT NULL,
+    proved_tx_hash CITEXT,
+    prover CITEXT,
+    verifier CITEXT,
+    proved_block_id NUMERIC(20, 0),
+    proved_at TIMESTAMP,
+    verified_tx_hash CITEXT,
+    verified_block_id NUMERIC(20, 0),
+    verified_at TIMESTAMP,
+    UNIQUE (chain_id, batch_id)
+);
+
+CREATE INDEX batches_proposer_index ON batches (proposer);
+CREATE INDEX batches_prover_index ON batches (prover);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE batches;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE indexed_blocks ADD COLUMN block_timestamp NUMERIC(20, 0) NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE indexed_blocks DROP COLUMN block_timestamp;
+-- +goose StatementEnd
@@ -0,0 +1,77 @@
+-- +goose Up
+-- Backfills the batches table from the batch events indexed before it was created, from the
+-- latest event of each batch. The prover is the sender of the prove transaction, which the
+-- events do not keep, so it is left empty until the batch is proved again or the indexer resyncs.
+-- +goose StatementBegin
+INSERT INTO batches (
+    chain_id,
+    batch_id,
+    proposer,
+    first_block_id,
+    last_block_id,
+    num_blocks,
+    blob_count,
+    proposed_tx_hash,
+    proposed_block_id,
+    proposed_at
+)
+SELECT DISTINCT ON (e.chain_id, e.batch_id)
+    e.chain_id,
+    e.batch_id,
+    e.address,
+    e.block_id - e.num_blocks + 1,
+    e.block_id,
+    e.num_blocks,
+    CASE
+        WHEN jsonb_typeof(e.data -> 'Info' -> 'BlobHashes') = 'array'
+        THEN jsonb_array_length(e.data -> 'Info' -> 'BlobHashes')
+        ELSE 0
+    END,
+    e.data -> 'Raw' ->> 'transactionHash',
+    e.emitted_block_id,
+    e.transacted_at
+FROM events e
+WHERE e.event = 'BatchProposed'
+    AND e.batch_id IS NOT NULL
+    AND e.block_id IS NOT NULL
+    AND e.num_blocks IS NOT NULL
+ORDER BY e.chain_id, e.batch_id, e.id DESC
+ON CONFLICT (chain_id, batch_id) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE batches b
+SET
+    proved_tx_hash = e.data -> 'Raw' ->> 'transactionHash',
+    verifier = e.address,
+    proved_block_id = e.emitted_block_id,
+    proved_at = e.transacted_at
+FROM (
+    SELECT DISTINCT ON (chain_id, batch_id) *
+    FROM events
+    WHERE event = 'BatchesProved'
+    ORDER BY chain_id, batch_id, id DESC
+) e
+WHERE e.chain_id = b.chain_id
+    AND e.batch_id = b.batch_id
+    AND b.proved_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE batches b
+SET
+    verified_tx_hash = e.data -> 'Raw' ->> 'transactionHash',
+    verified_block_id = e.emitted_block_id,
+    verified_at = e.transacted_at
+FROM events e
+WHERE b.verified_at IS NULL
+    AND e.id = (
+        SELECT l.id FROM events l
+        WHERE l.event = 'BatchesVerified' AND l.chain_id = b.chain_id AND l.batch_id >= b.batch_id
+        ORDER BY l.batch_id, l.id
+        LIMIT 1
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- The backfilled batches are kept, they cannot be told apart from the indexed ones.
@@ -28,6 +28,16 @@ type UpdateNFTBalanceOpts struct {
 	Amount          int64
 }
 
+// FindBalancesOpts filters the NFT or ERC20 balances, the unset fields match every balance.
+// Only the positive balances are returned, latest first, BeforeID is the cursor of the next page.
+type FindBalancesOpts struct {
+	ChainID         *int64
+	Address         *string
+	ContractAddress *string
+	BeforeID        int
+	Limit           int
+}
+
 // NFTBalanceRepository is used to interact with nft balances in the store
 type NFTBalanceRepository interface {
 	IncreaseAndDecreaseBalancesInTx(
@@ -40,4 +50,5 @@ type NFTBalanceRepository interface {
 		address string,
 		chainID string,
 	) (paginate.Page, error)
+	Find(ctx context.Context, opts FindBalancesOpts) ([]*NFTBalance, error)
 }
@@ -0,0 +1,123 @@
+package graphql
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+type accountFilter struct {
+	Address *string
+}
+
+type accountsArgs struct {
+	Filter *accountFilter
+	pageArgs
+}
+
+func (r *Resolver) Accounts(ctx context.Context, args accountsArgs) (*connection[*accountResolver], error) {
+	limit, err := args.limit()
+	if err != nil {
+		return nil, err
+	}
+
+	beforeID, err := args.position()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := eventindexer.FindAccountsOpts{
+		BeforeID: beforeID,
+		Limit:    limit + 1,
+	}
+
+	if args.Filter != nil {
+		opts.Address = args.Filter.Address
+	}
+
+	accounts, err := r.accountRepo.Find(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*accountResolver, 0, len(accounts))
+	for _, a := range accounts {
+		resolvers = append(resolvers, &accountResolver{r: r, a: a})
+	}
+
+	return newConnection(resolvers, limit, func(a *accountResolver) int {
+		return a.a.ID
+	}), nil
+}
+
+func (r *Resolver) Account(ctx context.Context, args struct{ Address string }) (*accountResolver, error) {
+	accounts, err := r.accountRepo.Find(ctx, eventindexer.FindAccountsOpts{
+		Address: &args.Address,
+		Limit:   1,
+	})
+	if err != nil || len(accounts) == 0 {
+		return nil, err
+	}
+
+	return &accountResolver{r: r, a: accounts[0]}, nil
+}
+
+// accountResolver resolves an account, and the events and balances of its address.
+type accountResolver struct {
+	r *Resolver
+	a *eventindexer.Account
+}
+
+func (a *accountResolver) ID() graphql.ID {
+	return graphql.ID(strconv.Itoa(a.a.ID))
+}
+
+func (a *accountResolver) Address() string {
+	return a.a.Address
+}
+
+func (a *accountResolver) TransactedAt() graphql.Time {
+	return graphql.Time{Time: a.a.TransactedAt}
+}
+
+func (a *accountResolver) Events(ctx context.Context, args eventsArgs) (*connection[*eventResolver], error) {
+	opts, err := args.findEventsOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Address = &a.a.Address
+
+	return a.r.findEvents(ctx, opts)
+}
+
+func (a *accountResolver) NFTBalances(
+	ctx context.Context,
+	args balancesArgs,
+) (*connection[*nftBalanceResolver], error) {
+	opts, err := args.findBalancesOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Address = &a.a.Address
+
+	return a.r.findNFTBalances(ctx, opts)
+}
+
+func (a *accountResolver) ERC20Balances(
+	ctx context.Context,
+	args balancesArgs,
+) (*connection[*erc20BalanceResolver], error) {
+	opts, err := args.findBalancesOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Address = &a.a.Address
+
+	return a.r.findERC20Balances(ctx, opts)
+}
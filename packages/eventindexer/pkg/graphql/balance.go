@@ -0,0 +1,180 @@
+package graphql
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+type balanceFilter struct {
+	ChainID         *Long
+	Address         *string
+	ContractAddress *string
+}
+
+type balancesArgs struct {
+	Filter *balanceFilter
+	pageArgs
+}
+
+// findBalancesOpts returns the repository filters of the given arguments.
+func (a balancesArgs) findBalancesOpts() (eventindexer.FindBalancesOpts, error) {
+	limit, err := a.limit()
+	if err != nil {
+		return eventindexer.FindBalancesOpts{}, err
+	}
+
+	beforeID, err := a.position()
+	if err != nil {
+		return eventindexer.FindBalancesOpts{}, err
+	}
+
+	opts := eventindexer.FindBalancesOpts{
+		BeforeID: beforeID,
+		Limit:    limit + 1,
+	}
+
+	if a.Filter != nil {
+		opts.ChainID = int64Ptr(a.Filter.ChainID)
+		opts.Address = a.Filter.Address
+		opts.ContractAddress = a.Filter.ContractAddress
+	}
+
+	return opts, nil
+}
+
+func (r *Resolver) NFTBalances(ctx context.Context, args balancesArgs) (*connection[*nftBalanceResolver], error) {
+	opts, err := args.findBalancesOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.findNFTBalances(ctx, opts)
+}
+
+func (r *Resolver) findNFTBalances(
+	ctx context.Context,
+	opts eventindexer.FindBalancesOpts,
+) (*connection[*nftBalanceResolver], error) {
+	balances, err := r.nftBalanceRepo.Find(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*nftBalanceResolver, 0, len(balances))
+	for _, b := range balances {
+		resolvers = append(resolvers, &nftBalanceResolver{b})
+	}
+
+	return newConnection(resolvers, opts.Limit-1, func(b *nftBalanceResolver) int {
+		return b.b.ID
+	}), nil
+}
+
+func (r *Resolver) ERC20Balances(
+	ctx context.Context,
+	args balancesArgs,
+) (*connection[*erc20BalanceResolver], error) {
+	opts, err := args.findBalancesOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.findERC20Balances(ctx, opts)
+}
+
+func (r *Resolver) findERC20Balances(
+	ctx context.Context,
+	opts eventindexer.FindBalancesOpts,
+) (*connection[*erc20BalanceResolver], error) {
+	balances, err := r.erc20BalanceRepo.Find(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*erc20BalanceResolver, 0, len(balances))
+	for _, b := range balances {
+		resolvers = append(resolvers, &erc20BalanceResolver{b})
+	}
+
+	return newConnection(resolvers, opts.Limit-1, func(b *erc20BalanceResolver) int {
+		return b.b.ID
+	}), nil
+}
+
+type nftBalanceResolver struct {
+	b *eventindexer.NFTBalance
+}
+
+func (r *nftBalanceResolver) ID() graphql.ID {
+	return graphql.ID(strconv.Itoa(r.b.ID))
+}
+
+func (r *nftBalanceResolver) ChainID() Long {
+	return Long(r.b.ChainID)
+}
+
+func (r *nftBalanceResolver) Address() string {
+	return r.b.Address
+}
+
+func (r *nftBalanceResolver) ContractAddress() string {
+	return r.b.ContractAddress
+}
+
+func (r *nftBalanceResolver) ContractType() string {
+	return r.b.ContractType
+}
+
+func (r *nftBalanceResolver) TokenID() Long {
+	return Long(r.b.TokenID)
+}
+
+func (r *nftBalanceResolver) Amount() Long {
+	return Long(r.b.Amount)
+}
+
+type erc20BalanceResolver struct {
+	b *eventindexer.ERC20Balance
+}
+
+func (r *erc20BalanceResolver) ID() graphql.ID {
+	return graphql.ID(strconv.Itoa(r.b.ID))
+}
+
+func (r *erc20BalanceResolver) ChainID() Long {
+	return Long(r.b.ChainID)
+}
+
+func (r *erc20BalanceResolver) Address() string {
+	return r.b.Address
+}
+
+func (r *erc20BalanceResolver) ContractAddress() string {
+	return r.b.ContractAddress
+}
+
+func (r *erc20BalanceResolver) Amount() string {
+	return r.b.Amount
+}
+
+func (r *erc20BalanceResolver) Symbol() *string {
+	if r.b.Metadata == nil {
+		return nil
+	}
+
+	return &r.b.Metadata.Symbol
+}
+
+func (r *erc20BalanceResolver) Decimals() *int32 {
+	if r.b.Metadata == nil {
+		return nil
+	}
+
+	decimals := int32(r.b.Metadata.Decimals)
+
+	return &decimals
+}
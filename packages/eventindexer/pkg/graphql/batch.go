@@ -0,0 +1,147 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+type batchFilter struct {
+	ChainID  *Long
+	Proposer *string
+	BatchID  *Long
+}
+
+type batchesArgs struct {
+	Filter *batchFilter
+	pageArgs
+}
+
+func (r *Resolver) Batches(ctx context.Context, args batchesArgs) (*connection[*batchResolver], error) {
+	limit, err := args.limit()
+	if err != nil {
+		return nil, err
+	}
+
+	beforeID, err := args.position()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := eventindexer.FindEventsOpts{
+		Events:   []string{eventindexer.EventNameBatchProposed},
+		BeforeID: beforeID,
+		Limit:    limit + 1,
+	}
+
+	if args.Filter != nil {
+		opts.ChainID = int64Ptr(args.Filter.ChainID)
+		opts.Address = args.Filter.Proposer
+		opts.BatchID = int64Ptr(args.Filter.BatchID)
+	}
+
+	events, err := r.eventRepo.FindEvents(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	batches := make([]*batchResolver, 0, len(events))
+	for _, e := range events {
+		batches = append(batches, &batchResolver{r: r, e: e})
+	}
+
+	return newConnection(batches, limit, func(b *batchResolver) int {
+		return b.e.ID
+	}), nil
+}
+
+func (r *Resolver) Batch(ctx context.Context, args struct {
+	ChainID Long
+	BatchID Long
+}) (*batchResolver, error) {
+	events, err := r.eventRepo.FindEvents(ctx, eventindexer.FindEventsOpts{
+		ChainID: int64Ptr(&args.ChainID),
+		Events:  []string{eventindexer.EventNameBatchProposed},
+		BatchID: int64Ptr(&args.BatchID),
+		Limit:   1,
+	})
+	if err != nil || len(events) == 0 {
+		return nil, err
+	}
+
+	return &batchResolver{r: r, e: events[0]}, nil
+}
+
+// batchResolver resolves a batch from its BatchProposed event, the proofs and the
+// verification of the batch are only looked up when they are selected.
+type batchResolver struct {
+	r *Resolver
+	e *eventindexer.Event
+}
+
+func (b *batchResolver) ChainID() Long {
+	return Long(b.e.ChainID)
+}
+
+func (b *batchResolver) BatchID() Long {
+	return Long(b.e.BatchID.Int64)
+}
+
+func (b *batchResolver) Proposer() string {
+	return b.e.Address
+}
+
+func (b *batchResolver) LastBlockID() *Long {
+	return nullLong(b.e.BlockID)
+}
+
+func (b *batchResolver) NumBlocks() *Long {
+	return nullLong(b.e.NumBlocks)
+}
+
+func (b *batchResolver) ProposedAt() graphql.Time {
+	return graphql.Time{Time: b.e.TransactedAt}
+}
+
+func (b *batchResolver) ProposedIn() Long {
+	return Long(b.e.EmittedBlockID)
+}
+
+func (b *batchResolver) Proposal() *eventResolver {
+	return &eventResolver{b.e}
+}
+
+func (b *batchResolver) Proofs(ctx context.Context) ([]*eventResolver, error) {
+	events, err := b.r.eventRepo.FindEvents(ctx, eventindexer.FindEventsOpts{
+		ChainID: &b.e.ChainID,
+		Events:  []string{eventindexer.EventNameBatchesProven},
+		BatchID: &b.e.BatchID.Int64,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	proofs := make([]*eventResolver, 0, len(events))
+	for _, e := range events {
+		proofs = append(proofs, &eventResolver{e})
+	}
+
+	return proofs, nil
+}
+
+// Verified returns whether a BatchesVerified event verified the batch, or any batch after it.
+func (b *batchResolver) Verified(ctx context.Context) (bool, error) {
+	events, err := b.r.eventRepo.FindEvents(ctx, eventindexer.FindEventsOpts{
+		ChainID:    &b.e.ChainID,
+		Events:     []string{eventindexer.EventNameBatchesVerified},
+		MinBatchID: &b.e.BatchID.Int64,
+		Limit:      1,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return len(events) > 0, nil
+}
@@ -0,0 +1,122 @@
+package graphql
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// cursorPrefix is prepended to the position of the cursors before they are encoded, so
+// that the clients treat them as opaque.
+var cursorPrefix = "cursor:"
+
+// pageArgs are the pagination arguments of the connections.
+type pageArgs struct {
+	First *int32
+	After *string
+}
+
+// limit returns the page size requested by the given arguments.
+func (a pageArgs) limit() (int, error) {
+	if a.First == nil {
+		return int(defaultPageSize), nil
+	}
+
+	if *a.First < 0 || *a.First > maxPageSize {
+		return 0, fmt.Errorf("first must be between 0 and %v", maxPageSize)
+	}
+
+	return int(*a.First), nil
+}
+
+// position returns the position encoded in the `after` cursor, or 0 when it is not given.
+func (a pageArgs) position() (int, error) {
+	if a.After == nil {
+		return 0, nil
+	}
+
+	return decodeCursor(*a.After)
+}
+
+func encodeCursor(position int) string {
+	return base64.StdEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(position)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	b, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(b), cursorPrefix) {
+		return 0, fmt.Errorf("invalid cursor %q", cursor)
+	}
+
+	position, err := strconv.Atoi(strings.TrimPrefix(string(b), cursorPrefix))
+	if err != nil || position <= 0 {
+		return 0, fmt.Errorf("invalid cursor %q", cursor)
+	}
+
+	return position, nil
+}
+
+type pageInfo struct {
+	hasNextPage bool
+	endCursor   *string
+}
+
+func (p *pageInfo) HasNextPage() bool {
+	return p.hasNextPage
+}
+
+func (p *pageInfo) EndCursor() *string {
+	return p.endCursor
+}
+
+type edge[T any] struct {
+	cursor string
+	node   T
+}
+
+func (e *edge[T]) Cursor() string {
+	return e.cursor
+}
+
+func (e *edge[T]) Node() T {
+	return e.node
+}
+
+// connection is a page of nodes, each with the cursor of the page following it.
+type connection[T any] struct {
+	edges    []*edge[T]
+	pageInfo *pageInfo
+}
+
+func (c *connection[T]) Edges() []*edge[T] {
+	return c.edges
+}
+
+func (c *connection[T]) PageInfo() *pageInfo {
+	return c.pageInfo
+}
+
+// newConnection returns the page of the given nodes, fetched with one more node than the
+// limit to know whether there is a next page. position returns the cursor position of a node.
+func newConnection[T any](nodes []T, limit int, position func(T) int) *connection[T] {
+	c := &connection[T]{
+		edges:    make([]*edge[T], 0, len(nodes)),
+		pageInfo: &pageInfo{},
+	}
+
+	if len(nodes) > limit {
+		nodes = nodes[:limit]
+		c.pageInfo.hasNextPage = true
+	}
+
+	for _, n := range nodes {
+		c.edges = append(c.edges, &edge[T]{cursor: encodeCursor(position(n)), node: n})
+	}
+
+	if len(c.edges) > 0 {
+		c.pageInfo.endCursor = &c.edges[len(c.edges)-1].cursor
+	}
+
+	return c
+}
@@ -0,0 +1,202 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/shopspring/decimal"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+type eventFilter struct {
+	ChainID *Long
+	Event   *[]string
+	Address *string
+	BlockID *Long
+	BatchID *Long
+}
+
+type eventsArgs struct {
+	Filter *eventFilter
+	pageArgs
+}
+
+// findEventsOpts returns the repository filters of the given arguments.
+func (a eventsArgs) findEventsOpts() (eventindexer.FindEventsOpts, error) {
+	limit, err := a.limit()
+	if err != nil {
+		return eventindexer.FindEventsOpts{}, err
+	}
+
+	beforeID, err := a.position()
+	if err != nil {
+		return eventindexer.FindEventsOpts{}, err
+	}
+
+	opts := eventindexer.FindEventsOpts{
+		BeforeID: beforeID,
+		Limit:    limit + 1,
+	}
+
+	if a.Filter != nil {
+		opts.ChainID = int64Ptr(a.Filter.ChainID)
+		opts.Address = a.Filter.Address
+		opts.BlockID = int64Ptr(a.Filter.BlockID)
+		opts.BatchID = int64Ptr(a.Filter.BatchID)
+
+		if a.Filter.Event != nil {
+			opts.Events = *a.Filter.Event
+		}
+	}
+
+	return opts, nil
+}
+
+func (r *Resolver) Events(ctx context.Context, args eventsArgs) (*connection[*eventResolver], error) {
+	opts, err := args.findEventsOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.findEvents(ctx, opts)
+}
+
+func (r *Resolver) findEvents(
+	ctx context.Context,
+	opts eventindexer.FindEventsOpts,
+) (*connection[*eventResolver], error) {
+	events, err := r.eventRepo.FindEvents(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*eventResolver, 0, len(events))
+	for _, e := range events {
+		resolvers = append(resolvers, &eventResolver{e})
+	}
+
+	return newConnection(resolvers, opts.Limit-1, func(e *eventResolver) int {
+		return e.e.ID
+	}), nil
+}
+
+type eventResolver struct {
+	e *eventindexer.Event
+}
+
+func (r *eventResolver) ID() graphql.ID {
+	return graphql.ID(strconv.Itoa(r.e.ID))
+}
+
+func (r *eventResolver) Name() string {
+	return r.e.Name
+}
+
+func (r *eventResolver) Event() string {
+	return r.e.Event
+}
+
+func (r *eventResolver) ChainID() Long {
+	return Long(r.e.ChainID)
+}
+
+func (r *eventResolver) Address() string {
+	return r.e.Address
+}
+
+func (r *eventResolver) BlockID() *Long {
+	return nullLong(r.e.BlockID)
+}
+
+func (r *eventResolver) BatchID() *Long {
+	return nullLong(r.e.BatchID)
+}
+
+func (r *eventResolver) NumBlocks() *Long {
+	return nullLong(r.e.NumBlocks)
+}
+
+func (r *eventResolver) Amount() *string {
+	return nullDecimal(r.e.Amount)
+}
+
+func (r *eventResolver) ProofReward() *string {
+	return nullDecimal(r.e.ProofReward)
+}
+
+func (r *eventResolver) ProposerReward() *string {
+	return nullDecimal(r.e.ProposerReward)
+}
+
+func (r *eventResolver) AssignedProver() *string {
+	return emptyString(r.e.AssignedProver)
+}
+
+func (r *eventResolver) To() *string {
+	return emptyString(r.e.To)
+}
+
+func (r *eventResolver) TokenID() *Long {
+	return nullLong(r.e.TokenID)
+}
+
+func (r *eventResolver) ContractAddress() *string {
+	return emptyString(r.e.ContractAddress)
+}
+
+func (r *eventResolver) FeeTokenAddress() *string {
+	return emptyString(r.e.FeeTokenAddress)
+}
+
+func (r *eventResolver) Tier() *int32 {
+	if !r.e.Tier.Valid {
+		return nil
+	}
+
+	tier := int32(r.e.Tier.Int16)
+
+	return &tier
+}
+
+func (r *eventResolver) EmittedBlockID() Long {
+	return Long(r.e.EmittedBlockID)
+}
+
+func (r *eventResolver) TransactedAt() graphql.Time {
+	return graphql.Time{Time: r.e.TransactedAt}
+}
+
+func (r *eventResolver) Data() string {
+	return string(r.e.Data)
+}
+
+func nullLong(v sql.NullInt64) *Long {
+	if !v.Valid {
+		return nil
+	}
+
+	l := Long(v.Int64)
+
+	return &l
+}
+
+func nullDecimal(v decimal.NullDecimal) *string {
+	if !v.Valid {
+		return nil
+	}
+
+	s := v.Decimal.String()
+
+	return &s
+}
+
+func emptyString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
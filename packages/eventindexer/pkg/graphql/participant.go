@@ -0,0 +1,86 @@
+package graphql
+
+import (
+	"context"
+	"sort"
+)
+
+type participantResolver struct {
+	address string
+	count   int
+
+	// position is the position of the participant in the ranking, starting at 1.
+	position int
+}
+
+func (p *participantResolver) Address() string {
+	return p.address
+}
+
+func (p *participantResolver) Count() int32 {
+	return int32(p.count)
+}
+
+func (r *Resolver) Provers(ctx context.Context, args pageArgs) (*connection[*participantResolver], error) {
+	provers, err := r.eventRepo.FindUniqueProvers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	participants := make([]*participantResolver, 0, len(provers))
+	for _, p := range provers {
+		participants = append(participants, &participantResolver{address: p.Address, count: p.Count})
+	}
+
+	return rankParticipants(participants, args)
+}
+
+func (r *Resolver) Proposers(ctx context.Context, args pageArgs) (*connection[*participantResolver], error) {
+	proposers, err := r.eventRepo.FindUniqueProposers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	participants := make([]*participantResolver, 0, len(proposers))
+	for _, p := range proposers {
+		participants = append(participants, &participantResolver{address: p.Address, count: p.Count})
+	}
+
+	return rankParticipants(participants, args)
+}
+
+// rankParticipants sorts the given participants by count, and returns the requested page.
+// The participants are aggregated in the store, so their cursor is their position.
+func rankParticipants(
+	participants []*participantResolver,
+	args pageArgs,
+) (*connection[*participantResolver], error) {
+	limit, err := args.limit()
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := args.position()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(participants, func(i, j int) bool {
+		if participants[i].count != participants[j].count {
+			return participants[i].count > participants[j].count
+		}
+
+		return participants[i].address < participants[j].address
+	})
+
+	for i, p := range participants {
+		p.position = i + 1
+	}
+
+	participants = participants[min(after, len(participants)):]
+	participants = participants[:min(limit+1, len(participants))]
+
+	return newConnection(participants, limit, func(p *participantResolver) int {
+		return p.position
+	}), nil
+}
@@ -0,0 +1,65 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Long is a 64-bit integer, as the GraphQL Int is a 32-bit integer. It is serialized as a
+// number, and can be given as a number or as a string.
+type Long int64
+
+func (Long) ImplementsGraphQLType(name string) bool {
+	return name == "Long"
+}
+
+func (l *Long) UnmarshalGraphQL(input interface{}) error {
+	switch v := input.(type) {
+	case int32:
+		*l = Long(v)
+	case int64:
+		*l = Long(v)
+	case float64:
+		if v != math.Trunc(v) || v > math.MaxInt64 || v < math.MinInt64 {
+			return fmt.Errorf("invalid Long %v", v)
+		}
+
+		*l = Long(v)
+	case json.Number:
+		return l.parse(v.String())
+	case string:
+		return l.parse(v)
+	default:
+		return fmt.Errorf("invalid Long %v", input)
+	}
+
+	return nil
+}
+
+func (l *Long) parse(s string) error {
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid Long %q", s)
+	}
+
+	*l = Long(v)
+
+	return nil
+}
+
+func (l Long) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(l), 10), nil
+}
+
+// int64Ptr returns the value of the given optional Long argument.
+func int64Ptr(l *Long) *int64 {
+	if l == nil {
+		return nil
+	}
+
+	v := int64(*l)
+
+	return &v
+}
@@ -0,0 +1,54 @@
+package graphql
+
+import (
+	_ "embed"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+//go:embed schema.graphql
+var schemaString string
+
+var (
+	// maxDepth is the maximum depth of the selections of a query.
+	maxDepth = 10
+	// defaultPageSize is the page size of the connections when `first` is not given.
+	defaultPageSize int32 = 100
+	// maxPageSize is the maximum page size of the connections.
+	maxPageSize int32 = 100
+)
+
+// Resolver is the root resolver of the GraphQL schema, it resolves the queries with the
+// repositories of the indexer.
+type Resolver struct {
+	eventRepo        eventindexer.EventRepository
+	nftBalanceRepo   eventindexer.NFTBalanceRepository
+	erc20BalanceRepo eventindexer.ERC20BalanceRepository
+	accountRepo      eventindexer.AccountRepository
+}
+
+type NewSchemaOpts struct {
+	EventRepo        eventindexer.EventRepository
+	NFTBalanceRepo   eventindexer.NFTBalanceRepository
+	ERC20BalanceRepo eventindexer.ERC20BalanceRepository
+	AccountRepo      eventindexer.AccountRepository
+}
+
+// NewSchema parses the GraphQL schema of the indexer, resolved with the given repositories.
+func NewSchema(opts NewSchemaOpts) (*graphql.Schema, error) {
+	r := &Resolver{
+		eventRepo:        opts.EventRepo,
+		nftBalanceRepo:   opts.NFTBalanceRepo,
+		erc20BalanceRepo: opts.ERC20BalanceRepo,
+		accountRepo:      opts.AccountRepo,
+	}
+
+	return graphql.ParseSchema(
+		schemaString,
+		r,
+		graphql.UseStringDescriptions(),
+		graphql.MaxDepth(maxDepth),
+	)
+}
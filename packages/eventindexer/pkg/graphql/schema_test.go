@@ -0,0 +1,267 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/mock"
+)
+
+var (
+	proposer = "0x0000000000000000000000000000000000000001"
+	prover   = "0x0000000000000000000000000000000000000002"
+)
+
+func newTestSchema(t *testing.T) (*graphql.Schema, *mock.EventRepository, *mock.AccountRepository) {
+	eventRepo := mock.NewEventRepository()
+	accountRepo := mock.NewAccountRepository()
+
+	erc20BalanceRepo := mock.NewERC20BalanceRepository()
+	erc20BalanceRepo.ERC20Balances = []*eventindexer.ERC20Balance{
+		{ID: 1, ChainID: 167001, Address: proposer, ContractAddress: "0x3", Amount: "100"},
+		{ID: 2, ChainID: 167001, Address: prover, ContractAddress: "0x3", Amount: "0"},
+	}
+
+	schema, err := NewSchema(NewSchemaOpts{
+		EventRepo:        eventRepo,
+		NFTBalanceRepo:   mock.NewNFTBalanceRepository(),
+		ERC20BalanceRepo: erc20BalanceRepo,
+		AccountRepo:      accountRepo,
+	})
+	assert.Nil(t, err)
+
+	return schema, eventRepo, accountRepo
+}
+
+func saveEvent(t *testing.T, repo *mock.EventRepository, event string, address string, batchID int64) {
+	_, err := repo.Save(context.Background(), eventindexer.SaveEventOpts{
+		Name:         event,
+		Data:         "{}",
+		ChainID:      mock.MockChainID,
+		Event:        event,
+		Address:      address,
+		BatchID:      &batchID,
+		TransactedAt: time.Now(),
+	})
+	assert.Nil(t, err)
+}
+
+func exec(t *testing.T, schema *graphql.Schema, query string, variables map[string]interface{}) string {
+	resp := schema.Exec(context.Background(), query, "", variables)
+	assert.Empty(t, resp.Errors)
+
+	return string(resp.Data)
+}
+
+func Test_Events_pagination(t *testing.T) {
+	schema, eventRepo, _ := newTestSchema(t)
+
+	for i := int64(1); i <= 3; i++ {
+		saveEvent(t, eventRepo, eventindexer.EventNameBatchProposed, proposer, i)
+	}
+
+	saveEvent(t, eventRepo, eventindexer.EventNameBatchesProven, prover, 1)
+
+	query := `query($after: String) {
+		events(filter: {event: ["BatchProposed"]}, first: 2, after: $after) {
+			edges { node { batchID } }
+			pageInfo { hasNextPage endCursor }
+		}
+	}`
+
+	var page struct {
+		Events struct {
+			Edges []struct {
+				Node struct {
+					BatchID int64 `json:"batchID"`
+				} `json:"node"`
+			} `json:"edges"`
+			PageInfo struct {
+				HasNextPage bool    `json:"hasNextPage"`
+				EndCursor   *string `json:"endCursor"`
+			} `json:"pageInfo"`
+		} `json:"events"`
+	}
+
+	var (
+		batchIDs []int64
+		after    interface{}
+		pages    int
+	)
+
+	for {
+		assert.Nil(t, json.Unmarshal([]byte(exec(t, schema, query, map[string]interface{}{"after": after})), &page))
+
+		for _, e := range page.Events.Edges {
+			batchIDs = append(batchIDs, e.Node.BatchID)
+		}
+
+		pages++
+
+		if !page.Events.PageInfo.HasNextPage {
+			break
+		}
+
+		after = *page.Events.PageInfo.EndCursor
+	}
+
+	assert.Equal(t, 2, pages)
+	assert.ElementsMatch(t, []int64{1, 2, 3}, batchIDs)
+}
+
+func Test_Events_invalidArguments(t *testing.T) {
+	schema, _, _ := newTestSchema(t)
+
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{
+			"firstTooLarge",
+			`{ events(first: 101) { pageInfo { hasNextPage } } }`,
+		},
+		{
+			"invalidCursor",
+			`{ events(after: "invalid") { pageInfo { hasNextPage } } }`,
+		},
+		{
+			"invalidLong",
+			`{ events(filter: {chainID: "invalid"}) { pageInfo { hasNextPage } } }`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := schema.Exec(context.Background(), tt.query, "", nil)
+			assert.NotEmpty(t, resp.Errors)
+		})
+	}
+}
+
+func Test_Batch(t *testing.T) {
+	schema, eventRepo, _ := newTestSchema(t)
+
+	saveEvent(t, eventRepo, eventindexer.EventNameBatchProposed, proposer, 1)
+	saveEvent(t, eventRepo, eventindexer.EventNameBatchProposed, proposer, 2)
+	saveEvent(t, eventRepo, eventindexer.EventNameBatchesProven, prover, 1)
+	saveEvent(t, eventRepo, eventindexer.EventNameBatchesVerified, proposer, 1)
+
+	query := `query($batchID: Long!) {
+		batch(chainID: 167001, batchID: $batchID) {
+			batchID proposer verified
+			proofs { address }
+		}
+	}`
+
+	tests := []struct {
+		name    string
+		batchID int64
+		want    string
+	}{
+		{
+			"provedAndVerified",
+			1,
+			`{"batch":{"batchID":1,"proposer":"` + proposer + `","verified":true,` +
+				`"proofs":[{"address":"` + prover + `"}]}}`,
+		},
+		{
+			"proposed",
+			2,
+			`{"batch":{"batchID":2,"proposer":"` + proposer + `","verified":false,"proofs":[]}}`,
+		},
+		{
+			"notProposed",
+			3,
+			`{"batch":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.JSONEq(t, tt.want, exec(t, schema, query, map[string]interface{}{"batchID": tt.batchID}))
+		})
+	}
+}
+
+func Test_Account(t *testing.T) {
+	schema, eventRepo, accountRepo := newTestSchema(t)
+
+	assert.Nil(t, accountRepo.Save(context.Background(), common.HexToAddress(proposer), time.Now()))
+
+	saveEvent(t, eventRepo, eventindexer.EventNameBatchProposed, proposer, 1)
+	saveEvent(t, eventRepo, eventindexer.EventNameBatchesProven, prover, 1)
+
+	got := exec(t, schema, `{
+		account(address: "`+proposer+`") {
+			address
+			events { edges { node { event } } }
+			erc20Balances(filter: {chainID: 167001}) { edges { node { contractAddress amount } } }
+		}
+	}`, nil)
+
+	assert.JSONEq(t, `{"account":{"address":"`+proposer+`",`+
+		`"events":{"edges":[{"node":{"event":"BatchProposed"}}]},`+
+		`"erc20Balances":{"edges":[{"node":{"contractAddress":"0x3","amount":"100"}}]}}}`, got)
+
+	assert.JSONEq(t, `{"account":null}`, exec(t, schema, `{ account(address: "`+prover+`") { address } }`, nil))
+}
+
+func Test_rankParticipants(t *testing.T) {
+	participants := func() []*participantResolver {
+		return []*participantResolver{
+			{address: "0xc", count: 1},
+			{address: "0xb", count: 3},
+			{address: "0xa", count: 3},
+		}
+	}
+
+	first := int32(2)
+
+	page, err := rankParticipants(participants(), pageArgs{First: &first})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(page.Edges()))
+	assert.Equal(t, "0xa", page.Edges()[0].Node().Address())
+	assert.Equal(t, "0xb", page.Edges()[1].Node().Address())
+	assert.True(t, page.PageInfo().HasNextPage())
+
+	page, err = rankParticipants(participants(), pageArgs{First: &first, After: page.PageInfo().EndCursor()})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(page.Edges()))
+	assert.Equal(t, "0xc", page.Edges()[0].Node().Address())
+	assert.False(t, page.PageInfo().HasNextPage())
+}
+
+func Test_Long_UnmarshalGraphQL(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		want    Long
+		wantErr bool
+	}{
+		{"int32", int32(1), 1, false},
+		{"float64", float64(5000000000), 5000000000, false},
+		{"string", "5000000000", 5000000000, false},
+		{"jsonNumber", json.Number("5000000000"), 5000000000, false},
+		{"fraction", 1.5, 0, true},
+		{"invalidString", "0x1", 0, true},
+		{"bigInt", big.NewInt(1), 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var l Long
+
+			err := l.UnmarshalGraphQL(tt.input)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, l)
+		})
+	}
+}
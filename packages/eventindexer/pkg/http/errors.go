@@ -11,4 +11,12 @@ var (
 		"ERR_NO_REWARDER",
 		"Rewarder is required",
 	)
+	ErrInvalidAddress = errors.Validation.NewWithKeyAndDetail(
+		"ERR_INVALID_ADDRESS",
+		"address must be a hex address",
+	)
+	ErrInvalidStatsWindow = errors.Validation.NewWithKeyAndDetail(
+		"ERR_INVALID_STATS_WINDOW",
+		"start must not be after end, and the window must be at most 90 days",
+	)
 )
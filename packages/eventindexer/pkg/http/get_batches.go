@@ -0,0 +1,135 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/labstack/echo/v4"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+// GetBatches
+//
+//	 returns the batches, latest first, with their proposal, proof, verification and latencies
+//
+//			@Summary		Get batches
+//			@ID			   	get-batches
+//		    @Param			chainID	query		string		false	"chainID to query"
+//		    @Param			proposer	query		string		false	"proposer to query"
+//		    @Param			prover	query		string		false	"prover to query"
+//		    @Param			start	query		string		false	"first batch ID of the range, inclusive"
+//		    @Param			end	query		string		false	"last batch ID of the range, inclusive"
+//			@Accept			json
+//			@Produce		json
+//			@Success		200	{object} paginate.Page
+//			@Router			/batches [get]
+func (srv *Server) GetBatches(c echo.Context) error {
+	opts := eventindexer.FindBatchesOpts{}
+
+	var err error
+
+	if opts.ChainID, err = int64QueryParam(c, "chainID"); err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, err)
+	}
+
+	if opts.StartBatchID, err = int64QueryParam(c, "start"); err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, err)
+	}
+
+	if opts.EndBatchID, err = int64QueryParam(c, "end"); err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, err)
+	}
+
+	proposer, err := addressQueryParam(c, "proposer")
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, err)
+	}
+
+	if proposer != "" {
+		opts.Proposer = &proposer
+	}
+
+	prover, err := addressQueryParam(c, "prover")
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, err)
+	}
+
+	if prover != "" {
+		opts.Prover = &prover
+	}
+
+	page, err := srv.batchRepo.Find(c.Request().Context(), c.Request(), opts)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	return c.JSON(http.StatusOK, page)
+}
+
+// GetBatchByID
+//
+//	 returns a batch with its proposal, proof, verification and latencies
+//
+//			@Summary		Get batch by ID
+//			@ID			   	get-batch-by-id
+//		    @Param			id	path		string		true	"batch ID to query"
+//		    @Param			chainID	query		string		false	"chainID to query"
+//			@Accept			json
+//			@Produce		json
+//			@Success		200	{object} eventindexer.Batch
+//			@Router			/batches/{id} [get]
+func (srv *Server) GetBatchByID(c echo.Context) error {
+	batchID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, err)
+	}
+
+	chainID, err := int64QueryParam(c, "chainID")
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, err)
+	}
+
+	batch, err := srv.batchRepo.FindByBatchID(c.Request().Context(), batchID, chainID)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	if batch == nil {
+		return c.NoContent(http.StatusNotFound)
+	}
+
+	return c.JSON(http.StatusOK, batch)
+}
+
+// int64QueryParam returns the value of the given optional integer query parameter.
+func int64QueryParam(c echo.Context, name string) (*int64, error) {
+	param := c.QueryParam(name)
+	if param == "" {
+		return nil, nil
+	}
+
+	v, err := strconv.ParseInt(param, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// addressQueryParam returns the value of the given optional address query parameter in the
+// checksummed form the batches are saved with, or an empty string when it is not given.
+func addressQueryParam(c echo.Context, name string) (string, error) {
+	address := c.QueryParam(name)
+	if address == "" {
+		return "", nil
+	}
+
+	if !common.IsHexAddress(address) {
+		return "", ErrInvalidAddress
+	}
+
+	return common.HexToAddress(address).Hex(), nil
+}
@@ -0,0 +1,130 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+func Test_GetBatches(t *testing.T) {
+	srv := newTestServer()
+
+	proposedAt := time.Unix(1000, 0)
+
+	for _, batchID := range []int64{1, 2} {
+		err := srv.batchRepo.SaveProposed(context.Background(), eventindexer.SaveBatchProposedOpts{
+			ChainID:     167001,
+			BatchID:     batchID,
+			Proposer:    "0x0000000000000000000000000000000000000123",
+			LastBlockID: batchID * 10,
+			NumBlocks:   10,
+			BlobCount:   1,
+			ProposedAt:  proposedAt,
+		})
+		assert.Equal(t, nil, err)
+	}
+
+	err := srv.batchRepo.SaveProved(context.Background(), eventindexer.SaveBatchesProvedOpts{
+		ChainID:  167001,
+		BatchIDs: []int64{1},
+		Prover:   "0x000000000000000000000000000000000000ABcD",
+		ProvedAt: proposedAt.Add(time.Minute),
+	})
+	assert.Equal(t, nil, err)
+
+	err = srv.batchRepo.SaveVerified(context.Background(), eventindexer.SaveBatchesVerifiedOpts{
+		ChainID:    167001,
+		BatchID:    1,
+		VerifiedAt: proposedAt.Add(time.Hour),
+	})
+	assert.Equal(t, nil, err)
+
+	tests := []struct {
+		name                  string
+		url                   string
+		wantStatus            int
+		wantBodyRegexpMatches []string
+	}{
+		{
+			"batch",
+			"/batches/1?chainID=167001",
+			http.StatusOK,
+			[]string{
+				`"firstBlockID":1,"lastBlockID":10`,
+				`"prover":"0x000000000000000000000000000000000000ABcD"`,
+				`"proveLatency":60,"verifyLatency":3540,"totalLatency":3600`,
+			},
+		},
+		{
+			"unprovedBatch",
+			"/batches/2",
+			http.StatusOK,
+			[]string{`"prover":null`, `"proveLatency":null,"verifyLatency":null,"totalLatency":null`},
+		},
+		{
+			"batchNotFound",
+			"/batches/3",
+			http.StatusNotFound,
+			[]string{``},
+		},
+		{
+			"invalidBatchID",
+			"/batches/invalid",
+			http.StatusBadRequest,
+			[]string{``},
+		},
+		{
+			"range",
+			"/batches?start=2&end=2",
+			http.StatusOK,
+			[]string{`{"items":\[{"id":2,`},
+		},
+		{
+			"prover",
+			"/batches?prover=0x000000000000000000000000000000000000abcd",
+			http.StatusOK,
+			[]string{`{"items":\[{"id":1,`},
+		},
+		{
+			"invalidProver",
+			"/batches?prover=0x456",
+			http.StatusBadRequest,
+			[]string{``},
+		},
+		{
+			"invalidProposer",
+			"/batches?proposer=invalid",
+			http.StatusBadRequest,
+			[]string{``},
+		},
+		{
+			"invalidRange",
+			"/batches?start=invalid",
+			http.StatusBadRequest,
+			[]string{``},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testutils.NewUnauthenticatedRequest(
+				echo.GET,
+				tt.url,
+				nil,
+			)
+
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, req)
+
+			testutils.AssertStatusAndBody(t, rec, tt.wantStatus, tt.wantBodyRegexpMatches)
+		})
+	}
+}
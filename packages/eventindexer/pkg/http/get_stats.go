@@ -0,0 +1,160 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/labstack/echo/v4"
+	"github.com/patrickmn/go-cache"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+var (
+	// defaultStatsWindow is the time window of the stats when the start is not given.
+	defaultStatsWindow = 7 * 24 * time.Hour
+	// maxStatsWindow bounds the batches aggregated by a request.
+	maxStatsWindow = 90 * 24 * time.Hour
+)
+
+// GetProverStats
+//
+//	 returns the provers ranked by batches proven in a time window, with their share of the
+//	 batches proven, the median and p95 times to prove after proposal, in seconds, and the
+//	 number of batches proven by each verifier, which identifies the proof type
+//
+//			@Summary		Get prover stats
+//			@ID			   	get-prover-stats
+//		    @Param			chainID	query		string		false	"chainID to query"
+//		    @Param			address	query		string		false	"prover to query"
+//		    @Param			start	query		string		false	"start of the window, RFC 3339, defaults to 7 days before end"
+//		    @Param			end	query		string		false	"end of the window, RFC 3339, defaults to now"
+//			@Accept			json
+//			@Produce		json
+//			@Success		200	{object} []eventindexer.ProverStats
+//			@Router			/stats/provers [get]
+func (srv *Server) GetProverStats(c echo.Context) error {
+	cacheKey := "stats/provers?" + c.QueryString()
+
+	if cached, found := srv.cache.Get(cacheKey); found {
+		return c.JSON(http.StatusOK, cached)
+	}
+
+	opts, err := findStatsBatchesOpts(c)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, err)
+	}
+
+	// the batches are the ones proven in the window, whenever they were proposed.
+	opts.ProvedFrom, opts.ProposedFrom = opts.ProposedFrom, nil
+	opts.ProvedTo, opts.ProposedTo = opts.ProposedTo, nil
+
+	address, err := addressQueryParam(c, "address")
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, err)
+	}
+
+	all, err := srv.batchRepo.FindProverStats(c.Request().Context(), opts)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	stats := make([]*eventindexer.ProverStats, 0)
+
+	for _, s := range all {
+		if address == "" || s.Address == address {
+			stats = append(stats, s)
+		}
+	}
+
+	srv.cache.Set(cacheKey, stats, cache.DefaultExpiration)
+
+	return c.JSON(http.StatusOK, stats)
+}
+
+// GetProposerStats
+//
+//	 returns the proposers ranked by batches proposed in a time window, with their share of
+//	 the batches proposed, their average blocks and blobs per batch, and the forced inclusions
+//	 they processed
+//
+//			@Summary		Get proposer stats
+//			@ID			   	get-proposer-stats
+//		    @Param			chainID	query		string		false	"chainID to query"
+//		    @Param			address	query		string		false	"proposer to query"
+//		    @Param			start	query		string		false	"start of the window, RFC 3339, defaults to 7 days before end"
+//		    @Param			end	query		string		false	"end of the window, RFC 3339, defaults to now"
+//			@Accept			json
+//			@Produce		json
+//			@Success		200	{object} []eventindexer.ProposerStats
+//			@Router			/stats/proposers [get]
+func (srv *Server) GetProposerStats(c echo.Context) error {
+	cacheKey := "stats/proposers?" + c.QueryString()
+
+	if cached, found := srv.cache.Get(cacheKey); found {
+		return c.JSON(http.StatusOK, cached)
+	}
+
+	opts, err := findStatsBatchesOpts(c)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, err)
+	}
+
+	address, err := addressQueryParam(c, "address")
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, err)
+	}
+
+	all, err := srv.batchRepo.FindProposerStats(c.Request().Context(), opts)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	stats := make([]*eventindexer.ProposerStats, 0)
+
+	for _, s := range all {
+		if address == "" || s.Address == address {
+			stats = append(stats, s)
+		}
+	}
+
+	srv.cache.Set(cacheKey, stats, cache.DefaultExpiration)
+
+	return c.JSON(http.StatusOK, stats)
+}
+
+// findStatsBatchesOpts returns the filters of the batches proposed in the window of the
+// stats. The address is not filtered on, as the shares are computed over all the batches.
+func findStatsBatchesOpts(c echo.Context) (eventindexer.FindBatchesOpts, error) {
+	chainID, err := int64QueryParam(c, "chainID")
+	if err != nil {
+		return eventindexer.FindBatchesOpts{}, err
+	}
+
+	end := time.Now().UTC()
+
+	if param := c.QueryParam("end"); param != "" {
+		if end, err = time.Parse(time.RFC3339, param); err != nil {
+			return eventindexer.FindBatchesOpts{}, err
+		}
+	}
+
+	start := end.Add(-defaultStatsWindow)
+
+	if param := c.QueryParam("start"); param != "" {
+		if start, err = time.Parse(time.RFC3339, param); err != nil {
+			return eventindexer.FindBatchesOpts{}, err
+		}
+	}
+
+	if start.After(end) || end.Sub(start) > maxStatsWindow {
+		return eventindexer.FindBatchesOpts{}, ErrInvalidStatsWindow
+	}
+
+	return eventindexer.FindBatchesOpts{
+		ChainID:      chainID,
+		ProposedFrom: &start,
+		ProposedTo:   &end,
+	}, nil
+}
@@ -0,0 +1,170 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+func Test_GetStats(t *testing.T) {
+	srv := newTestServer()
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	proposerA := common.HexToAddress("0xa").Hex()
+	proposerB := common.HexToAddress("0xb").Hex()
+	prover1 := common.HexToAddress("0x1").Hex()
+	prover2 := common.HexToAddress("0x2").Hex()
+	verifier1 := common.HexToAddress("0x11").Hex()
+	verifier2 := common.HexToAddress("0x12").Hex()
+
+	proposals := []struct {
+		batchID    int64
+		proposer   string
+		txHash     string
+		numBlocks  int64
+		blobCount  int64
+		proposedAt time.Time
+	}{
+		// batch 1 is a forced inclusion, proposed in the same transaction as batch 2.
+		{1, proposerA, "0x1", 10, 1, start},
+		{2, proposerA, "0x1", 20, 3, start},
+		{3, proposerB, "0x2", 6, 2, start.Add(time.Minute)},
+		{4, proposerB, "0x3", 6, 2, start.Add(-30 * 24 * time.Hour)},
+	}
+
+	for _, p := range proposals {
+		err := srv.batchRepo.SaveProposed(context.Background(), eventindexer.SaveBatchProposedOpts{
+			ChainID:        167001,
+			BatchID:        p.batchID,
+			Proposer:       p.proposer,
+			LastBlockID:    p.batchID * p.numBlocks,
+			NumBlocks:      p.numBlocks,
+			BlobCount:      p.blobCount,
+			ProposedTxHash: p.txHash,
+			ProposedAt:     p.proposedAt,
+		})
+		assert.Equal(t, nil, err)
+	}
+
+	proofs := []struct {
+		batchIDs []int64
+		prover   string
+		verifier string
+		provedAt time.Time
+	}{
+		{[]int64{1, 2}, prover1, verifier1, start.Add(10 * time.Minute)},
+		{[]int64{3}, prover2, verifier2, start.Add(2 * time.Minute)},
+		{[]int64{4}, prover2, verifier1, start.Add(3 * time.Minute)},
+	}
+
+	for _, p := range proofs {
+		err := srv.batchRepo.SaveProved(context.Background(), eventindexer.SaveBatchesProvedOpts{
+			ChainID:  167001,
+			BatchIDs: p.batchIDs,
+			Prover:   p.prover,
+			Verifier: p.verifier,
+			ProvedAt: p.provedAt,
+		})
+		assert.Equal(t, nil, err)
+	}
+
+	window := "start=2025-01-01T00:00:00Z&end=2025-01-01T01:00:00Z"
+
+	tests := []struct {
+		name                  string
+		url                   string
+		wantStatus            int
+		wantBodyRegexpMatches []string
+	}{
+		{
+			"provers",
+			"/stats/provers?" + window,
+			http.StatusOK,
+			[]string{
+				`\[{"address":"` + prover1 + `","batchesProven":2,"share":0.5,"medianTimeToProve":600,` +
+					`"p95TimeToProve":600,"proofTypes":{"` + verifier1 + `":2}},`,
+				`{"address":"` + prover2 + `","batchesProven":2,"share":0.5,"medianTimeToProve":60,` +
+					`"p95TimeToProve":2592180,"proofTypes":{"` + verifier1 + `":1,"` + verifier2 + `":1}}\]`,
+			},
+		},
+		{
+			"prover",
+			"/stats/provers?address=" + strings.ToLower(prover2) + "&" + window,
+			http.StatusOK,
+			[]string{`^\[{"address":"` + prover2 + `",[^\]]*\]\n$`},
+		},
+		{
+			"proposers",
+			"/stats/proposers?" + window,
+			http.StatusOK,
+			[]string{
+				`\[{"address":"` + proposerA + `","batchesProposed":2,"share":0.6666666666666666,` +
+					`"avgBlocksPerBatch":15,"avgBlobsPerBatch":2,"forcedInclusions":1},`,
+				`{"address":"` + proposerB + `","batchesProposed":1,"share":0.3333333333333333,` +
+					`"avgBlocksPerBatch":6,"avgBlobsPerBatch":2,"forcedInclusions":0}\]`,
+			},
+		},
+		{
+			"emptyWindow",
+			"/stats/proposers?chainID=1&" + window,
+			http.StatusOK,
+			[]string{`^\[\]\n$`},
+		},
+		{
+			"invalidStart",
+			"/stats/provers?start=2025-01-01",
+			http.StatusBadRequest,
+			[]string{``},
+		},
+		{
+			"invalidAddress",
+			"/stats/proposers?address=0xa&" + window,
+			http.StatusBadRequest,
+			[]string{``},
+		},
+		{
+			"startAfterEnd",
+			"/stats/provers?start=2025-01-02T00:00:00Z&end=2025-01-01T00:00:00Z",
+			http.StatusBadRequest,
+			[]string{``},
+		},
+		{
+			"windowTooLong",
+			"/stats/proposers?start=2024-01-01T00:00:00Z&end=2025-01-01T00:00:00Z",
+			http.StatusBadRequest,
+			[]string{``},
+		},
+		{
+			"invalidChainID",
+			"/stats/proposers?chainID=invalid",
+			http.StatusBadRequest,
+			[]string{``},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testutils.NewUnauthenticatedRequest(
+				echo.GET,
+				tt.url,
+				nil,
+			)
+
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, req)
+
+			testutils.AssertStatusAndBody(t, rec, tt.wantStatus, tt.wantBodyRegexpMatches)
+		})
+	}
+}
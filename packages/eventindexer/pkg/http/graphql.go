@@ -0,0 +1,38 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/labstack/echo/v4"
+)
+
+// graphQLRequest is the body of a GraphQL request.
+type graphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// GraphQL
+//
+//	 executes a GraphQL query over the events, batches, provers, proposers, balances and accounts
+//
+//			@Summary		Execute a GraphQL query
+//			@ID			   	graphql
+//		    @Param			body	body		http.graphQLRequest		true	"query, operation name and variables"
+//			@Accept			json
+//			@Produce		json
+//			@Success		200	{object} graphql.Response
+//			@Router			/graphql [post]
+func (srv *Server) GraphQL(c echo.Context) error {
+	req := &graphQLRequest{}
+
+	if err := c.Bind(req); err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, err)
+	}
+
+	resp := srv.graphqlSchema.Exec(c.Request().Context(), req.Query, req.OperationName, req.Variables)
+
+	return c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,71 @@
+package http
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+func Test_GraphQL(t *testing.T) {
+	srv := newTestServer()
+
+	_, err := srv.eventRepo.Save(context.Background(), eventindexer.SaveEventOpts{
+		Name:         "name",
+		Data:         `{}`,
+		ChainID:      big.NewInt(167001),
+		Address:      "0x123",
+		Event:        eventindexer.EventNameBatchProposed,
+		TransactedAt: time.Now(),
+	})
+
+	assert.Equal(t, nil, err)
+
+	tests := []struct {
+		name                  string
+		body                  *graphQLRequest
+		wantStatus            int
+		wantBodyRegexpMatches []string
+	}{
+		{
+			"success",
+			&graphQLRequest{
+				Query:     `query($address: String) { events(filter: {address: $address}) { edges { node { event } } } }`,
+				Variables: map[string]interface{}{"address": "0x123"},
+			},
+			http.StatusOK,
+			[]string{`{"data":{"events":{"edges":\[{"node":{"event":"BatchProposed"}}\]}}}`},
+		},
+		{
+			"invalidQuery",
+			&graphQLRequest{
+				Query: `{ unknown }`,
+			},
+			http.StatusOK,
+			[]string{`"errors":\[`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testutils.NewUnauthenticatedRequest(
+				echo.POST,
+				"/graphql",
+				tt.body,
+			)
+
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, req)
+
+			testutils.AssertStatusAndBody(t, rec, tt.wantStatus, tt.wantBodyRegexpMatches)
+		})
+	}
+}
@@ -13,6 +13,8 @@ func (srv *Server) configureRoutes() {
 	srv.echo.GET("/blockProvenBy", srv.GetBlockProvenBy)
 	srv.echo.GET("/blockProposedBy", srv.GetBlockProposedBy)
 	srv.echo.GET("/erc20ByAddress", srv.GetERC20BalancesByAddressAndChainID)
+	srv.echo.GET("/batches", srv.GetBatches)
+	srv.echo.GET("/batches/:id", srv.GetBatchByID)
 
 	galaxeAPI := srv.echo.Group("/api")
 
@@ -23,4 +25,11 @@ func (srv *Server) configureRoutes() {
 	chartAPI := srv.echo.Group("/chart")
 
 	chartAPI.GET("/chartByTask", srv.GetChartByTask)
+
+	statsAPI := srv.echo.Group("/stats")
+
+	statsAPI.GET("/provers", srv.GetProverStats)
+	statsAPI.GET("/proposers", srv.GetProposerStats)
+
+	srv.echo.POST("/graphql", srv.GraphQL)
 }
@@ -7,9 +7,11 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/graph-gophers/graphql-go"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/patrickmn/go-cache"
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	eventindexergraphql "github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/graphql"
 
 	echo "github.com/labstack/echo/v4"
 )
@@ -33,6 +35,8 @@ type Server struct {
 	nftBalanceRepo   eventindexer.NFTBalanceRepository
 	erc20BalanceRepo eventindexer.ERC20BalanceRepository
 	chartRepo        eventindexer.ChartRepository
+	batchRepo        eventindexer.BatchRepository
+	graphqlSchema    *graphql.Schema
 	cache            *cache.Cache
 }
 
@@ -42,6 +46,8 @@ type NewServerOpts struct {
 	NFTBalanceRepo   eventindexer.NFTBalanceRepository
 	ERC20BalanceRepo eventindexer.ERC20BalanceRepository
 	ChartRepo        eventindexer.ChartRepository
+	AccountRepo      eventindexer.AccountRepository
+	BatchRepo        eventindexer.BatchRepository
 	EthClient        *ethclient.Client
 	CorsOrigins      []string
 }
@@ -69,12 +75,24 @@ func NewServer(opts NewServerOpts) (*Server, error) {
 
 	cache := cache.New(5*time.Minute, 10*time.Minute)
 
+	graphqlSchema, err := eventindexergraphql.NewSchema(eventindexergraphql.NewSchemaOpts{
+		EventRepo:        opts.EventRepo,
+		NFTBalanceRepo:   opts.NFTBalanceRepo,
+		ERC20BalanceRepo: opts.ERC20BalanceRepo,
+		AccountRepo:      opts.AccountRepo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	srv := &Server{
 		echo:             opts.Echo,
 		eventRepo:        opts.EventRepo,
 		nftBalanceRepo:   opts.NFTBalanceRepo,
 		erc20BalanceRepo: opts.ERC20BalanceRepo,
 		chartRepo:        opts.ChartRepo,
+		batchRepo:        opts.BatchRepo,
+		graphqlSchema:    graphqlSchema,
 		cache:            cache,
 	}
 
@@ -135,6 +153,6 @@ func (srv *Server) configureMiddleware(corsOrigins []string) {
 	srv.echo.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: corsOrigins,
 		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept},
-		AllowMethods: []string{http.MethodGet, http.MethodHead},
+		AllowMethods: []string{http.MethodGet, http.MethodHead, http.MethodPost},
 	}))
 }
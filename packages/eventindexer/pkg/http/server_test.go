@@ -12,6 +12,7 @@ import (
 	"github.com/patrickmn/go-cache"
 	"github.com/stretchr/testify/assert"
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/graphql"
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/mock"
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/repo"
 )
@@ -25,8 +26,16 @@ func newTestServer() *Server {
 		eventRepo:        mock.NewEventRepository(),
 		nftBalanceRepo:   mock.NewNFTBalanceRepository(),
 		erc20BalanceRepo: mock.NewERC20BalanceRepository(),
+		batchRepo:        mock.NewBatchRepository(),
 	}
 
+	srv.graphqlSchema, _ = graphql.NewSchema(graphql.NewSchemaOpts{
+		EventRepo:        srv.eventRepo,
+		NFTBalanceRepo:   srv.nftBalanceRepo,
+		ERC20BalanceRepo: srv.erc20BalanceRepo,
+		AccountRepo:      mock.NewAccountRepository(),
+	})
+
 	srv.configureMiddleware([]string{"*"})
 	srv.configureRoutes()
 
@@ -47,6 +56,8 @@ func Test_NewServer(t *testing.T) {
 				CorsOrigins:      make([]string, 0),
 				NFTBalanceRepo:   &repo.NFTBalanceRepository{},
 				ERC20BalanceRepo: &repo.ERC20BalanceRepository{},
+				AccountRepo:      &repo.AccountRepository{},
+				BatchRepo:        &repo.BatchRepository{},
 			},
 			nil,
 		},
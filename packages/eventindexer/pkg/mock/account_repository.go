@@ -0,0 +1,57 @@
+package mock
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+type AccountRepository struct {
+	accounts []*eventindexer.Account
+}
+
+func NewAccountRepository() *AccountRepository {
+	return &AccountRepository{}
+}
+
+func (r *AccountRepository) Save(ctx context.Context, address common.Address, transactedAt time.Time) error {
+	for _, a := range r.accounts {
+		if a.Address == address.Hex() {
+			return nil
+		}
+	}
+
+	r.accounts = append(r.accounts, &eventindexer.Account{
+		ID:           len(r.accounts) + 1,
+		Address:      address.Hex(),
+		TransactedAt: transactedAt,
+	})
+
+	return nil
+}
+
+func (r *AccountRepository) Find(
+	ctx context.Context,
+	opts eventindexer.FindAccountsOpts,
+) ([]*eventindexer.Account, error) {
+	accounts := make([]*eventindexer.Account, 0)
+
+	for i := len(r.accounts) - 1; i >= 0; i-- {
+		a := r.accounts[i]
+
+		if (opts.Address != nil && a.Address != *opts.Address) ||
+			(opts.BeforeID > 0 && a.ID >= opts.BeforeID) {
+			continue
+		}
+
+		accounts = append(accounts, a)
+	}
+
+	if opts.Limit > 0 && len(accounts) > opts.Limit {
+		accounts = accounts[:opts.Limit]
+	}
+
+	return accounts, nil
+}
@@ -0,0 +1,86 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+type BalanceChangeRepository struct {
+	changes []*eventindexer.BalanceChange
+	// Reversed are the balance changes rolled back, in the order they were reversed.
+	Reversed []*eventindexer.BalanceChange
+}
+
+func NewBalanceChangeRepository() *BalanceChangeRepository {
+	return &BalanceChangeRepository{
+		changes: make([]*eventindexer.BalanceChange, 0),
+	}
+}
+
+func (r *BalanceChangeRepository) Apply(ctx context.Context, opts eventindexer.SaveBalanceChangeOpts) error {
+	r.changes = append(r.changes, &eventindexer.BalanceChange{
+		ID:              len(r.changes) + 1,
+		ChainID:         opts.ChainID,
+		BlockID:         opts.BlockID,
+		ContractType:    opts.ContractType,
+		ContractAddress: opts.ContractAddress,
+		TokenID:         opts.TokenID,
+		ERC20MetadataID: opts.ERC20MetadataID,
+		FromAddress:     opts.FromAddress,
+		ToAddress:       opts.ToAddress,
+		Amount:          opts.Amount,
+	})
+
+	return nil
+}
+
+func (r *BalanceChangeRepository) FindAllAfterBlockID(
+	ctx context.Context,
+	chainID uint64,
+	blockID uint64,
+) ([]*eventindexer.BalanceChange, error) {
+	var changes []*eventindexer.BalanceChange
+
+	// saved in order, so iterating backwards returns the latest first
+	for n := len(r.changes) - 1; n >= 0; n-- {
+		c := r.changes[n]
+		if uint64(c.ChainID) == chainID && c.BlockID > blockID {
+			changes = append(changes, c)
+		}
+	}
+
+	return changes, nil
+}
+
+func (r *BalanceChangeRepository) RollbackAfterBlockID(ctx context.Context, chainID uint64, blockID uint64) error {
+	changes, _ := r.FindAllAfterBlockID(ctx, chainID, blockID)
+
+	r.Reversed = append(r.Reversed, changes...)
+
+	r.delete(func(c *eventindexer.BalanceChange) bool {
+		return uint64(c.ChainID) == chainID && c.BlockID > blockID
+	})
+
+	return nil
+}
+
+func (r *BalanceChangeRepository) DeleteBeforeBlockID(ctx context.Context, chainID uint64, blockID uint64) error {
+	r.delete(func(c *eventindexer.BalanceChange) bool {
+		return uint64(c.ChainID) == chainID && c.BlockID < blockID
+	})
+
+	return nil
+}
+
+func (r *BalanceChangeRepository) delete(f func(c *eventindexer.BalanceChange) bool) {
+	changes := make([]*eventindexer.BalanceChange, 0)
+
+	for _, c := range r.changes {
+		if !f(c) {
+			changes = append(changes, c)
+		}
+	}
+
+	r.changes = changes
+}
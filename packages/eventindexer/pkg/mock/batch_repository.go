@@ -0,0 +1,283 @@
+package mock
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sort"
+
+	"github.com/morkid/paginate"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+type BatchRepository struct {
+	Batches []*eventindexer.Batch
+}
+
+func NewBatchRepository() *BatchRepository {
+	return &BatchRepository{}
+}
+
+func (r *BatchRepository) find(chainID int64, batchID int64) *eventindexer.Batch {
+	for _, b := range r.Batches {
+		if b.ChainID == chainID && b.BatchID == batchID {
+			return b
+		}
+	}
+
+	return nil
+}
+
+func (r *BatchRepository) SaveProposed(ctx context.Context, opts eventindexer.SaveBatchProposedOpts) error {
+	b := r.find(opts.ChainID, opts.BatchID)
+	if b == nil {
+		b = &eventindexer.Batch{
+			ID:      len(r.Batches) + 1,
+			ChainID: opts.ChainID,
+			BatchID: opts.BatchID,
+		}
+
+		r.Batches = append(r.Batches, b)
+	}
+
+	b.Proposer = opts.Proposer
+	b.FirstBlockID = opts.LastBlockID - opts.NumBlocks + 1
+	b.LastBlockID = opts.LastBlockID
+	b.NumBlocks = opts.NumBlocks
+	b.BlobCount = opts.BlobCount
+	b.ProposedTxHash = opts.ProposedTxHash
+	b.ProposedBlockID = opts.ProposedBlockID
+	b.ProposedAt = opts.ProposedAt
+
+	return nil
+}
+
+func (r *BatchRepository) SaveProved(ctx context.Context, opts eventindexer.SaveBatchesProvedOpts) error {
+	for _, b := range r.Batches {
+		if b.ChainID != opts.ChainID || !slices.Contains(opts.BatchIDs, b.BatchID) {
+			continue
+		}
+
+		b.ProvedTxHash = &opts.ProvedTxHash
+		b.Prover = &opts.Prover
+		b.Verifier = &opts.Verifier
+		b.ProvedBlockID = &opts.ProvedBlockID
+		b.ProvedAt = &opts.ProvedAt
+	}
+
+	return nil
+}
+
+func (r *BatchRepository) SaveVerified(ctx context.Context, opts eventindexer.SaveBatchesVerifiedOpts) error {
+	for _, b := range r.Batches {
+		if b.ChainID != opts.ChainID || b.BatchID > opts.BatchID || b.VerifiedAt != nil {
+			continue
+		}
+
+		b.VerifiedTxHash = &opts.VerifiedTxHash
+		b.VerifiedBlockID = &opts.VerifiedBlockID
+		b.VerifiedAt = &opts.VerifiedAt
+	}
+
+	return nil
+}
+
+func (r *BatchRepository) FindByBatchID(
+	ctx context.Context,
+	batchID int64,
+	chainID *int64,
+) (*eventindexer.Batch, error) {
+	for _, b := range r.Batches {
+		if b.BatchID == batchID && (chainID == nil || b.ChainID == *chainID) {
+			return b, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *BatchRepository) Find(
+	ctx context.Context,
+	req *http.Request,
+	opts eventindexer.FindBatchesOpts,
+) (paginate.Page, error) {
+	return paginate.Page{
+		Items: r.findAll(opts),
+	}, nil
+}
+
+func (r *BatchRepository) FindProverStats(
+	ctx context.Context,
+	opts eventindexer.FindBatchesOpts,
+) ([]*eventindexer.ProverStats, error) {
+	stats := make(map[string]*eventindexer.ProverStats)
+	timesToProve := make(map[string][]int64)
+	proven := 0
+
+	for _, b := range r.findAll(opts) {
+		if b.Prover == nil || b.ProvedAt == nil {
+			continue
+		}
+
+		s, ok := stats[*b.Prover]
+		if !ok {
+			s = &eventindexer.ProverStats{
+				Address:    *b.Prover,
+				ProofTypes: make(map[string]int64),
+			}
+
+			stats[*b.Prover] = s
+		}
+
+		s.BatchesProven++
+
+		if b.Verifier != nil {
+			s.ProofTypes[*b.Verifier]++
+		}
+
+		timesToProve[s.Address] = append(timesToProve[s.Address], int64(b.ProvedAt.Sub(b.ProposedAt).Seconds()))
+		proven++
+	}
+
+	provers := make([]*eventindexer.ProverStats, 0, len(stats))
+
+	for _, s := range stats {
+		s.Share = float64(s.BatchesProven) / float64(proven)
+		s.MedianTimeToProve = percentile(timesToProve[s.Address], 50)
+		s.P95TimeToProve = percentile(timesToProve[s.Address], 95)
+
+		provers = append(provers, s)
+	}
+
+	sort.Slice(provers, func(i, j int) bool {
+		if provers[i].BatchesProven != provers[j].BatchesProven {
+			return provers[i].BatchesProven > provers[j].BatchesProven
+		}
+
+		return provers[i].Address < provers[j].Address
+	})
+
+	return provers, nil
+}
+
+func (r *BatchRepository) FindProposerStats(
+	ctx context.Context,
+	opts eventindexer.FindBatchesOpts,
+) ([]*eventindexer.ProposerStats, error) {
+	batches := r.findAll(opts)
+	stats := make(map[string]*eventindexer.ProposerStats)
+	blocks := make(map[string]int64)
+	blobs := make(map[string]int64)
+	lastBatchIDs := make(map[string]int64)
+
+	for _, b := range batches {
+		if id, ok := lastBatchIDs[b.ProposedTxHash]; !ok || b.BatchID > id {
+			lastBatchIDs[b.ProposedTxHash] = b.BatchID
+		}
+	}
+
+	for _, b := range batches {
+		s, ok := stats[b.Proposer]
+		if !ok {
+			s = &eventindexer.ProposerStats{
+				Address: b.Proposer,
+			}
+
+			stats[b.Proposer] = s
+		}
+
+		s.BatchesProposed++
+
+		if b.BatchID < lastBatchIDs[b.ProposedTxHash] {
+			s.ForcedInclusions++
+		}
+
+		blocks[s.Address] += b.NumBlocks
+		blobs[s.Address] += b.BlobCount
+	}
+
+	proposers := make([]*eventindexer.ProposerStats, 0, len(stats))
+
+	for _, s := range stats {
+		s.Share = float64(s.BatchesProposed) / float64(len(batches))
+		s.AvgBlocksPerBatch = float64(blocks[s.Address]) / float64(s.BatchesProposed)
+		s.AvgBlobsPerBatch = float64(blobs[s.Address]) / float64(s.BatchesProposed)
+
+		proposers = append(proposers, s)
+	}
+
+	sort.Slice(proposers, func(i, j int) bool {
+		if proposers[i].BatchesProposed != proposers[j].BatchesProposed {
+			return proposers[i].BatchesProposed > proposers[j].BatchesProposed
+		}
+
+		return proposers[i].Address < proposers[j].Address
+	})
+
+	return proposers, nil
+}
+
+// percentile returns the nearest-rank percentile p of the values, nil when there are none.
+func percentile(values []int64, p int) *int64 {
+	if len(values) == 0 {
+		return nil
+	}
+
+	sorted := make([]int64, len(values))
+	copy(sorted, values)
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	rank := (p*len(sorted) + 99) / 100
+
+	return &sorted[rank-1]
+}
+
+// findAll returns the batches matching the given filters, latest first.
+func (r *BatchRepository) findAll(opts eventindexer.FindBatchesOpts) []*eventindexer.Batch {
+	batches := make([]*eventindexer.Batch, 0)
+
+	for i := len(r.Batches) - 1; i >= 0; i-- {
+		b := r.Batches[i]
+
+		if (opts.ChainID != nil && b.ChainID != *opts.ChainID) ||
+			(opts.Proposer != nil && b.Proposer != *opts.Proposer) ||
+			(opts.Prover != nil && (b.Prover == nil || *b.Prover != *opts.Prover)) ||
+			(opts.StartBatchID != nil && b.BatchID < *opts.StartBatchID) ||
+			(opts.EndBatchID != nil && b.BatchID > *opts.EndBatchID) ||
+			(opts.ProposedFrom != nil && b.ProposedAt.Before(*opts.ProposedFrom)) ||
+			(opts.ProposedTo != nil && !b.ProposedAt.Before(*opts.ProposedTo)) ||
+			(opts.ProvedFrom != nil && (b.ProvedAt == nil || b.ProvedAt.Before(*opts.ProvedFrom))) ||
+			(opts.ProvedTo != nil && (b.ProvedAt == nil || !b.ProvedAt.Before(*opts.ProvedTo))) {
+			continue
+		}
+
+		batches = append(batches, b)
+	}
+
+	return batches
+}
+
+func (r *BatchRepository) RollbackAfterBlockID(ctx context.Context, chainID uint64, blockID uint64) error {
+	batches := make([]*eventindexer.Batch, 0)
+
+	for _, b := range r.Batches {
+		if b.ChainID == int64(chainID) && b.ProposedBlockID > blockID {
+			continue
+		}
+
+		if b.ChainID == int64(chainID) && b.ProvedBlockID != nil && *b.ProvedBlockID > blockID {
+			b.ProvedTxHash, b.Prover, b.Verifier, b.ProvedBlockID, b.ProvedAt = nil, nil, nil, nil, nil
+		}
+
+		if b.ChainID == int64(chainID) && b.VerifiedBlockID != nil && *b.VerifiedBlockID > blockID {
+			b.VerifiedTxHash, b.VerifiedBlockID, b.VerifiedAt = nil, nil, nil
+		}
+
+		batches = append(batches, b)
+	}
+
+	r.Batches = batches
+
+	return nil
+}
@@ -59,3 +59,30 @@ func (r *ERC20BalanceRepository) CreateMetadata(
 ) (int, error) {
 	return 1, nil
 }
+
+func (r *ERC20BalanceRepository) Find(
+	ctx context.Context,
+	opts eventindexer.FindBalancesOpts,
+) ([]*eventindexer.ERC20Balance, error) {
+	balances := make([]*eventindexer.ERC20Balance, 0)
+
+	for i := len(r.ERC20Balances) - 1; i >= 0; i-- {
+		b := r.ERC20Balances[i]
+
+		if b.Amount == "0" ||
+			(opts.ChainID != nil && b.ChainID != *opts.ChainID) ||
+			(opts.Address != nil && b.Address != *opts.Address) ||
+			(opts.ContractAddress != nil && b.ContractAddress != *opts.ContractAddress) ||
+			(opts.BeforeID > 0 && b.ID >= opts.BeforeID) {
+			continue
+		}
+
+		balances = append(balances, b)
+	}
+
+	if opts.Limit > 0 && len(balances) > opts.Limit {
+		balances = balances[:opts.Limit]
+	}
+
+	return balances, nil
+}
@@ -2,10 +2,13 @@ package mock
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"math/big"
 	"math/rand"
 	"net/http"
+	"slices"
+	"sort"
 
 	"github.com/morkid/paginate"
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
@@ -23,14 +26,26 @@ func NewEventRepository() *EventRepository {
 	}
 }
 func (r *EventRepository) Save(ctx context.Context, opts eventindexer.SaveEventOpts) (*eventindexer.Event, error) {
-	r.events = append(r.events, &eventindexer.Event{
-		ID:      rand.Int(), // nolint: gosec
-		Data:    datatypes.JSON(opts.Data),
-		ChainID: opts.ChainID.Int64(),
-		Name:    opts.Name,
-		Event:   opts.Event,
-		Address: opts.Address,
-	})
+	e := &eventindexer.Event{
+		ID:             rand.Int(), // nolint: gosec
+		Data:           datatypes.JSON(opts.Data),
+		ChainID:        opts.ChainID.Int64(),
+		Name:           opts.Name,
+		Event:          opts.Event,
+		Address:        opts.Address,
+		TransactedAt:   opts.TransactedAt,
+		EmittedBlockID: opts.EmittedBlockID,
+	}
+
+	if opts.BlockID != nil {
+		e.BlockID = sql.NullInt64{Valid: true, Int64: *opts.BlockID}
+	}
+
+	if opts.BatchID != nil {
+		e.BatchID = sql.NullInt64{Valid: true, Int64: *opts.BatchID}
+	}
+
+	r.events = append(r.events, e)
 
 	return nil, nil
 }
@@ -148,6 +163,16 @@ func (r *EventRepository) GetAssignedBlocksByProverAddress(
 
 // DeleteAllAfterBlockID is used when a reorg is detected
 func (r *EventRepository) DeleteAllAfterBlockID(ctx context.Context, blockID uint64, srcChainID uint64) error {
+	events := make([]*eventindexer.Event, 0)
+
+	for _, e := range r.events {
+		if e.ChainID != int64(srcChainID) || e.EmittedBlockID <= blockID {
+			events = append(events, e)
+		}
+	}
+
+	r.events = events
+
 	return nil
 }
 
@@ -184,3 +209,34 @@ func (r *EventRepository) GetBlockProposedBy(ctx context.Context, blockID int) (
 
 	return nil, errors.New("not found")
 }
+
+func (r *EventRepository) FindEvents(
+	ctx context.Context,
+	opts eventindexer.FindEventsOpts,
+) ([]*eventindexer.Event, error) {
+	events := make([]*eventindexer.Event, 0)
+
+	for _, e := range r.events {
+		if (opts.ChainID != nil && e.ChainID != *opts.ChainID) ||
+			(len(opts.Events) > 0 && !slices.Contains(opts.Events, e.Event)) ||
+			(opts.Address != nil && e.Address != *opts.Address) ||
+			(opts.BlockID != nil && e.BlockID.Int64 != *opts.BlockID) ||
+			(opts.BatchID != nil && e.BatchID.Int64 != *opts.BatchID) ||
+			(opts.MinBatchID != nil && e.BatchID.Int64 < *opts.MinBatchID) ||
+			(opts.BeforeID > 0 && e.ID >= opts.BeforeID) {
+			continue
+		}
+
+		events = append(events, e)
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].ID > events[j].ID
+	})
+
+	if opts.Limit > 0 && len(events) > opts.Limit {
+		events = events[:opts.Limit]
+	}
+
+	return events, nil
+}
@@ -0,0 +1,85 @@
+package mock
+
+import (
+	"context"
+	"sort"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+type IndexedBlockRepository struct {
+	blocks []*eventindexer.IndexedBlock
+}
+
+func NewIndexedBlockRepository() *IndexedBlockRepository {
+	return &IndexedBlockRepository{
+		blocks: make([]*eventindexer.IndexedBlock, 0),
+	}
+}
+
+func (r *IndexedBlockRepository) Save(ctx context.Context, opts eventindexer.SaveIndexedBlockOpts) error {
+	for _, b := range r.blocks {
+		if b.ChainID == opts.ChainID && b.BlockID == opts.BlockID {
+			b.BlockHash = opts.BlockHash
+			b.ParentHash = opts.ParentHash
+			b.BlockTimestamp = opts.BlockTimestamp
+
+			return nil
+		}
+	}
+
+	r.blocks = append(r.blocks, &eventindexer.IndexedBlock{
+		ID:             len(r.blocks) + 1,
+		ChainID:        opts.ChainID,
+		BlockID:        opts.BlockID,
+		BlockHash:      opts.BlockHash,
+		ParentHash:     opts.ParentHash,
+		BlockTimestamp: opts.BlockTimestamp,
+	})
+
+	return nil
+}
+
+func (r *IndexedBlockRepository) FindAll(ctx context.Context, chainID uint64) ([]*eventindexer.IndexedBlock, error) {
+	var blocks []*eventindexer.IndexedBlock
+
+	for _, b := range r.blocks {
+		if b.ChainID == chainID {
+			blocks = append(blocks, b)
+		}
+	}
+
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].BlockID > blocks[j].BlockID
+	})
+
+	return blocks, nil
+}
+
+func (r *IndexedBlockRepository) DeleteAfterBlockID(ctx context.Context, chainID uint64, blockID uint64) error {
+	r.delete(func(b *eventindexer.IndexedBlock) bool {
+		return b.ChainID == chainID && b.BlockID > blockID
+	})
+
+	return nil
+}
+
+func (r *IndexedBlockRepository) DeleteBeforeBlockID(ctx context.Context, chainID uint64, blockID uint64) error {
+	r.delete(func(b *eventindexer.IndexedBlock) bool {
+		return b.ChainID == chainID && b.BlockID < blockID
+	})
+
+	return nil
+}
+
+func (r *IndexedBlockRepository) delete(f func(b *eventindexer.IndexedBlock) bool) {
+	blocks := make([]*eventindexer.IndexedBlock, 0)
+
+	for _, b := range r.blocks {
+		if !f(b) {
+			blocks = append(blocks, b)
+		}
+	}
+
+	r.blocks = blocks
+}
@@ -41,3 +41,30 @@ func (r *NFTBalanceRepository) FindByAddress(ctx context.Context,
 		Items: balances,
 	}, nil
 }
+
+func (r *NFTBalanceRepository) Find(
+	ctx context.Context,
+	opts eventindexer.FindBalancesOpts,
+) ([]*eventindexer.NFTBalance, error) {
+	balances := make([]*eventindexer.NFTBalance, 0)
+
+	for i := len(r.nftBalances) - 1; i >= 0; i-- {
+		b := r.nftBalances[i]
+
+		if b.Amount <= 0 ||
+			(opts.ChainID != nil && b.ChainID != *opts.ChainID) ||
+			(opts.Address != nil && b.Address != *opts.Address) ||
+			(opts.ContractAddress != nil && b.ContractAddress != *opts.ContractAddress) ||
+			(opts.BeforeID > 0 && b.ID >= opts.BeforeID) {
+			continue
+		}
+
+		balances = append(balances, b)
+	}
+
+	if opts.Limit > 0 && len(balances) > opts.Limit {
+		balances = balances[:opts.Limit]
+	}
+
+	return balances, nil
+}
@@ -57,3 +57,31 @@ func (r *AccountRepository) Save(
 
 	return nil
 }
+
+// Find returns the accounts matching the given filters, latest first.
+func (r *AccountRepository) Find(
+	ctx context.Context,
+	opts eventindexer.FindAccountsOpts,
+) ([]*eventindexer.Account, error) {
+	q := r.db.GormDB().WithContext(ctx)
+
+	if opts.Address != nil {
+		q = q.Where("address = ?", *opts.Address)
+	}
+
+	if opts.BeforeID > 0 {
+		q = q.Where("id < ?", opts.BeforeID)
+	}
+
+	accounts := []*eventindexer.Account{}
+
+	if opts.Limit > 0 {
+		q = q.Limit(opts.Limit)
+	}
+
+	if err := q.Order("id DESC").Find(&accounts).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return accounts, nil
+}
@@ -38,48 +38,69 @@ func Test_NewAccountRepository(t *testing.T) {
 }
 
 func TestIntegration_Account_Save(t *testing.T) {
-	db, close, err := testMysql(t)
-	assert.Equal(t, nil, err)
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		accountRepo, err := NewAccountRepository(db)
+		assert.Equal(t, nil, err)
 
-	defer close()
-
-	accountRepo, err := NewAccountRepository(db)
-	assert.Equal(t, nil, err)
-
-	t1 := time.Now()
-	tests := []struct {
-		name    string
-		acct    eventindexer.Account
-		wantErr error
-	}{
-		{
-			"success",
-			eventindexer.Account{
-				ID:           0,
-				Address:      "0x1234",
-				TransactedAt: t1,
+		t1 := time.Now()
+		tests := []struct {
+			name    string
+			acct    eventindexer.Account
+			wantErr error
+		}{
+			{
+				"success",
+				eventindexer.Account{
+					ID:           0,
+					Address:      "0x1234",
+					TransactedAt: t1,
+				},
+				nil,
 			},
-			nil,
-		},
-		{
-			"duplicate",
-			eventindexer.Account{
-				ID:           0,
-				Address:      "0x1234",
-				TransactedAt: t1,
+			{
+				"duplicate",
+				eventindexer.Account{
+					ID:           0,
+					Address:      "0x1234",
+					TransactedAt: t1,
+				},
+				nil,
 			},
-			nil,
-		},
-	}
+		}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			err = accountRepo.Save(
-				context.Background(),
-				common.HexToAddress(tt.acct.Address),
-				tt.acct.TransactedAt,
-			)
-			assert.Equal(t, tt.wantErr, err)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err = accountRepo.Save(
+					context.Background(),
+					common.HexToAddress(tt.acct.Address),
+					tt.acct.TransactedAt,
+				)
+				assert.Equal(t, tt.wantErr, err)
+			})
+		}
+	})
+}
+
+func TestIntegration_Account_Find(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		accountRepo, err := NewAccountRepository(db)
+		assert.Equal(t, nil, err)
+
+		for _, address := range []string{"0x1", "0x2", "0x3"} {
+			err = accountRepo.Save(context.Background(), common.HexToAddress(address), time.Now())
+			assert.Equal(t, nil, err)
+		}
+
+		accounts, err := accountRepo.Find(context.Background(), eventindexer.FindAccountsOpts{Limit: 2})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 2, len(accounts))
+		assert.Equal(t, common.HexToAddress("0x3").Hex(), accounts[0].Address)
+
+		accounts, err = accountRepo.Find(context.Background(), eventindexer.FindAccountsOpts{
+			BeforeID: accounts[1].ID,
 		})
-	}
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 1, len(accounts))
+		assert.Equal(t, common.HexToAddress("0x1").Hex(), accounts[0].Address)
+	})
 }
@@ -0,0 +1,244 @@
+package repo
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slog"
+	"gorm.io/gorm"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/db"
+)
+
+// zeroAddress is the sender of the mints, which has no balance to decrease.
+var zeroAddress = common.Address{}.Hex()
+
+type BalanceChangeRepository struct {
+	db    db.DB
+	erc20 *ERC20BalanceRepository
+	nft   *NFTBalanceRepository
+}
+
+func NewBalanceChangeRepository(dbHandler db.DB) (*BalanceChangeRepository, error) {
+	if dbHandler == nil {
+		return nil, db.ErrNoDB
+	}
+
+	return &BalanceChangeRepository{
+		db:    dbHandler,
+		erc20: &ERC20BalanceRepository{db: dbHandler},
+		nft:   &NFTBalanceRepository{db: dbHandler},
+	}, nil
+}
+
+func (r *BalanceChangeRepository) Apply(ctx context.Context, opts eventindexer.SaveBalanceChangeOpts) error {
+	c := &eventindexer.BalanceChange{
+		ChainID:         opts.ChainID,
+		BlockID:         opts.BlockID,
+		ContractType:    opts.ContractType,
+		ContractAddress: opts.ContractAddress,
+		TokenID:         opts.TokenID,
+		ERC20MetadataID: opts.ERC20MetadataID,
+		FromAddress:     opts.FromAddress,
+		ToAddress:       opts.ToAddress,
+		Amount:          opts.Amount,
+		CreatedAt:       time.Now().UTC(),
+	}
+
+	return r.transaction(ctx, func(tx *gorm.DB) error {
+		if err := r.updateBalances(ctx, tx, c, false); err != nil {
+			return err
+		}
+
+		return tx.Create(c).Error
+	})
+}
+
+func (r *BalanceChangeRepository) RollbackAfterBlockID(ctx context.Context, chainID uint64, blockID uint64) error {
+	return r.transaction(ctx, func(tx *gorm.DB) error {
+		changes := make([]*eventindexer.BalanceChange, 0)
+
+		if err := tx.
+			Where("chain_id = ? AND block_id > ?", chainID, blockID).
+			Order("block_id DESC").
+			Order("id DESC").
+			Find(&changes).Error; err != nil {
+			return err
+		}
+
+		for _, c := range changes {
+			if err := r.updateBalances(ctx, tx, c, true); err != nil {
+				return err
+			}
+		}
+
+		return tx.
+			Where("chain_id = ? AND block_id > ?", chainID, blockID).
+			Delete(&eventindexer.BalanceChange{}).Error
+	})
+}
+
+// updateBalances applies the transfer of the balance change, or reverses it.
+func (r *BalanceChangeRepository) updateBalances(
+	ctx context.Context,
+	tx *gorm.DB,
+	c *eventindexer.BalanceChange,
+	reverse bool,
+) error {
+	if c.ContractType == "ERC20" {
+		increaseOpts, decreaseOpts := erc20BalanceUpdates(c, reverse)
+
+		if increaseOpts.Amount != "0" && increaseOpts.Amount != "" {
+			if _, err := r.erc20.increaseBalanceInDB(tx, increaseOpts); err != nil {
+				return err
+			}
+		}
+
+		if decreaseOpts.Amount != "0" && decreaseOpts.Amount != "" {
+			if _, err := r.erc20.decreaseBalanceInDB(tx, decreaseOpts); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	increaseOpts, decreaseOpts, err := nftBalanceUpdates(c, reverse)
+	if err != nil {
+		return err
+	}
+
+	if increaseOpts.Amount != 0 {
+		if _, err := r.nft.increaseBalanceInDB(ctx, tx, increaseOpts); err != nil {
+			return err
+		}
+	}
+
+	if decreaseOpts.Amount != 0 {
+		if _, err := r.nft.decreaseBalanceInDB(ctx, tx, decreaseOpts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// erc20BalanceUpdates returns the balance updates of an ERC20 balance change: the recipient's
+// balance is increased and the sender's decreased, or the opposite when it is reversed. The
+// sender of a mint has no balance, its update is empty.
+func erc20BalanceUpdates(
+	c *eventindexer.BalanceChange,
+	reverse bool,
+) (eventindexer.UpdateERC20BalanceOpts, eventindexer.UpdateERC20BalanceOpts) {
+	to := eventindexer.UpdateERC20BalanceOpts{
+		ERC20MetadataID: c.ERC20MetadataID,
+		ChainID:         c.ChainID,
+		Address:         c.ToAddress,
+		ContractAddress: c.ContractAddress,
+		Amount:          c.Amount,
+	}
+
+	from := eventindexer.UpdateERC20BalanceOpts{}
+
+	if c.FromAddress != zeroAddress {
+		from = to
+		from.Address = c.FromAddress
+	}
+
+	if reverse {
+		return from, to
+	}
+
+	return to, from
+}
+
+// nftBalanceUpdates returns the balance increased and decreased by an NFT balance change, as
+// erc20BalanceUpdates.
+func nftBalanceUpdates(
+	c *eventindexer.BalanceChange,
+	reverse bool,
+) (eventindexer.UpdateNFTBalanceOpts, eventindexer.UpdateNFTBalanceOpts, error) {
+	amount, err := strconv.ParseInt(c.Amount, 10, 64)
+	if err != nil {
+		return eventindexer.UpdateNFTBalanceOpts{}, eventindexer.UpdateNFTBalanceOpts{},
+			errors.Wrap(err, "strconv.ParseInt")
+	}
+
+	to := eventindexer.UpdateNFTBalanceOpts{
+		ChainID:         c.ChainID,
+		Address:         c.ToAddress,
+		TokenID:         c.TokenID,
+		ContractAddress: c.ContractAddress,
+		ContractType:    c.ContractType,
+		Amount:          amount,
+	}
+
+	from := eventindexer.UpdateNFTBalanceOpts{}
+
+	if c.FromAddress != zeroAddress {
+		from = to
+		from.Address = c.FromAddress
+	}
+
+	if reverse {
+		return from, to, nil
+	}
+
+	return to, from, nil
+}
+
+// transaction runs the given function in a transaction, retried when it deadlocks with the
+// updates of the same balances by concurrent transfers.
+func (r *BalanceChangeRepository) transaction(ctx context.Context, f func(tx *gorm.DB) error) error {
+	var err error
+
+	for retries := 10; retries > 0; retries-- {
+		err = r.db.GormDB().WithContext(ctx).Transaction(f)
+		if err == nil || !strings.Contains(err.Error(), "Deadlock") {
+			break
+		}
+
+		slog.Warn("database deadlock")
+
+		time.Sleep(100 * time.Millisecond) // backoff before retrying
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "r.db.Transaction")
+	}
+
+	return nil
+}
+
+func (r *BalanceChangeRepository) FindAllAfterBlockID(
+	ctx context.Context,
+	chainID uint64,
+	blockID uint64,
+) ([]*eventindexer.BalanceChange, error) {
+	changes := make([]*eventindexer.BalanceChange, 0)
+
+	if err := r.db.GormDB().WithContext(ctx).
+		Where("chain_id = ? AND block_id > ?", chainID, blockID).
+		Order("block_id DESC").
+		Order("id DESC").
+		Find(&changes).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return changes, nil
+}
+
+func (r *BalanceChangeRepository) DeleteBeforeBlockID(ctx context.Context, chainID uint64, blockID uint64) error {
+	if err := r.db.GormDB().WithContext(ctx).
+		Where("chain_id = ? AND block_id < ?", chainID, blockID).
+		Delete(&eventindexer.BalanceChange{}).Error; err != nil {
+		return errors.Wrap(err, "r.db.Delete")
+	}
+
+	return nil
+}
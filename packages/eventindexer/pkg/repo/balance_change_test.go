@@ -0,0 +1,145 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/db"
+)
+
+func Test_NewBalanceChangeRepo(t *testing.T) {
+	tests := []struct {
+		name    string
+		db      db.DB
+		wantErr error
+	}{
+		{
+			"success",
+			&db.Database{},
+			nil,
+		},
+		{
+			"noDb",
+			nil,
+			db.ErrNoDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewBalanceChangeRepository(tt.db)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func Test_erc20BalanceUpdates(t *testing.T) {
+	c := &eventindexer.BalanceChange{
+		ChainID:         1,
+		ContractType:    "ERC20",
+		ERC20MetadataID: 1,
+		FromAddress:     "0x456",
+		ToAddress:       "0x789",
+		Amount:          "100",
+	}
+
+	increaseOpts, decreaseOpts := erc20BalanceUpdates(c, false)
+	assert.Equal(t, "0x789", increaseOpts.Address)
+	assert.Equal(t, "0x456", decreaseOpts.Address)
+	assert.Equal(t, "100", decreaseOpts.Amount)
+
+	increaseOpts, decreaseOpts = erc20BalanceUpdates(c, true)
+	assert.Equal(t, "0x456", increaseOpts.Address)
+	assert.Equal(t, "0x789", decreaseOpts.Address)
+
+	// the sender of a mint has no balance to give back to.
+	c.FromAddress = zeroAddress
+
+	increaseOpts, decreaseOpts = erc20BalanceUpdates(c, true)
+	assert.Equal(t, eventindexer.UpdateERC20BalanceOpts{}, increaseOpts)
+	assert.Equal(t, "0x789", decreaseOpts.Address)
+}
+
+func Test_nftBalanceUpdates(t *testing.T) {
+	c := &eventindexer.BalanceChange{
+		ChainID:      1,
+		ContractType: "ERC1155",
+		TokenID:      1,
+		FromAddress:  zeroAddress,
+		ToAddress:    "0x789",
+		Amount:       "3",
+	}
+
+	increaseOpts, decreaseOpts, err := nftBalanceUpdates(c, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "0x789", increaseOpts.Address)
+	assert.Equal(t, int64(3), increaseOpts.Amount)
+	assert.Equal(t, eventindexer.UpdateNFTBalanceOpts{}, decreaseOpts)
+
+	increaseOpts, decreaseOpts, err = nftBalanceUpdates(c, true)
+	assert.Nil(t, err)
+	assert.Equal(t, eventindexer.UpdateNFTBalanceOpts{}, increaseOpts)
+	assert.Equal(t, "0x789", decreaseOpts.Address)
+
+	c.Amount = "invalid"
+
+	_, _, err = nftBalanceUpdates(c, false)
+	assert.NotNil(t, err)
+}
+
+func TestIntegration_BalanceChange(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		balanceChangeRepo, err := NewBalanceChangeRepository(db)
+		assert.Equal(t, nil, err)
+
+		nftBalanceRepo, err := NewNFTBalanceRepository(db)
+		assert.Equal(t, nil, err)
+
+		// 0x456 mints 3 NFTs, then sends them to 0x789 in the following blocks.
+		for _, opts := range []eventindexer.SaveBalanceChangeOpts{
+			{BlockID: 10, FromAddress: zeroAddress, ToAddress: "0x456", Amount: "3"},
+			{BlockID: 20, FromAddress: "0x456", ToAddress: "0x789", Amount: "1"},
+			{BlockID: 20, FromAddress: "0x456", ToAddress: "0x789", Amount: "1"},
+			{BlockID: 30, FromAddress: "0x456", ToAddress: "0x789", Amount: "1"},
+		} {
+			opts.ChainID = 1
+			opts.ContractType = "ERC1155"
+			opts.ContractAddress = "0x123"
+			opts.TokenID = 1
+
+			assert.Equal(t, nil, balanceChangeRepo.Apply(context.Background(), opts))
+		}
+
+		changes, err := balanceChangeRepo.FindAllAfterBlockID(context.Background(), 1, 10)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 3, len(changes))
+		assert.Equal(t, uint64(30), changes[0].BlockID)
+		assert.Greater(t, changes[1].ID, changes[2].ID)
+		assert.Equal(t, "1", changes[0].Amount)
+
+		balances, err := nftBalanceRepo.Find(context.Background(), eventindexer.FindBalancesOpts{})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 1, len(balances))
+		assert.Equal(t, "0x789", balances[0].Address)
+		assert.Equal(t, int64(3), balances[0].Amount)
+
+		// the transfer of the last block is given back to 0x456.
+		assert.Equal(t, nil, balanceChangeRepo.RollbackAfterBlockID(context.Background(), 1, 20))
+		assert.Equal(t, nil, balanceChangeRepo.DeleteBeforeBlockID(context.Background(), 1, 20))
+
+		changes, err = balanceChangeRepo.FindAllAfterBlockID(context.Background(), 1, 0)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 2, len(changes))
+		assert.Equal(t, uint64(20), changes[0].BlockID)
+
+		balances, err = nftBalanceRepo.Find(context.Background(), eventindexer.FindBalancesOpts{})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 2, len(balances))
+		assert.Equal(t, "0x456", balances[0].Address)
+		assert.Equal(t, int64(1), balances[0].Amount)
+		assert.Equal(t, int64(2), balances[1].Amount)
+	})
+}
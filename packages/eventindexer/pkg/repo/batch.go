@@ -0,0 +1,376 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/morkid/paginate"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/db"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db/dialect"
+)
+
+type BatchRepository struct {
+	db db.DB
+}
+
+func NewBatchRepository(dbHandler db.DB) (*BatchRepository, error) {
+	if dbHandler == nil {
+		return nil, db.ErrNoDB
+	}
+
+	return &BatchRepository{
+		db: dbHandler,
+	}, nil
+}
+
+func (r *BatchRepository) SaveProposed(ctx context.Context, opts eventindexer.SaveBatchProposedOpts) error {
+	b := &eventindexer.Batch{
+		ChainID:         opts.ChainID,
+		BatchID:         opts.BatchID,
+		Proposer:        opts.Proposer,
+		FirstBlockID:    opts.LastBlockID - opts.NumBlocks + 1,
+		LastBlockID:     opts.LastBlockID,
+		NumBlocks:       opts.NumBlocks,
+		BlobCount:       opts.BlobCount,
+		ProposedTxHash:  opts.ProposedTxHash,
+		ProposedBlockID: opts.ProposedBlockID,
+		ProposedAt:      opts.ProposedAt.UTC(),
+	}
+
+	// a batch is proposed again after a reorg, or when the indexer resyncs.
+	if err := r.db.GormDB().WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "chain_id"}, {Name: "batch_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"proposer",
+				"first_block_id",
+				"last_block_id",
+				"num_blocks",
+				"blob_count",
+				"proposed_tx_hash",
+				"proposed_block_id",
+				"proposed_at",
+			}),
+		}).
+		Create(b).Error; err != nil {
+		return errors.Wrap(err, "r.db.Create")
+	}
+
+	return nil
+}
+
+func (r *BatchRepository) SaveProved(ctx context.Context, opts eventindexer.SaveBatchesProvedOpts) error {
+	if err := r.db.GormDB().WithContext(ctx).
+		Model(&eventindexer.Batch{}).
+		Where("chain_id = ? AND batch_id IN ?", opts.ChainID, opts.BatchIDs).
+		Updates(map[string]interface{}{
+			"proved_tx_hash":  opts.ProvedTxHash,
+			"prover":          opts.Prover,
+			"verifier":        opts.Verifier,
+			"proved_block_id": opts.ProvedBlockID,
+			"proved_at":       opts.ProvedAt.UTC(),
+		}).Error; err != nil {
+		return errors.Wrap(err, "r.db.Updates")
+	}
+
+	return nil
+}
+
+func (r *BatchRepository) SaveVerified(ctx context.Context, opts eventindexer.SaveBatchesVerifiedOpts) error {
+	if err := r.db.GormDB().WithContext(ctx).
+		Model(&eventindexer.Batch{}).
+		Where("chain_id = ? AND batch_id <= ? AND verified_at IS NULL", opts.ChainID, opts.BatchID).
+		Updates(map[string]interface{}{
+			"verified_tx_hash":  opts.VerifiedTxHash,
+			"verified_block_id": opts.VerifiedBlockID,
+			"verified_at":       opts.VerifiedAt.UTC(),
+		}).Error; err != nil {
+		return errors.Wrap(err, "r.db.Updates")
+	}
+
+	return nil
+}
+
+func (r *BatchRepository) FindByBatchID(
+	ctx context.Context,
+	batchID int64,
+	chainID *int64,
+) (*eventindexer.Batch, error) {
+	q := r.db.GormDB().WithContext(ctx).Where("batch_id = ?", batchID)
+
+	if chainID != nil {
+		q = q.Where("chain_id = ?", *chainID)
+	}
+
+	b := &eventindexer.Batch{}
+
+	if err := q.First(b).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+
+		return nil, errors.Wrap(err, "r.db.First")
+	}
+
+	return b, nil
+}
+
+func (r *BatchRepository) Find(
+	ctx context.Context,
+	req *http.Request,
+	opts eventindexer.FindBatchesOpts,
+) (paginate.Page, error) {
+	pg := paginate.New(&paginate.Config{
+		DefaultSize: 100,
+	})
+
+	reqCtx := pg.With(r.findQuery(ctx, opts))
+
+	page := reqCtx.Request(req).Response(&[]eventindexer.Batch{})
+
+	return page, nil
+}
+
+// proverStatsRow is a prover aggregated over its proven batches, with the nearest-rank
+// median and p95 of its times to prove.
+type proverStatsRow struct {
+	Address           string
+	BatchesProven     int
+	MedianTimeToProve *int64
+	P95TimeToProve    *int64
+}
+
+type proofTypeRow struct {
+	Address  string
+	Verifier string
+	Batches  int64
+}
+
+func (r *BatchRepository) FindProverStats(
+	ctx context.Context,
+	opts eventindexer.FindBatchesOpts,
+) ([]*eventindexer.ProverStats, error) {
+	timeToProve := r.secondsBetween("proposed_at", "proved_at")
+
+	proofs := r.filterQuery(ctx, opts).
+		Where("prover IS NOT NULL AND proved_at IS NOT NULL").
+		Select(
+			"prover, " +
+				timeToProve + " AS time_to_prove, " +
+				"ROW_NUMBER() OVER (PARTITION BY prover ORDER BY " + timeToProve + ") AS proof_rank, " +
+				"COUNT(*) OVER (PARTITION BY prover) AS proven",
+		)
+
+	rows := []*proverStatsRow{}
+
+	if err := r.db.GormDB().WithContext(ctx).
+		Table("(?) AS proofs", proofs).
+		Select(
+			"prover AS address, " +
+				"COUNT(*) AS batches_proven, " +
+				"MAX(CASE WHEN proof_rank = CEIL(proven * 0.5) THEN time_to_prove END) AS median_time_to_prove, " +
+				"MAX(CASE WHEN proof_rank = CEIL(proven * 0.95) THEN time_to_prove END) AS p95_time_to_prove",
+		).
+		Group("prover").
+		Order("batches_proven DESC, address").
+		Scan(&rows).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Scan")
+	}
+
+	proofTypes := []*proofTypeRow{}
+
+	if err := r.filterQuery(ctx, opts).
+		Where("prover IS NOT NULL AND proved_at IS NOT NULL AND verifier IS NOT NULL").
+		Select("prover AS address, verifier, COUNT(*) AS batches").
+		Group("prover, verifier").
+		Scan(&proofTypes).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Scan")
+	}
+
+	proven := 0
+
+	for _, row := range rows {
+		proven += row.BatchesProven
+	}
+
+	stats := make([]*eventindexer.ProverStats, 0, len(rows))
+	statsByAddress := make(map[string]*eventindexer.ProverStats, len(rows))
+
+	for _, row := range rows {
+		s := &eventindexer.ProverStats{
+			Address:           row.Address,
+			BatchesProven:     row.BatchesProven,
+			Share:             float64(row.BatchesProven) / float64(proven),
+			MedianTimeToProve: row.MedianTimeToProve,
+			P95TimeToProve:    row.P95TimeToProve,
+			ProofTypes:        make(map[string]int64),
+		}
+
+		stats = append(stats, s)
+		statsByAddress[row.Address] = s
+	}
+
+	for _, row := range proofTypes {
+		if s, ok := statsByAddress[row.Address]; ok {
+			s.ProofTypes[row.Verifier] = row.Batches
+		}
+	}
+
+	return stats, nil
+}
+
+// proposerStatsRow is a proposer aggregated over its proposed batches.
+type proposerStatsRow struct {
+	Address          string
+	BatchesProposed  int
+	Blocks           int64
+	Blobs            int64
+	ForcedInclusions int
+}
+
+func (r *BatchRepository) FindProposerStats(
+	ctx context.Context,
+	opts eventindexer.FindBatchesOpts,
+) ([]*eventindexer.ProposerStats, error) {
+	proposals := r.filterQuery(ctx, opts).
+		Select(
+			"proposer, batch_id, num_blocks, blob_count, " +
+				"MAX(batch_id) OVER (PARTITION BY chain_id, proposed_tx_hash) AS last_batch_id",
+		)
+
+	rows := []*proposerStatsRow{}
+
+	if err := r.db.GormDB().WithContext(ctx).
+		Table("(?) AS proposals", proposals).
+		Select(
+			"proposer AS address, " +
+				"COUNT(*) AS batches_proposed, " +
+				"SUM(num_blocks) AS blocks, " +
+				"SUM(blob_count) AS blobs, " +
+				"SUM(CASE WHEN batch_id < last_batch_id THEN 1 ELSE 0 END) AS forced_inclusions",
+		).
+		Group("proposer").
+		Order("batches_proposed DESC, address").
+		Scan(&rows).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Scan")
+	}
+
+	proposed := 0
+
+	for _, row := range rows {
+		proposed += row.BatchesProposed
+	}
+
+	stats := make([]*eventindexer.ProposerStats, 0, len(rows))
+
+	for _, row := range rows {
+		stats = append(stats, &eventindexer.ProposerStats{
+			Address:           row.Address,
+			BatchesProposed:   row.BatchesProposed,
+			Share:             float64(row.BatchesProposed) / float64(proposed),
+			AvgBlocksPerBatch: float64(row.Blocks) / float64(row.BatchesProposed),
+			AvgBlobsPerBatch:  float64(row.Blobs) / float64(row.BatchesProposed),
+			ForcedInclusions:  row.ForcedInclusions,
+		})
+	}
+
+	return stats, nil
+}
+
+// secondsBetween returns the SQL expression of the whole seconds elapsed between two
+// timestamp columns, in the dialect of the database.
+func (r *BatchRepository) secondsBetween(from string, to string) string {
+	if r.db.GormDB().Dialector.Name() == dialect.Postgres {
+		return fmt.Sprintf("CAST(FLOOR(EXTRACT(EPOCH FROM %s - %s)) AS BIGINT)", to, from)
+	}
+
+	return fmt.Sprintf("TIMESTAMPDIFF(SECOND, %s, %s)", from, to)
+}
+
+// findQuery returns the query of the batches matching the given filters, latest first.
+func (r *BatchRepository) findQuery(ctx context.Context, opts eventindexer.FindBatchesOpts) *gorm.DB {
+	return r.filterQuery(ctx, opts).Order("batch_id DESC")
+}
+
+// filterQuery returns the query of the batches matching the given filters.
+func (r *BatchRepository) filterQuery(ctx context.Context, opts eventindexer.FindBatchesOpts) *gorm.DB {
+	q := r.db.GormDB().WithContext(ctx).Model(&eventindexer.Batch{})
+
+	if opts.ChainID != nil {
+		q = q.Where("chain_id = ?", *opts.ChainID)
+	}
+
+	if opts.Proposer != nil {
+		q = q.Where("proposer = ?", *opts.Proposer)
+	}
+
+	if opts.Prover != nil {
+		q = q.Where("prover = ?", *opts.Prover)
+	}
+
+	if opts.StartBatchID != nil {
+		q = q.Where("batch_id >= ?", *opts.StartBatchID)
+	}
+
+	if opts.EndBatchID != nil {
+		q = q.Where("batch_id <= ?", *opts.EndBatchID)
+	}
+
+	if opts.ProposedFrom != nil {
+		q = q.Where("proposed_at >= ?", opts.ProposedFrom.UTC())
+	}
+
+	if opts.ProposedTo != nil {
+		q = q.Where("proposed_at < ?", opts.ProposedTo.UTC())
+	}
+
+	if opts.ProvedFrom != nil {
+		q = q.Where("proved_at >= ?", opts.ProvedFrom.UTC())
+	}
+
+	if opts.ProvedTo != nil {
+		q = q.Where("proved_at < ?", opts.ProvedTo.UTC())
+	}
+
+	return q
+}
+
+func (r *BatchRepository) RollbackAfterBlockID(ctx context.Context, chainID uint64, blockID uint64) error {
+	return r.db.GormDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Where("chain_id = ? AND proposed_block_id > ?", chainID, blockID).
+			Delete(&eventindexer.Batch{}).Error; err != nil {
+			return errors.Wrap(err, "tx.Delete")
+		}
+
+		if err := tx.Model(&eventindexer.Batch{}).
+			Where("chain_id = ? AND proved_block_id > ?", chainID, blockID).
+			Updates(map[string]interface{}{
+				"proved_tx_hash":  nil,
+				"prover":          nil,
+				"verifier":        nil,
+				"proved_block_id": nil,
+				"proved_at":       nil,
+			}).Error; err != nil {
+			return errors.Wrap(err, "tx.Updates")
+		}
+
+		if err := tx.Model(&eventindexer.Batch{}).
+			Where("chain_id = ? AND verified_block_id > ?", chainID, blockID).
+			Updates(map[string]interface{}{
+				"verified_tx_hash":  nil,
+				"verified_block_id": nil,
+				"verified_at":       nil,
+			}).Error; err != nil {
+			return errors.Wrap(err, "tx.Updates")
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,138 @@
+package repo
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/db"
+)
+
+func Test_NewBatchRepo(t *testing.T) {
+	tests := []struct {
+		name    string
+		db      db.DB
+		wantErr error
+	}{
+		{
+			"success",
+			&db.Database{},
+			nil,
+		},
+		{
+			"noDb",
+			nil,
+			db.ErrNoDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewBatchRepository(tt.db)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestIntegration_Batch(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		batchRepo, err := NewBatchRepository(db)
+		assert.Equal(t, nil, err)
+
+		ctx := context.Background()
+		proposedAt := time.Now().UTC().Truncate(time.Second)
+
+		for _, batchID := range []int64{1, 2} {
+			assert.Equal(t, nil, batchRepo.SaveProposed(ctx, eventindexer.SaveBatchProposedOpts{
+				ChainID:         1,
+				BatchID:         batchID,
+				Proposer:        "0x123",
+				LastBlockID:     batchID * 10,
+				NumBlocks:       10,
+				BlobCount:       1,
+				ProposedTxHash:  "0xabc",
+				ProposedBlockID: uint64(batchID * 100),
+				ProposedAt:      proposedAt,
+			}))
+		}
+
+		assert.Equal(t, nil, batchRepo.SaveProved(ctx, eventindexer.SaveBatchesProvedOpts{
+			ChainID:       1,
+			BatchIDs:      []int64{1, 2},
+			ProvedTxHash:  "0xdef",
+			Prover:        "0x456",
+			Verifier:      "0x789",
+			ProvedBlockID: 300,
+			ProvedAt:      proposedAt.Add(time.Minute),
+		}))
+
+		assert.Equal(t, nil, batchRepo.SaveVerified(ctx, eventindexer.SaveBatchesVerifiedOpts{
+			ChainID:         1,
+			BatchID:         2,
+			VerifiedTxHash:  "0xfed",
+			VerifiedBlockID: 400,
+			VerifiedAt:      proposedAt.Add(time.Hour),
+		}))
+
+		chainID := int64(1)
+
+		batch, err := batchRepo.FindByBatchID(ctx, 1, &chainID)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, int64(1), batch.FirstBlockID)
+		assert.Equal(t, "0x456", *batch.Prover)
+		assert.Equal(t, uint64(400), *batch.VerifiedBlockID)
+
+		req, err := http.NewRequest("GET", "", nil)
+		assert.Equal(t, nil, err)
+
+		start := int64(2)
+
+		page, err := batchRepo.Find(ctx, req, eventindexer.FindBatchesOpts{StartBatchID: &start})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, int64(1), page.Total)
+
+		provedFrom := proposedAt.Add(time.Minute)
+		provedTo := proposedAt.Add(2 * time.Minute)
+
+		provers, err := batchRepo.FindProverStats(ctx, eventindexer.FindBatchesOpts{
+			ProvedFrom: &provedFrom,
+			ProvedTo:   &provedTo,
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 1, len(provers))
+		assert.Equal(t, 2, provers[0].BatchesProven)
+		assert.Equal(t, float64(1), provers[0].Share)
+		assert.Equal(t, int64(60), *provers[0].MedianTimeToProve)
+		assert.Equal(t, int64(60), *provers[0].P95TimeToProve)
+		assert.Equal(t, map[string]int64{"0x789": 2}, provers[0].ProofTypes)
+
+		provers, err = batchRepo.FindProverStats(ctx, eventindexer.FindBatchesOpts{ProposedTo: &proposedAt})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 0, len(provers))
+
+		// the first batch is a forced inclusion, proposed in the same transaction as the second.
+		proposers, err := batchRepo.FindProposerStats(ctx, eventindexer.FindBatchesOpts{ChainID: &chainID})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 1, len(proposers))
+		assert.Equal(t, 2, proposers[0].BatchesProposed)
+		assert.Equal(t, float64(10), proposers[0].AvgBlocksPerBatch)
+		assert.Equal(t, float64(1), proposers[0].AvgBlobsPerBatch)
+		assert.Equal(t, 1, proposers[0].ForcedInclusions)
+
+		// the verification and the proofs are reorged out, and then the second proposal.
+		assert.Equal(t, nil, batchRepo.RollbackAfterBlockID(ctx, 1, 150))
+
+		batch, err = batchRepo.FindByBatchID(ctx, 1, &chainID)
+		assert.Equal(t, nil, err)
+		assert.Nil(t, batch.Prover)
+		assert.Nil(t, batch.VerifiedAt)
+
+		batch, err = batchRepo.FindByBatchID(ctx, 2, &chainID)
+		assert.Equal(t, nil, err)
+		assert.Nil(t, batch)
+	})
+}
@@ -2,6 +2,9 @@ package repo
 
 import (
 	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/db"
 
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
@@ -37,33 +40,25 @@ func (r *ChartRepository) Find(
 ) (*eventindexer.ChartResponse, error) {
 	slog.Info("finding chart", "task", task, "tier", tier, "feeTokenAddress", feeTokenAddress)
 
-	var tx *gorm.DB
-
-	var q string = `SELECT * FROM time_series_data
-	WHERE task = ? AND date BETWEEN ? AND ?
-	ORDER BY date;`
-
-	tx = r.getDB(ctx).Raw(q, task, start, end)
+	tx := r.getDB(ctx).
+		Where("task = ?", task).
+		Where("date BETWEEN ? AND ?", start, end)
 
 	if feeTokenAddress != "" {
-		q = `SELECT * FROM time_series_data
-		WHERE task = ? AND date BETWEEN ? AND ?
-		AND fee_token_address = ?
-		ORDER BY date;`
-
-		tx = r.getDB(ctx).Raw(q, task, start, end, feeTokenAddress)
+		tx = tx.Where("fee_token_address = ?", feeTokenAddress)
 	} else if tier != "" {
-		q = `SELECT * FROM time_series_data
-		WHERE task = ? AND date BETWEEN ? AND ?
-		AND tier = ?
-		ORDER BY date;`
+		// tier is an int column, Postgres does not cast the string like MySQL does
+		tierID, err := strconv.Atoi(tier)
+		if err != nil {
+			return nil, errors.Wrap(err, "strconv.Atoi(tier)")
+		}
 
-		tx = r.getDB(ctx).Raw(q, task, start, end, tier)
+		tx = tx.Where("tier = ?", tierID)
 	}
 
 	var tsd []*eventindexer.TimeSeriesData
 
-	if err := tx.Scan(&tsd).Error; err != nil {
+	if err := tx.Order("date").Find(&tsd).Error; err != nil {
 		return nil, err
 	}
 
@@ -36,33 +36,27 @@ func Test_NewChartRepo(t *testing.T) {
 }
 
 func Test_GetDB(t *testing.T) {
-	db, close, err := testMysql(t)
-	assert.Equal(t, nil, err)
-
-	defer close()
-
-	chartRepo, err := NewChartRepository(db)
-	assert.Equal(t, nil, err)
-	assert.NotNil(t, chartRepo.getDB(context.Background()))
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		chartRepo, err := NewChartRepository(db)
+		assert.Equal(t, nil, err)
+		assert.NotNil(t, chartRepo.getDB(context.Background()))
+	})
 }
 
 func Test_Integration_FindChart(t *testing.T) {
-	db, close, err := testMysql(t)
-	assert.Equal(t, nil, err)
-
-	defer close()
-
-	chartRepo, err := NewChartRepository(db)
-	assert.Equal(t, nil, err)
-
-	chart, err := chartRepo.Find(
-		context.Background(),
-		"test",
-		"2023-09-08",
-		"2023-09-09",
-		"0x01",
-		"",
-	)
-	assert.Equal(t, nil, err)
-	assert.Equal(t, 0, len(chart.Chart))
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		chartRepo, err := NewChartRepository(db)
+		assert.Equal(t, nil, err)
+
+		chart, err := chartRepo.Find(
+			context.Background(),
+			"test",
+			"2023-09-08",
+			"2023-09-09",
+			"0x01",
+			"",
+		)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 0, len(chart.Chart))
+	})
 }
@@ -122,9 +122,12 @@ func (r *ERC20BalanceRepository) IncreaseAndDecreaseBalancesInTx(
 	retries := 10
 	for retries > 0 {
 		err = r.db.GormDB().Transaction(func(tx *gorm.DB) (err error) {
-			increasedBalance, err = r.increaseBalanceInDB(tx.WithContext(ctx), increaseOpts)
-			if err != nil {
-				return err
+			// the increase is empty when a reorged mint is reversed
+			if increaseOpts.Amount != "0" && increaseOpts.Amount != "" {
+				increasedBalance, err = r.increaseBalanceInDB(tx.WithContext(ctx), increaseOpts)
+				if err != nil {
+					return err
+				}
 			}
 
 			if decreaseOpts.Amount != "0" && decreaseOpts.Amount != "" {
@@ -205,30 +208,54 @@ func (r *ERC20BalanceRepository) CreateMetadata(
 	symbol string,
 	decimals uint8,
 ) (int, error) {
-	var id int
+	md := &eventindexer.ERC20Metadata{
+		ChainID:         chainID,
+		ContractAddress: contractAddress,
+		Symbol:          symbol,
+		Decimals:        decimals,
+	}
 
-	// Start a transaction
-	tx := r.db.GormDB().WithContext(ctx).Begin()
+	// GORM fills in the ID of the new entry, with LAST_INSERT_ID on MySQL and RETURNING on Postgres
+	if err := r.db.GormDB().WithContext(ctx).Table("erc20_metadata").Create(md).Error; err != nil {
+		return 0, err
+	}
 
-	// Insert the new entry
-	result := tx.Exec(
-		"INSERT INTO erc20_metadata (chain_id, contract_address, symbol, decimals, created_at, updated_at) VALUES (?, ?, ?, ?, NOW(), NOW())",
-		chainID, contractAddress, symbol, decimals,
-	)
+	return md.ID, nil
+}
 
-	if result.Error != nil {
-		tx.Rollback()
-		return 0, result.Error
+// Find returns the positive balances matching the given filters with their metadata,
+// latest first.
+func (r *ERC20BalanceRepository) Find(
+	ctx context.Context,
+	opts eventindexer.FindBalancesOpts,
+) ([]*eventindexer.ERC20Balance, error) {
+	q := r.db.GormDB().WithContext(ctx).Preload("Metadata").Where("amount > 0")
+
+	if opts.ChainID != nil {
+		q = q.Where("chain_id = ?", *opts.ChainID)
 	}
 
-	// Retrieve the ID of the newly inserted entry
-	err := tx.Raw("SELECT LAST_INSERT_ID()").Scan(&id).Error
-	if err != nil {
-		tx.Rollback()
-		return 0, err
+	if opts.Address != nil {
+		q = q.Where("address = ?", *opts.Address)
 	}
 
-	tx.Commit()
+	if opts.ContractAddress != nil {
+		q = q.Where("contract_address = ?", *opts.ContractAddress)
+	}
+
+	if opts.BeforeID > 0 {
+		q = q.Where("id < ?", opts.BeforeID)
+	}
+
+	balances := []*eventindexer.ERC20Balance{}
+
+	if opts.Limit > 0 {
+		q = q.Limit(opts.Limit)
+	}
+
+	if err := q.Order("id DESC").Find(&balances).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
 
-	return id, nil
+	return balances, nil
 }
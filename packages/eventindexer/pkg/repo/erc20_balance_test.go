@@ -41,125 +41,148 @@ func Test_NewERC20BalanceRepo(t *testing.T) {
 }
 
 func TestIntegration_ERC20Balance_Increase_And_Decrease(t *testing.T) {
-	db, close, err := testMysql(t)
-	assert.Equal(t, nil, err)
-
-	defer close()
-
-	ERC20BalanceRepo, err := NewERC20BalanceRepository(db)
-	assert.Equal(t, nil, err)
-
-	pk, _ := ERC20BalanceRepo.CreateMetadata(context.Background(), 1, "0x123", "SYMBOL", 18)
-
-	bal1, _, err := ERC20BalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(),
-		eventindexer.UpdateERC20BalanceOpts{
-			ERC20MetadataID: int64(pk),
-			ChainID:         1,
-			Address:         "0x123",
-			ContractAddress: "0x123",
-			Amount:          "1",
-		}, eventindexer.UpdateERC20BalanceOpts{})
-	assert.Equal(t, nil, err)
-	assert.NotNil(t, bal1)
-
-	bal2, _, err := ERC20BalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(),
-		eventindexer.UpdateERC20BalanceOpts{
-			ERC20MetadataID: int64(pk),
-			ChainID:         1,
-			Address:         "0x123",
-			ContractAddress: "0x123456",
-			Amount:          "2",
-		}, eventindexer.UpdateERC20BalanceOpts{})
-	assert.Equal(t, nil, err)
-	assert.NotNil(t, bal2)
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		ERC20BalanceRepo, err := NewERC20BalanceRepository(db)
+		assert.Equal(t, nil, err)
 
-	tests := []struct {
-		name         string
-		increaseOpts eventindexer.UpdateERC20BalanceOpts
-		decreaseOpts eventindexer.UpdateERC20BalanceOpts
-		wantErr      error
-	}{
-		{
-			"success",
-			eventindexer.UpdateERC20BalanceOpts{
-				ERC20MetadataID: int64(pk),
-				ChainID:         1,
-				Address:         "0x123",
-				ContractAddress: "0x123456789",
-				Amount:          "1",
-			},
+		pk, _ := ERC20BalanceRepo.CreateMetadata(context.Background(), 1, "0x123", "SYMBOL", 18)
+
+		bal1, _, err := ERC20BalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(),
 			eventindexer.UpdateERC20BalanceOpts{
 				ERC20MetadataID: int64(pk),
 				ChainID:         1,
 				Address:         "0x123",
 				ContractAddress: "0x123",
 				Amount:          "1",
-			},
-			nil,
-		},
-		{
-			"one left",
-			eventindexer.UpdateERC20BalanceOpts{
-				ERC20MetadataID: int64(pk),
-				ChainID:         1,
-				Address:         "0x123",
-				ContractAddress: "0x123456789",
-				Amount:          "1",
-			},
+			}, eventindexer.UpdateERC20BalanceOpts{})
+		assert.Equal(t, nil, err)
+		assert.NotNil(t, bal1)
+
+		bal2, _, err := ERC20BalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(),
 			eventindexer.UpdateERC20BalanceOpts{
 				ERC20MetadataID: int64(pk),
 				ChainID:         1,
 				Address:         "0x123",
 				ContractAddress: "0x123456",
-				Amount:          "1",
+				Amount:          "2",
+			}, eventindexer.UpdateERC20BalanceOpts{})
+		assert.Equal(t, nil, err)
+		assert.NotNil(t, bal2)
+
+		tests := []struct {
+			name         string
+			increaseOpts eventindexer.UpdateERC20BalanceOpts
+			decreaseOpts eventindexer.UpdateERC20BalanceOpts
+			wantErr      error
+		}{
+			{
+				"success",
+				eventindexer.UpdateERC20BalanceOpts{
+					ERC20MetadataID: int64(pk),
+					ChainID:         1,
+					Address:         "0x123",
+					ContractAddress: "0x123456789",
+					Amount:          "1",
+				},
+				eventindexer.UpdateERC20BalanceOpts{
+					ERC20MetadataID: int64(pk),
+					ChainID:         1,
+					Address:         "0x123",
+					ContractAddress: "0x123",
+					Amount:          "1",
+				},
+				nil,
 			},
-			nil,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			_, _, err := ERC20BalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(), tt.increaseOpts, tt.decreaseOpts)
-			assert.Equal(t, tt.wantErr, err)
-		})
-	}
+			{
+				"one left",
+				eventindexer.UpdateERC20BalanceOpts{
+					ERC20MetadataID: int64(pk),
+					ChainID:         1,
+					Address:         "0x123",
+					ContractAddress: "0x123456789",
+					Amount:          "1",
+				},
+				eventindexer.UpdateERC20BalanceOpts{
+					ERC20MetadataID: int64(pk),
+					ChainID:         1,
+					Address:         "0x123",
+					ContractAddress: "0x123456",
+					Amount:          "1",
+				},
+				nil,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, _, err := ERC20BalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(), tt.increaseOpts, tt.decreaseOpts)
+				assert.Equal(t, tt.wantErr, err)
+			})
+		}
+	})
 }
 
 // TODO: fix this test
 func TestIntegration_ERC20Balance_FindByAddress(t *testing.T) {
-	db, close, err := testMysql(t)
-	assert.Equal(t, nil, err)
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		ERC20BalanceRepo, err := NewERC20BalanceRepository(db)
+		assert.Equal(t, nil, err)
+
+		tests := []struct {
+			name    string
+			address string
+			chainID string
+			wantErr error
+		}{
+			{
+				"success",
+				"0x123",
+				"1",
+				nil,
+			},
+		}
+
+		get, err := http.NewRequest("GET", "", nil)
+		assert.Equal(t, nil, err)
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := ERC20BalanceRepo.FindByAddress(
+					context.Background(),
+					get,
+					tt.address,
+					tt.chainID)
+				assert.Equal(t, tt.wantErr, err)
+			})
+		}
+	})
+}
 
-	defer close()
+func TestIntegration_ERC20Balance_Find(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		ERC20BalanceRepo, err := NewERC20BalanceRepository(db)
+		assert.Equal(t, nil, err)
 
-	ERC20BalanceRepo, err := NewERC20BalanceRepository(db)
-	assert.Equal(t, nil, err)
+		pk, err := ERC20BalanceRepo.CreateMetadata(context.Background(), 1, "0x123", "SYMBOL", 18)
+		assert.Equal(t, nil, err)
 
-	tests := []struct {
-		name    string
-		address string
-		chainID string
-		wantErr error
-	}{
-		{
-			"success",
-			"0x123",
-			"1",
-			nil,
-		},
-	}
+		_, _, err = ERC20BalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(),
+			eventindexer.UpdateERC20BalanceOpts{
+				ERC20MetadataID: int64(pk),
+				ChainID:         1,
+				Address:         "0x123",
+				ContractAddress: "0x123",
+				Amount:          "1",
+			}, eventindexer.UpdateERC20BalanceOpts{})
+		assert.Equal(t, nil, err)
 
-	get, err := http.NewRequest("GET", "", nil)
-	assert.Equal(t, nil, err)
+		address := "0x123"
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			_, err := ERC20BalanceRepo.FindByAddress(
-				context.Background(),
-				get,
-				tt.address,
-				tt.chainID)
-			assert.Equal(t, tt.wantErr, err)
+		balances, err := ERC20BalanceRepo.Find(context.Background(), eventindexer.FindBalancesOpts{
+			Address: &address,
 		})
-	}
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 1, len(balances))
+		assert.Equal(t, "SYMBOL", balances[0].Metadata.Symbol)
+	})
 }
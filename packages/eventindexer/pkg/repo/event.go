@@ -268,11 +268,12 @@ func (r *EventRepository) GetAssignedBlocksByProverAddress(
 	return page, nil
 }
 
-// DeleteAllAfterBlockID is used when a reorg is detected
+// DeleteAllAfterBlockID is used when a reorg is detected, it deletes the events emitted
+// after the given block.
 func (r *EventRepository) DeleteAllAfterBlockID(ctx context.Context, blockID uint64, srcChainID uint64) error {
 	query := `
 DELETE FROM events
-WHERE block_id >= ? AND chain_id = ?`
+WHERE emitted_block_id > ? AND chain_id = ?`
 
 	return r.db.GormDB().WithContext(ctx).Table("events").Exec(query, blockID, srcChainID).Error
 }
@@ -358,3 +359,51 @@ func (r *EventRepository) GetBlockProposedBy(ctx context.Context, blockID int) (
 
 	return e, nil
 }
+
+// FindEvents returns the events matching the given filters, latest first.
+func (r *EventRepository) FindEvents(
+	ctx context.Context,
+	opts eventindexer.FindEventsOpts,
+) ([]*eventindexer.Event, error) {
+	q := r.db.GormDB().WithContext(ctx)
+
+	if opts.ChainID != nil {
+		q = q.Where("chain_id = ?", *opts.ChainID)
+	}
+
+	if len(opts.Events) > 0 {
+		q = q.Where("event IN ?", opts.Events)
+	}
+
+	if opts.Address != nil {
+		q = q.Where("address = ?", *opts.Address)
+	}
+
+	if opts.BlockID != nil {
+		q = q.Where("block_id = ?", *opts.BlockID)
+	}
+
+	if opts.BatchID != nil {
+		q = q.Where("batch_id = ?", *opts.BatchID)
+	}
+
+	if opts.MinBatchID != nil {
+		q = q.Where("batch_id >= ?", *opts.MinBatchID)
+	}
+
+	if opts.BeforeID > 0 {
+		q = q.Where("id < ?", opts.BeforeID)
+	}
+
+	events := []*eventindexer.Event{}
+
+	if opts.Limit > 0 {
+		q = q.Limit(opts.Limit)
+	}
+
+	if err := q.Order("id DESC").Find(&events).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return events, nil
+}
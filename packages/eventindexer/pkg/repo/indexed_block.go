@@ -0,0 +1,82 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm/clause"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/db"
+)
+
+type IndexedBlockRepository struct {
+	db db.DB
+}
+
+func NewIndexedBlockRepository(dbHandler db.DB) (*IndexedBlockRepository, error) {
+	if dbHandler == nil {
+		return nil, db.ErrNoDB
+	}
+
+	return &IndexedBlockRepository{
+		db: dbHandler,
+	}, nil
+}
+
+func (r *IndexedBlockRepository) Save(ctx context.Context, opts eventindexer.SaveIndexedBlockOpts) error {
+	b := &eventindexer.IndexedBlock{
+		ChainID:        opts.ChainID,
+		BlockID:        opts.BlockID,
+		BlockHash:      opts.BlockHash,
+		ParentHash:     opts.ParentHash,
+		BlockTimestamp: opts.BlockTimestamp,
+		CreatedAt:      time.Now().UTC(),
+	}
+
+	// a block range is indexed again after a restart, the latest hash of its last block wins.
+	if err := r.db.GormDB().WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "chain_id"}, {Name: "block_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"block_hash", "parent_hash", "block_timestamp", "created_at"}),
+		}).
+		Create(b).Error; err != nil {
+		return errors.Wrap(err, "r.db.Create")
+	}
+
+	return nil
+}
+
+func (r *IndexedBlockRepository) FindAll(ctx context.Context, chainID uint64) ([]*eventindexer.IndexedBlock, error) {
+	blocks := make([]*eventindexer.IndexedBlock, 0)
+
+	if err := r.db.GormDB().WithContext(ctx).
+		Where("chain_id = ?", chainID).
+		Order("block_id DESC").
+		Find(&blocks).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return blocks, nil
+}
+
+func (r *IndexedBlockRepository) DeleteAfterBlockID(ctx context.Context, chainID uint64, blockID uint64) error {
+	if err := r.db.GormDB().WithContext(ctx).
+		Where("chain_id = ? AND block_id > ?", chainID, blockID).
+		Delete(&eventindexer.IndexedBlock{}).Error; err != nil {
+		return errors.Wrap(err, "r.db.Delete")
+	}
+
+	return nil
+}
+
+func (r *IndexedBlockRepository) DeleteBeforeBlockID(ctx context.Context, chainID uint64, blockID uint64) error {
+	if err := r.db.GormDB().WithContext(ctx).
+		Where("chain_id = ? AND block_id < ?", chainID, blockID).
+		Delete(&eventindexer.IndexedBlock{}).Error; err != nil {
+		return errors.Wrap(err, "r.db.Delete")
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/db"
+)
+
+func Test_NewIndexedBlockRepo(t *testing.T) {
+	tests := []struct {
+		name    string
+		db      db.DB
+		wantErr error
+	}{
+		{
+			"success",
+			&db.Database{},
+			nil,
+		},
+		{
+			"noDb",
+			nil,
+			db.ErrNoDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewIndexedBlockRepository(tt.db)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestIntegration_IndexedBlock(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		indexedBlockRepo, err := NewIndexedBlockRepository(db)
+		assert.Equal(t, nil, err)
+
+		for _, blockID := range []uint64{10, 20, 30} {
+			assert.Equal(t, nil, indexedBlockRepo.Save(context.Background(), eventindexer.SaveIndexedBlockOpts{
+				ChainID:    1,
+				BlockID:    blockID,
+				BlockHash:  "0x1",
+				ParentHash: "0x0",
+			}))
+		}
+
+		// the hash of a block indexed again is updated.
+		assert.Equal(t, nil, indexedBlockRepo.Save(context.Background(), eventindexer.SaveIndexedBlockOpts{
+			ChainID:    1,
+			BlockID:    30,
+			BlockHash:  "0x2",
+			ParentHash: "0x1",
+		}))
+
+		blocks, err := indexedBlockRepo.FindAll(context.Background(), 1)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 3, len(blocks))
+		assert.Equal(t, uint64(30), blocks[0].BlockID)
+		assert.Equal(t, "0x2", blocks[0].BlockHash)
+
+		blocks, err = indexedBlockRepo.FindAll(context.Background(), 2)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 0, len(blocks))
+
+		assert.Equal(t, nil, indexedBlockRepo.DeleteAfterBlockID(context.Background(), 1, 20))
+		assert.Equal(t, nil, indexedBlockRepo.DeleteBeforeBlockID(context.Background(), 1, 20))
+
+		blocks, err = indexedBlockRepo.FindAll(context.Background(), 1)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 1, len(blocks))
+		assert.Equal(t, uint64(20), blocks[0].BlockID)
+	})
+}
@@ -120,9 +120,12 @@ func (r *NFTBalanceRepository) IncreaseAndDecreaseBalancesInTx(
 	retries := 10
 	for retries > 0 {
 		err = r.db.GormDB().Transaction(func(tx *gorm.DB) (err error) {
-			increasedBalance, err = r.increaseBalanceInDB(ctx, tx, increaseOpts)
-			if err != nil {
-				return err
+			// the increase is empty when a reorged mint is reversed
+			if increaseOpts.Amount != 0 {
+				increasedBalance, err = r.increaseBalanceInDB(ctx, tx, increaseOpts)
+				if err != nil {
+					return err
+				}
 			}
 
 			if decreaseOpts.Amount != 0 {
@@ -174,3 +177,39 @@ func (r *NFTBalanceRepository) FindByAddress(ctx context.Context,
 
 	return page, nil
 }
+
+// Find returns the positive balances matching the given filters, latest first.
+func (r *NFTBalanceRepository) Find(
+	ctx context.Context,
+	opts eventindexer.FindBalancesOpts,
+) ([]*eventindexer.NFTBalance, error) {
+	q := r.db.GormDB().WithContext(ctx).Where("amount > 0")
+
+	if opts.ChainID != nil {
+		q = q.Where("chain_id = ?", *opts.ChainID)
+	}
+
+	if opts.Address != nil {
+		q = q.Where("address = ?", *opts.Address)
+	}
+
+	if opts.ContractAddress != nil {
+		q = q.Where("contract_address = ?", *opts.ContractAddress)
+	}
+
+	if opts.BeforeID > 0 {
+		q = q.Where("id < ?", opts.BeforeID)
+	}
+
+	balances := []*eventindexer.NFTBalance{}
+
+	if opts.Limit > 0 {
+		q = q.Limit(opts.Limit)
+	}
+
+	if err := q.Order("id DESC").Find(&balances).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return balances, nil
+}
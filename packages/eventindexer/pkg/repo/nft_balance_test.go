@@ -41,54 +41,11 @@ func Test_NewNFTBalanceRepo(t *testing.T) {
 }
 
 func TestIntegration_NFTBalance_Increase_And_Decrease(t *testing.T) {
-	db, close, err := testMysql(t)
-	assert.Equal(t, nil, err)
-
-	defer close()
-
-	nftBalanceRepo, err := NewNFTBalanceRepository(db)
-	assert.Equal(t, nil, err)
-
-	bal1, _, err := nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(),
-		eventindexer.UpdateNFTBalanceOpts{
-			ChainID:         1,
-			Address:         "0x123",
-			TokenID:         1,
-			ContractAddress: "0x123",
-			ContractType:    "ERC721",
-			Amount:          1,
-		}, eventindexer.UpdateNFTBalanceOpts{})
-	assert.Equal(t, nil, err)
-	assert.NotNil(t, bal1)
-
-	bal2, _, err := nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(),
-		eventindexer.UpdateNFTBalanceOpts{
-			ChainID:         1,
-			Address:         "0x123",
-			TokenID:         1,
-			ContractAddress: "0x123456",
-			ContractType:    "ERC721",
-			Amount:          2,
-		}, eventindexer.UpdateNFTBalanceOpts{})
-	assert.Equal(t, nil, err)
-	assert.NotNil(t, bal2)
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		nftBalanceRepo, err := NewNFTBalanceRepository(db)
+		assert.Equal(t, nil, err)
 
-	tests := []struct {
-		name         string
-		increaseOpts eventindexer.UpdateNFTBalanceOpts
-		decreaseOpts eventindexer.UpdateNFTBalanceOpts
-		wantErr      error
-	}{
-		{
-			"success",
-			eventindexer.UpdateNFTBalanceOpts{
-				ChainID:         1,
-				Address:         "0x123",
-				TokenID:         1,
-				ContractAddress: "0x123456789",
-				ContractType:    "ERC721",
-				Amount:          1,
-			},
+		bal1, _, err := nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(),
 			eventindexer.UpdateNFTBalanceOpts{
 				ChainID:         1,
 				Address:         "0x123",
@@ -96,74 +53,144 @@ func TestIntegration_NFTBalance_Increase_And_Decrease(t *testing.T) {
 				ContractAddress: "0x123",
 				ContractType:    "ERC721",
 				Amount:          1,
-			},
-			nil,
-		},
-		{
-			"one left",
-			eventindexer.UpdateNFTBalanceOpts{
-				ChainID:         1,
-				Address:         "0x123",
-				TokenID:         1,
-				ContractAddress: "0x123456789",
-				ContractType:    "ERC721",
-				Amount:          1,
-			},
+			}, eventindexer.UpdateNFTBalanceOpts{})
+		assert.Equal(t, nil, err)
+		assert.NotNil(t, bal1)
+
+		bal2, _, err := nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(),
 			eventindexer.UpdateNFTBalanceOpts{
 				ChainID:         1,
 				Address:         "0x123",
 				TokenID:         1,
 				ContractAddress: "0x123456",
 				ContractType:    "ERC721",
-				Amount:          1,
+				Amount:          2,
+			}, eventindexer.UpdateNFTBalanceOpts{})
+		assert.Equal(t, nil, err)
+		assert.NotNil(t, bal2)
+
+		tests := []struct {
+			name         string
+			increaseOpts eventindexer.UpdateNFTBalanceOpts
+			decreaseOpts eventindexer.UpdateNFTBalanceOpts
+			wantErr      error
+		}{
+			{
+				"success",
+				eventindexer.UpdateNFTBalanceOpts{
+					ChainID:         1,
+					Address:         "0x123",
+					TokenID:         1,
+					ContractAddress: "0x123456789",
+					ContractType:    "ERC721",
+					Amount:          1,
+				},
+				eventindexer.UpdateNFTBalanceOpts{
+					ChainID:         1,
+					Address:         "0x123",
+					TokenID:         1,
+					ContractAddress: "0x123",
+					ContractType:    "ERC721",
+					Amount:          1,
+				},
+				nil,
 			},
-			nil,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			_, _, err := nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(), tt.increaseOpts, tt.decreaseOpts)
-			assert.Equal(t, tt.wantErr, err)
-		})
-	}
+			{
+				"one left",
+				eventindexer.UpdateNFTBalanceOpts{
+					ChainID:         1,
+					Address:         "0x123",
+					TokenID:         1,
+					ContractAddress: "0x123456789",
+					ContractType:    "ERC721",
+					Amount:          1,
+				},
+				eventindexer.UpdateNFTBalanceOpts{
+					ChainID:         1,
+					Address:         "0x123",
+					TokenID:         1,
+					ContractAddress: "0x123456",
+					ContractType:    "ERC721",
+					Amount:          1,
+				},
+				nil,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, _, err := nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(), tt.increaseOpts, tt.decreaseOpts)
+				assert.Equal(t, tt.wantErr, err)
+			})
+		}
+	})
 }
 
 // TODO: fix this test
 func TestIntegration_NFTBalance_FindByAddress(t *testing.T) {
-	db, close, err := testMysql(t)
-	assert.Equal(t, nil, err)
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		nftBalanceRepo, err := NewNFTBalanceRepository(db)
+		assert.Equal(t, nil, err)
+
+		tests := []struct {
+			name    string
+			address string
+			chainID string
+			wantErr error
+		}{
+			{
+				"success",
+				"0x123",
+				"1",
+				nil,
+			},
+		}
+
+		get, err := http.NewRequest("GET", "", nil)
+		assert.Equal(t, nil, err)
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := nftBalanceRepo.FindByAddress(
+					context.Background(),
+					get,
+					tt.address,
+					tt.chainID)
+				assert.Equal(t, tt.wantErr, err)
+			})
+		}
+	})
+}
 
-	defer close()
+func TestIntegration_NFTBalance_Find(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		nftBalanceRepo, err := NewNFTBalanceRepository(db)
+		assert.Equal(t, nil, err)
 
-	nftBalanceRepo, err := NewNFTBalanceRepository(db)
-	assert.Equal(t, nil, err)
+		_, _, err = nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(),
+			eventindexer.UpdateNFTBalanceOpts{
+				ChainID:         1,
+				Address:         "0x123",
+				TokenID:         1,
+				ContractAddress: "0x123",
+				ContractType:    "ERC721",
+				Amount:          1,
+			}, eventindexer.UpdateNFTBalanceOpts{})
+		assert.Equal(t, nil, err)
 
-	tests := []struct {
-		name    string
-		address string
-		chainID string
-		wantErr error
-	}{
-		{
-			"success",
-			"0x123",
-			"1",
-			nil,
-		},
-	}
+		address := "0x123"
+		otherAddress := "0x456"
 
-	get, err := http.NewRequest("GET", "", nil)
-	assert.Equal(t, nil, err)
+		balances, err := nftBalanceRepo.Find(context.Background(), eventindexer.FindBalancesOpts{
+			Address: &address,
+		})
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 1, len(balances))
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			_, err := nftBalanceRepo.FindByAddress(
-				context.Background(),
-				get,
-				tt.address,
-				tt.chainID)
-			assert.Equal(t, tt.wantErr, err)
+		balances, err = nftBalanceRepo.Find(context.Background(), eventindexer.FindBalancesOpts{
+			Address: &otherAddress,
 		})
-	}
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 0, len(balances))
+	})
 }
@@ -76,3 +76,15 @@ func (r *TransactionRepository) Save(
 
 	return nil
 }
+
+// DeleteAllAfterBlockID is used when a reorg is detected, it deletes the transactions of the
+// blocks after the given block.
+func (r *TransactionRepository) DeleteAllAfterBlockID(ctx context.Context, blockID uint64, chainID uint64) error {
+	if err := r.db.GormDB().WithContext(ctx).
+		Where("block_id > ? AND chain_id = ?", blockID, chainID).
+		Delete(&eventindexer.Transaction{}).Error; err != nil {
+		return errors.Wrap(err, "r.db.Delete")
+	}
+
+	return nil
+}
@@ -134,4 +134,20 @@ var (
 		Name: "errors_encountered_during_subscription_opts_total",
 		Help: "The total number of errors that occurred during active subscription",
 	})
+	ReorgsDetected = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "reorgs_detected_ops_total",
+		Help: "The total number of reorgs detected and rolled back by the indexer",
+	})
+	ReorgsTooDeep = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "reorgs_too_deep_ops_total",
+		Help: "The total number of reorgs deeper than the indexed block history",
+	})
+	GenericEventsProcessed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "generic_events_processed_ops_total",
+		Help: "The total number of processed events of the generic contracts",
+	})
+	GenericEventsProcessedError = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "generic_events_processed_error_ops_total",
+		Help: "The total number of processed generic contract event errors encountered",
+	})
 )
@@ -0,0 +1,26 @@
+package eventindexer
+
+// ProverStats is the performance of a prover over the batches it proved in a time window.
+// The times to prove are in seconds after the proposal of the batches, and the proof types
+// count the batches proven by each verifier.
+type ProverStats struct {
+	Address           string           `json:"address"`
+	BatchesProven     int              `json:"batchesProven"`
+	Share             float64          `json:"share"`
+	MedianTimeToProve *int64           `json:"medianTimeToProve"`
+	P95TimeToProve    *int64           `json:"p95TimeToProve"`
+	ProofTypes        map[string]int64 `json:"proofTypes"`
+}
+
+// ProposerStats is the activity of a proposer over the batches it proposed in a time window.
+// A forced inclusion is proposed in its own batch, in the same transaction as the batch
+// which processes it, so every batch sharing its transaction with a later batch is counted
+// as one.
+type ProposerStats struct {
+	Address           string  `json:"address"`
+	BatchesProposed   int     `json:"batchesProposed"`
+	Share             float64 `json:"share"`
+	AvgBlocksPerBatch float64 `json:"avgBlocksPerBatch"`
+	AvgBlobsPerBatch  float64 `json:"avgBlobsPerBatch"`
+	ForcedInclusions  int     `json:"forcedInclusions"`
+}
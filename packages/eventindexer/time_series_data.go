@@ -1,16 +1,19 @@
 package eventindexer
 
 import (
+	"database/sql"
 	"time"
 
 	"github.com/shopspring/decimal"
 )
 
 type TimeSeriesData struct {
-	ID        int
-	Task      string
-	Value     decimal.NullDecimal
-	Date      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID              int
+	Task            string
+	Value           decimal.NullDecimal
+	Date            string
+	FeeTokenAddress string
+	Tier            sql.NullInt64
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
@@ -30,4 +30,5 @@ type TransactionRepository interface {
 		blockID *big.Int,
 		timestamp time.Time,
 		contractAddress common.Address) error
+	DeleteAllAfterBlockID(ctx context.Context, blockID uint64, chainID uint64) error
 }
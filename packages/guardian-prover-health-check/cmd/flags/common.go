@@ -38,6 +38,13 @@ var (
 		Category: commonCategory,
 		EnvVars:  []string{"DATABASE_NAME"},
 	}
+	DatabaseDialect = &cli.StringFlag{
+		Name:     "db.dialect",
+		Usage:    "Database dialect, one of: mysql, postgres",
+		Value:    "mysql",
+		Category: commonCategory,
+		EnvVars:  []string{"DATABASE_DIALECT"},
+	}
 )
 
 var (
@@ -79,6 +86,7 @@ var CommonFlags = []cli.Flag{
 	DatabaseHost,
 	DatabaseName,
 	// optional
+	DatabaseDialect,
 	DatabaseMaxIdleConns,
 	DatabaseConnMaxLifetime,
 	DatabaseMaxOpenConns,
@@ -2,11 +2,12 @@ package db
 
 import (
 	"database/sql"
-	"fmt"
 	"time"
 
 	"github.com/cyberhorsey/errors"
 	"gorm.io/gorm"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db/dialect"
 )
 
 var (
@@ -47,6 +48,7 @@ func New(gormdb *gorm.DB) *Database {
 }
 
 type DBConnectionOpts struct {
+	Dialect         string
 	Name            string
 	Password        string
 	Host            string
@@ -54,31 +56,22 @@ type DBConnectionOpts struct {
 	MaxIdleConns    uint64
 	MaxOpenConns    uint64
 	MaxConnLifetime uint64
-	OpenFunc        func(dsn string) (*Database, error)
+	OpenFunc        func(dialector gorm.Dialector) (*Database, error)
 }
 
 func OpenDBConnection(opts DBConnectionOpts) (*Database, error) {
-	dsn := ""
-	if opts.Password == "" {
-		dsn = fmt.Sprintf(
-			"%v@tcp(%v)/%v?charset=utf8mb4&parseTime=True&loc=%v",
-			opts.Name,
-			opts.Host,
-			opts.Database,
-			"UTC",
-		)
-	} else {
-		dsn = fmt.Sprintf(
-			"%v:%v@tcp(%v)/%v?charset=utf8mb4&parseTime=True&loc=%v",
-			opts.Name,
-			opts.Password,
-			opts.Host,
-			opts.Database,
-			"UTC",
-		)
+	dialector, err := dialect.New(dialect.Opts{
+		Dialect:  opts.Dialect,
+		Username: opts.Name,
+		Password: opts.Password,
+		Host:     opts.Host,
+		Database: opts.Database,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	db, err := opts.OpenFunc(dsn)
+	db, err := opts.OpenFunc(dialector)
 	if err != nil {
 		return nil, err
 	}
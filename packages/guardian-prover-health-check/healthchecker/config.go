@@ -4,7 +4,6 @@ import (
 	"strings"
 
 	"github.com/urfave/cli/v2"
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
@@ -18,6 +17,7 @@ type Config struct {
 	DatabasePassword              string
 	DatabaseName                  string
 	DatabaseHost                  string
+	DatabaseDialect               string
 	DatabaseMaxIdleConns          uint64
 	DatabaseMaxOpenConns          uint64
 	DatabaseMaxConnLifetime       uint64
@@ -37,6 +37,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		DatabasePassword:              c.String(flags.DatabasePassword.Name),
 		DatabaseName:                  c.String(flags.DatabaseName.Name),
 		DatabaseHost:                  c.String(flags.DatabaseHost.Name),
+		DatabaseDialect:               c.String(flags.DatabaseDialect.Name),
 		DatabaseMaxIdleConns:          c.Uint64(flags.DatabaseMaxIdleConns.Name),
 		DatabaseMaxOpenConns:          c.Uint64(flags.DatabaseMaxOpenConns.Name),
 		DatabaseMaxConnLifetime:       c.Uint64(flags.DatabaseConnMaxLifetime.Name),
@@ -51,11 +52,12 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 				Password:        c.String(flags.DatabasePassword.Name),
 				Database:        c.String(flags.DatabaseName.Name),
 				Host:            c.String(flags.DatabaseHost.Name),
+				Dialect:         c.String(flags.DatabaseDialect.Name),
 				MaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
 				MaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
 				MaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
-				OpenFunc: func(dsn string) (*db.Database, error) {
-					gormDB, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+				OpenFunc: func(dialector gorm.Dialector) (*db.Database, error) {
+					gormDB, err := gorm.Open(dialector, &gorm.Config{
 						Logger: logger.Default.LogMode(logger.Silent),
 					})
 					if err != nil {
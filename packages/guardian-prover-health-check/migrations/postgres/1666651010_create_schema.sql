@@ -0,0 +1,63 @@
+-- +goose Up
+-- Postgres schema of the guardian prover health check, equivalent to the MySQL migrations up to
+-- 1666651010_alter_startups_guardian_prover_address_index.sql.
+-- Addresses are compared case-insensitively by the MySQL default collation, so they are CITEXT here.
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS citext;
+
+CREATE TABLE IF NOT EXISTS health_checks (
+    id SERIAL PRIMARY KEY,
+    guardian_prover_id INT NOT NULL,
+    alive BOOLEAN NOT NULL DEFAULT false,
+    expected_address CITEXT NOT NULL,
+    recovered_address CITEXT NOT NULL DEFAULT '',
+    signed_response VARCHAR(5000) NOT NULL DEFAULT '',
+    latest_l1_block BIGINT NOT NULL,
+    latest_l2_block BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX health_checks_guardian_prover_id_index ON health_checks (guardian_prover_id);
+CREATE INDEX health_checks_guardian_prover_id_created_at_index ON health_checks (guardian_prover_id, created_at);
+CREATE INDEX health_checks_recovered_address_index ON health_checks (recovered_address);
+CREATE INDEX health_checks_recovered_address_created_at_index ON health_checks (recovered_address, created_at);
+
+CREATE TABLE IF NOT EXISTS signed_blocks (
+    id SERIAL PRIMARY KEY,
+    guardian_prover_id INT NOT NULL,
+    block_id INT NOT NULL,
+    signature VARCHAR(5000) NOT NULL,
+    block_hash VARCHAR(255) NOT NULL,
+    recovered_address CITEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT guardian_prover_id_block_id UNIQUE (guardian_prover_id, block_id),
+    CONSTRAINT guardian_prover_id_block_hash UNIQUE (guardian_prover_id, block_hash)
+);
+
+CREATE INDEX signed_blocks_block_id_index ON signed_blocks (block_id);
+CREATE INDEX signed_blocks_recovered_address_index ON signed_blocks (recovered_address);
+CREATE INDEX signed_blocks_recovered_address_block_id_index ON signed_blocks (recovered_address, block_id);
+
+CREATE TABLE IF NOT EXISTS startups (
+    id SERIAL PRIMARY KEY,
+    guardian_prover_id INT NOT NULL,
+    guardian_prover_address CITEXT NOT NULL,
+    revision VARCHAR(255) NOT NULL,
+    guardian_version VARCHAR(255) NOT NULL,
+    l1_node_version VARCHAR(255) NOT NULL,
+    l2_node_version VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX startups_guardian_prover_address_index ON startups (guardian_prover_address);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE startups;
+DROP TABLE signed_blocks;
+DROP TABLE health_checks;
+-- +goose StatementEnd
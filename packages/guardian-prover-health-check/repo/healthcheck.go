@@ -3,6 +3,7 @@ package repo
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/morkid/paginate"
 	"gorm.io/gorm"
@@ -107,9 +108,12 @@ func (r *HealthCheckRepository) GetUptimeByGuardianProverAddress(
 	var query string = `SELECT COUNT(*)
 	FROM health_checks
 	WHERE recovered_address = ? AND
-	created_at > NOW() - INTERVAL 1 DAY`
+	created_at > ?`
 
-	if err := r.db.GormDB().WithContext(ctx).Raw(query, address).Scan(&count).Error; err != nil {
+	// the cutoff is computed here, since interval arithmetic differs between MySQL and Postgres
+	since := time.Now().UTC().Add(-24 * time.Hour)
+
+	if err := r.db.GormDB().WithContext(ctx).Raw(query, address, since).Scan(&count).Error; err != nil {
 		return 0, 0, err
 	}
 
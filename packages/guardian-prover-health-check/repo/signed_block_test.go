@@ -37,35 +37,32 @@ func Test_NewSignedBlockRepo(t *testing.T) {
 }
 
 func TestIntegration_SignedBlock_Save(t *testing.T) {
-	db, close, err := testMysql(t)
-	assert.Equal(t, nil, err)
-
-	defer close()
-
-	SignedBlockRepo, err := NewSignedBlockRepository(db)
-	assert.Equal(t, nil, err)
-	tests := []struct {
-		name    string
-		opts    guardianproverhealthcheck.SaveSignedBlockOpts
-		wantErr error
-	}{
-		{
-			"success",
-			guardianproverhealthcheck.SaveSignedBlockOpts{
-				GuardianProverID: 1,
-				RecoveredAddress: "0x123",
-				Signature:        "0x456",
-				BlockID:          1,
-				BlockHash:        "0x987",
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		SignedBlockRepo, err := NewSignedBlockRepository(db)
+		assert.Equal(t, nil, err)
+		tests := []struct {
+			name    string
+			opts    guardianproverhealthcheck.SaveSignedBlockOpts
+			wantErr error
+		}{
+			{
+				"success",
+				guardianproverhealthcheck.SaveSignedBlockOpts{
+					GuardianProverID: 1,
+					RecoveredAddress: "0x123",
+					Signature:        "0x456",
+					BlockID:          1,
+					BlockHash:        "0x987",
+				},
+				nil,
 			},
-			nil,
-		},
-	}
+		}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			err = SignedBlockRepo.Save(context.Background(), &tt.opts)
-			assert.Equal(t, tt.wantErr, err)
-		})
-	}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err = SignedBlockRepo.Save(context.Background(), &tt.opts)
+				assert.Equal(t, tt.wantErr, err)
+			})
+		}
+	})
 }
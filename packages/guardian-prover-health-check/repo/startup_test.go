@@ -38,90 +38,84 @@ func Test_NewStartupRepo(t *testing.T) {
 }
 
 func TestIntegration_Startup_Save(t *testing.T) {
-	db, close, err := testMysql(t)
-	assert.Equal(t, nil, err)
-
-	defer close()
-
-	startupRepo, err := NewStartupRepository(db)
-	assert.Equal(t, nil, err)
-	tests := []struct {
-		name    string
-		opts    guardianproverhealthcheck.SaveStartupOpts
-		wantErr error
-	}{
-		{
-			"success",
-			guardianproverhealthcheck.SaveStartupOpts{
-				GuardianProverID:      1,
-				GuardianProverAddress: "0x123",
-				Revision:              "asdf",
-				GuardianVersion:       "v1.0.0",
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		startupRepo, err := NewStartupRepository(db)
+		assert.Equal(t, nil, err)
+		tests := []struct {
+			name    string
+			opts    guardianproverhealthcheck.SaveStartupOpts
+			wantErr error
+		}{
+			{
+				"success",
+				guardianproverhealthcheck.SaveStartupOpts{
+					GuardianProverID:      1,
+					GuardianProverAddress: "0x123",
+					Revision:              "asdf",
+					GuardianVersion:       "v1.0.0",
+				},
+				nil,
 			},
-			nil,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			err = startupRepo.Save(context.Background(), &tt.opts)
-			assert.Equal(t, tt.wantErr, err)
-		})
-	}
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err = startupRepo.Save(context.Background(), &tt.opts)
+				assert.Equal(t, tt.wantErr, err)
+			})
+		}
+	})
 }
 
 func TestIntegration_Startup_GetByGuardianProverID(t *testing.T) {
-	db, close, err := testMysql(t)
-	assert.Equal(t, nil, err)
-
-	defer close()
-
-	startupRepo, err := NewStartupRepository(db)
-	assert.Equal(t, nil, err)
-
-	err = startupRepo.Save(context.Background(), &guardianproverhealthcheck.SaveStartupOpts{
-		GuardianProverID:      1,
-		GuardianProverAddress: "0x123",
-		Revision:              "asdf",
-		GuardianVersion:       "v1.0.0",
-		L1NodeVersion:         "v0.1.0",
-		L2NodeVersion:         "v0.0.1",
-	})
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		startupRepo, err := NewStartupRepository(db)
+		assert.Equal(t, nil, err)
+
+		err = startupRepo.Save(context.Background(), &guardianproverhealthcheck.SaveStartupOpts{
+			GuardianProverID:      1,
+			GuardianProverAddress: "0x123",
+			Revision:              "asdf",
+			GuardianVersion:       "v1.0.0",
+			L1NodeVersion:         "v0.1.0",
+			L2NodeVersion:         "v0.0.1",
+		})
 
-	assert.Equal(t, nil, err)
+		assert.Equal(t, nil, err)
 
-	err = startupRepo.Save(context.Background(), &guardianproverhealthcheck.SaveStartupOpts{
-		GuardianProverID:      1,
-		GuardianProverAddress: "0x123",
-		Revision:              "zxxc",
-		GuardianVersion:       "v1.0.1",
-		L1NodeVersion:         "v0.1.0",
-		L2NodeVersion:         "v0.0.1",
-	})
-
-	assert.Equal(t, nil, err)
+		err = startupRepo.Save(context.Background(), &guardianproverhealthcheck.SaveStartupOpts{
+			GuardianProverID:      1,
+			GuardianProverAddress: "0x123",
+			Revision:              "zxxc",
+			GuardianVersion:       "v1.0.1",
+			L1NodeVersion:         "v0.1.0",
+			L2NodeVersion:         "v0.0.1",
+		})
 
-	tests := []struct {
-		name    string
-		address string
-		wantErr error
-	}{
-		{
-			"success",
-			"0x123",
-			nil,
-		},
-	}
+		assert.Equal(t, nil, err)
+
+		tests := []struct {
+			name    string
+			address string
+			wantErr error
+		}{
+			{
+				"success",
+				"0x123",
+				nil,
+			},
+		}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			req, err := http.NewRequest(http.MethodGet, "/signedBlock", nil)
-			assert.Equal(t, nil, err)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				req, err := http.NewRequest(http.MethodGet, "/signedBlock", nil)
+				assert.Equal(t, nil, err)
 
-			page, err := startupRepo.GetByGuardianProverAddress(context.Background(), req, tt.address)
-			assert.Equal(t, nil, err)
+				page, err := startupRepo.GetByGuardianProverAddress(context.Background(), req, tt.address)
+				assert.Equal(t, nil, err)
 
-			assert.Equal(t, page.Total, int64(2))
-		})
-	}
+				assert.Equal(t, page.Total, int64(2))
+			})
+		}
+	})
 }
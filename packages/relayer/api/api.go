@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/labstack/echo/v4"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/taikol2"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/feeoracle"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/http"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/repo"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/utils"
@@ -46,21 +47,40 @@ func InitFromConfig(ctx context.Context, api *API, cfg *Config) (err error) {
 		return err
 	}
 
-	srcEthClient, err := ethclient.Dial(cfg.SrcRPCUrl)
+	recallableMessageRepository, err := repo.NewRecallableMessageRepository(db)
 	if err != nil {
 		return err
 	}
 
-	destEthClient, err := ethclient.Dial(cfg.DestRPCUrl)
+	srcEthClient, err := ethclient.Dial(cfg.SrcRPCUrl)
 	if err != nil {
 		return err
 	}
 
-	taikoL2, err := taikol2.NewTaikoL2(cfg.DestTaikoAddress, destEthClient)
+	destEthClient, err := ethclient.Dial(cfg.DestRPCUrl)
 	if err != nil {
 		return err
 	}
 
+	// the destination fee oracle follows the processor configuration of the route
+	var taikoL2 feeoracle.TaikoL2
+	if cfg.EnableTaikoL2 {
+		if taikoL2, err = taikol2.NewTaikoL2(cfg.DestTaikoAddress, destEthClient); err != nil {
+			return err
+		}
+	}
+
+	hopEthClients := make([]feeoracle.BlockClient, 0, len(cfg.HopRPCUrls))
+
+	for _, hopRPCUrl := range cfg.HopRPCUrls {
+		hopEthClient, err := ethclient.Dial(hopRPCUrl)
+		if err != nil {
+			return err
+		}
+
+		hopEthClients = append(hopEthClients, hopEthClient)
+	}
+
 	srv, err := http.NewServer(http.NewServerOpts{
 		EventRepo:               eventRepository,
 		Echo:                    echo.New(),
@@ -68,7 +88,10 @@ func InitFromConfig(ctx context.Context, api *API, cfg *Config) (err error) {
 		SrcEthClient:            srcEthClient,
 		DestEthClient:           destEthClient,
 		TaikoL2:                 taikoL2,
+		HopEthClients:           hopEthClients,
 		ProcessingFeeMultiplier: cfg.ProcessingFeeMultiplier,
+		FeeOracleMargin:         cfg.FeeOracleMargin,
+		RecallableMessageRepo:   recallableMessageRepository,
 	})
 	if err != nil {
 		return err
@@ -7,7 +7,6 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
 	"github.com/urfave/cli/v2"
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
@@ -18,6 +17,7 @@ type Config struct {
 	DatabasePassword        string
 	DatabaseName            string
 	DatabaseHost            string
+	DatabaseDialect         string
 	DatabaseMaxIdleConns    uint64
 	DatabaseMaxOpenConns    uint64
 	DatabaseMaxConnLifetime uint64
@@ -26,7 +26,10 @@ type Config struct {
 	SrcRPCUrl               string
 	DestRPCUrl              string
 	ProcessingFeeMultiplier float64
+	FeeOracleMargin         float64
 	DestTaikoAddress        common.Address
+	EnableTaikoL2           bool
+	HopRPCUrls              []string
 	HTTPPort                uint64
 	OpenDBFunc              func() (db.DB, error)
 }
@@ -38,6 +41,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		DatabasePassword:        c.String(flags.DatabasePassword.Name),
 		DatabaseName:            c.String(flags.DatabaseName.Name),
 		DatabaseHost:            c.String(flags.DatabaseHost.Name),
+		DatabaseDialect:         c.String(flags.DatabaseDialect.Name),
 		DatabaseMaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
 		DatabaseMaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
 		DatabaseMaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
@@ -46,18 +50,22 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		SrcRPCUrl:               c.String(flags.SrcRPCUrl.Name),
 		DestRPCUrl:              c.String(flags.DestRPCUrl.Name),
 		ProcessingFeeMultiplier: c.Float64(flags.ProcessingFeeMultiplier.Name),
+		FeeOracleMargin:         c.Float64(flags.FeeOracleMargin.Name),
 		DestTaikoAddress:        common.HexToAddress(c.String(flags.DestTaikoAddress.Name)),
+		EnableTaikoL2:           c.Bool(flags.EnableTaikoL2.Name),
+		HopRPCUrls:              c.StringSlice(flags.HopRPCUrls.Name),
 		OpenDBFunc: func() (db.DB, error) {
 			return db.OpenDBConnection(db.DBConnectionOpts{
 				Name:            c.String(flags.DatabaseUsername.Name),
 				Password:        c.String(flags.DatabasePassword.Name),
 				Database:        c.String(flags.DatabaseName.Name),
 				Host:            c.String(flags.DatabaseHost.Name),
+				Dialect:         c.String(flags.DatabaseDialect.Name),
 				MaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
 				MaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
 				MaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
-				OpenFunc: func(dsn string) (db.DB, error) {
-					gormDB, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+				OpenFunc: func(dialector gorm.Dialector) (db.DB, error) {
+					gormDB, err := gorm.Open(dialector, &gorm.Config{
 						Logger: logger.Default.LogMode(logger.Silent),
 					})
 					if err != nil {
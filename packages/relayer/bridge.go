@@ -20,4 +20,5 @@ type Bridge interface {
 	IsMessageReceived(opts *bind.CallOpts, _message bridge.IBridgeMessage, _proof []byte) (bool, error)
 	SendMessage(opts *bind.TransactOpts, _message bridge.IBridgeMessage) (*types.Transaction, error)
 	Paused(opts *bind.CallOpts) (bool, error)
+	SignalForFailedMessage(opts *bind.CallOpts, _msgHash [32]byte) ([32]byte, error)
 }
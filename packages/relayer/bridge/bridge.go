@@ -14,11 +14,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/urfave/cli/v2"
 	"golang.org/x/exp/slog"
 
-	"github.com/taikoxyz/taiko-mono/packages/relayer"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
 )
 
@@ -37,14 +35,10 @@ type ethClient interface {
 type Bridge struct {
 	cancel context.CancelFunc
 
-	srcEthClient  ethClient
-	destEthClient ethClient
+	routes []*route
 
 	ecdsaKey *ecdsa.PrivateKey
 
-	srcBridge  relayer.Bridge
-	destBridge relayer.Bridge
-
 	addr common.Address
 
 	backOffRetryInterval time.Duration
@@ -53,10 +47,14 @@ type Bridge struct {
 
 	wg sync.WaitGroup
 
-	srcChainId  *big.Int
-	destChainId *big.Int
-
 	bridgeMessageValue *big.Int
+
+	canaryEnabled         bool
+	canaryInterval        time.Duration
+	canaryPollInterval    time.Duration
+	canarySLO             time.Duration
+	canaryTimeout         time.Duration
+	canaryAlertWebhookURL string
 }
 
 func (b *Bridge) InitFromCli(ctx context.Context, c *cli.Context) error {
@@ -70,34 +68,30 @@ func (b *Bridge) InitFromCli(ctx context.Context, c *cli.Context) error {
 
 // nolint: funlen
 func InitFromConfig(ctx context.Context, b *Bridge, cfg *Config) error {
-	srcEthClient, err := ethclient.Dial(cfg.SrcRPCUrl)
-	if err != nil {
-		return err
-	}
+	canaryRoutes := cfg.CanaryRoutes
 
-	destEthClient, err := ethclient.Dial(cfg.DestRPCUrl)
-	if err != nil {
-		return err
+	// without a route table, the bridge sends messages across the configured route.
+	if len(canaryRoutes) == 0 {
+		canaryRoutes = []CanaryRoute{
+			{
+				SrcRPCUrl:                cfg.SrcRPCUrl,
+				DestRPCUrl:               cfg.DestRPCUrl,
+				SrcBridgeAddress:         cfg.SrcBridgeAddress,
+				DestBridgeAddress:        cfg.DestBridgeAddress,
+				DestSignalServiceAddress: cfg.DestSignalServiceAddress,
+			},
+		}
 	}
 
-	srcBridge, err := bridge.NewBridge(cfg.SrcBridgeAddress, srcEthClient)
-	if err != nil {
-		return err
-	}
+	routes := make([]*route, 0, len(canaryRoutes))
 
-	destBridge, err := bridge.NewBridge(cfg.DestBridgeAddress, destEthClient)
-	if err != nil {
-		return err
-	}
+	for _, r := range canaryRoutes {
+		rt, err := newRoute(ctx, r)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("newRoute %v", r.Name))
+		}
 
-	srcChainID, err := srcEthClient.ChainID(context.Background())
-	if err != nil {
-		return err
-	}
-
-	destChainID, err := destEthClient.ChainID(context.Background())
-	if err != nil {
-		return err
+		routes = append(routes, rt)
 	}
 
 	publicKey := cfg.BridgePrivateKey.Public()
@@ -107,24 +101,24 @@ func InitFromConfig(ctx context.Context, b *Bridge, cfg *Config) error {
 		return errors.New("unable to convert public key")
 	}
 
-	b.srcEthClient = srcEthClient
-	b.destEthClient = destEthClient
-
-	b.destBridge = destBridge
-	b.srcBridge = srcBridge
+	b.routes = routes
 
 	b.ecdsaKey = cfg.BridgePrivateKey
 	b.addr = crypto.PubkeyToAddress(*publicKeyECDSA)
 
-	b.srcChainId = srcChainID
-	b.destChainId = destChainID
-
 	b.backOffRetryInterval = time.Duration(cfg.BackoffRetryInterval) * time.Second
 	b.backOffMaxRetries = cfg.BackOffMaxRetrys
 	b.ethClientTimeout = time.Duration(cfg.ETHClientTimeout) * time.Second
 
 	b.bridgeMessageValue = cfg.BridgeMessageValue
 
+	b.canaryEnabled = cfg.CanaryEnabled
+	b.canaryInterval = cfg.CanaryInterval
+	b.canaryPollInterval = cfg.CanaryPollInterval
+	b.canarySLO = cfg.CanarySLO
+	b.canaryTimeout = cfg.CanaryTimeout
+	b.canaryAlertWebhookURL = cfg.CanaryAlertWebhookURL
+
 	return nil
 }
 
@@ -145,13 +139,25 @@ func (b *Bridge) Start() error {
 
 	b.cancel = cancel
 
-	_ = b.submitBridgeTx(ctx)
+	if b.canaryEnabled {
+		b.wg.Add(1)
+
+		go b.canaryLoop(ctx)
+
+		return nil
+	}
+
+	for _, r := range b.routes {
+		if _, err := b.submitBridgeTx(ctx, r); err != nil {
+			slog.Error("error submitting bridge tx", "route", r.name, "error", err)
+		}
+	}
 
 	return nil
 }
 
-func (b *Bridge) setLatestNonce(ctx context.Context, auth *bind.TransactOpts) error {
-	pendingNonce, err := b.srcEthClient.PendingNonceAt(ctx, b.addr)
+func (b *Bridge) setLatestNonce(ctx context.Context, r *route, auth *bind.TransactOpts) error {
+	pendingNonce, err := r.srcEthClient.PendingNonceAt(ctx, b.addr)
 	if err != nil {
 		return err
 	}
@@ -162,7 +168,7 @@ func (b *Bridge) setLatestNonce(ctx context.Context, auth *bind.TransactOpts) er
 }
 
 func (b *Bridge) estimateGas(
-	ctx context.Context, message bridge.IBridgeMessage) (uint64, error) {
+	ctx context.Context, r *route, message bridge.IBridgeMessage) (uint64, error) {
 	auth, err := bind.NewKeyedTransactorWithChainID(b.ecdsaKey, new(big.Int).SetUint64(message.SrcChainId))
 	if err != nil {
 		return 0, errors.Wrap(err, "bind.NewKeyedTransactorWithChainID")
@@ -173,7 +179,7 @@ func (b *Bridge) estimateGas(
 	auth.Context = ctx
 	auth.GasLimit = 500000
 
-	tx, err := b.srcBridge.SendMessage(auth, message)
+	tx, err := r.srcBridge.SendMessage(auth, message)
 	if err != nil {
 		fmt.Println(err)
 		return 0, errors.Wrap(err, "rcBridge.SendMessage")
@@ -184,27 +190,17 @@ func (b *Bridge) estimateGas(
 	return tx.Gas() + gasPaddingAmt, nil
 }
 
-func (b *Bridge) submitBridgeTx(ctx context.Context) error {
-	srcChainId, err := b.srcEthClient.ChainID(ctx)
-	if err != nil {
-		return errors.Wrap(err, "b.srcEthClient.ChainID")
-	}
-
-	destChainId, err := b.destEthClient.ChainID(ctx)
+func (b *Bridge) submitBridgeTx(ctx context.Context, r *route) (*types.Transaction, error) {
+	auth, err := bind.NewKeyedTransactorWithChainID(b.ecdsaKey, new(big.Int).SetUint64(r.srcChainId.Uint64()))
 	if err != nil {
-		return errors.Wrap(err, "b.destEthClient.ChainID")
-	}
-
-	auth, err := bind.NewKeyedTransactorWithChainID(b.ecdsaKey, new(big.Int).SetUint64(srcChainId.Uint64()))
-	if err != nil {
-		return errors.Wrap(err, "b.NewKeyedTransactorWithChainID")
+		return nil, errors.Wrap(err, "b.NewKeyedTransactorWithChainID")
 	}
 
 	auth.Context = ctx
 
-	err = b.setLatestNonce(ctx, auth)
+	err = b.setLatestNonce(ctx, r, auth)
 	if err != nil {
-		return errors.New("b.setLatestNonce")
+		return nil, errors.New("b.setLatestNonce")
 	}
 
 	processingFee := big.NewInt(10000)
@@ -215,8 +211,8 @@ func (b *Bridge) submitBridgeTx(ctx context.Context) error {
 	message := bridge.IBridgeMessage{
 		Id:          0,
 		From:        b.addr,
-		SrcChainId:  srcChainId.Uint64(),
-		DestChainId: destChainId.Uint64(),
+		SrcChainId:  r.srcChainId.Uint64(),
+		DestChainId: r.destChainId.Uint64(),
 		SrcOwner:    b.addr,
 		DestOwner:   b.addr,
 		To:          b.addr,
@@ -226,20 +222,20 @@ func (b *Bridge) submitBridgeTx(ctx context.Context) error {
 		Data:        []byte{},
 	}
 
-	gas, err := b.estimateGas(ctx, message)
+	gas, err := b.estimateGas(ctx, r, message)
 	if err != nil || gas == 0 {
 		slog.Info("gas estimation failed, hardcoding gas limit", "b.estimateGas:", err)
 	}
 
 	auth.GasLimit = gas
 
-	tx, err := b.srcBridge.SendMessage(auth, message)
+	tx, err := r.srcBridge.SendMessage(auth, message)
 	if err != nil {
 		fmt.Println("b.srcBridge.SendMessage", err)
-		return errors.Wrap(err, "rcBridge.SendMessage")
+		return nil, errors.Wrap(err, "rcBridge.SendMessage")
 	}
 
-	slog.Info("Sent tx", "txHash", hex.EncodeToString(tx.Hash().Bytes()))
+	slog.Info("Sent tx", "route", r.name, "txHash", hex.EncodeToString(tx.Hash().Bytes()))
 
-	return nil
+	return tx, nil
 }
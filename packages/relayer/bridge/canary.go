@@ -0,0 +1,338 @@
+package bridge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slog"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+)
+
+// The stages a canary message goes through, in order.
+const (
+	// canaryStageSent is complete once the MessageSent event is emitted on the source chain.
+	canaryStageSent = "sent"
+	// canaryStageSynced is complete once the source block is synced to the destination chain.
+	canaryStageSynced = "synced"
+	// canaryStageProcessed is complete once the message is processed on the destination chain.
+	canaryStageProcessed = "processed"
+	// canaryStageTotal is the latency from sending the message to its processing.
+	canaryStageTotal = "total"
+)
+
+var (
+	errCanaryMessageFailed = errors.New("canary message failed")
+
+	stateRootKind = crypto.Keccak256Hash([]byte("STATE_ROOT"))
+
+	alertTimeout = 10 * time.Second
+)
+
+// canaryMessage is a canary message followed through its stages.
+type canaryMessage struct {
+	route *route
+
+	txHash      common.Hash
+	msgHash     common.Hash
+	blockNumber uint64
+
+	// stage is the stage the message is waiting to complete.
+	stage          string
+	sentAt         time.Time
+	stageStartedAt time.Time
+
+	alerted bool
+}
+
+// canaryAlert is the body POSTed to the alert webhook when a canary message exceeds the SLO.
+type canaryAlert struct {
+	Route   string `json:"route"`
+	TxHash  string `json:"txHash"`
+	MsgHash string `json:"msgHash"`
+	Stage   string `json:"stage"`
+	Elapsed string `json:"elapsed"`
+	SLO     string `json:"slo"`
+}
+
+// canaryLoop sends a canary message across every route every canaryInterval.
+func (b *Bridge) canaryLoop(ctx context.Context) {
+	defer b.wg.Done()
+
+	t := time.NewTicker(b.canaryInterval)
+	defer t.Stop()
+
+	b.sendCanaryMessages(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("canary loop context done")
+			return
+		case <-t.C:
+			b.sendCanaryMessages(ctx)
+		}
+	}
+}
+
+// sendCanaryMessages sends a canary message across every route, and follows each of them
+// until it is processed on the destination chain.
+func (b *Bridge) sendCanaryMessages(ctx context.Context) {
+	for _, r := range b.routes {
+		sentAt := time.Now()
+
+		tx, err := b.submitBridgeTx(ctx, r)
+		if err != nil {
+			slog.Error("error sending canary message", "route", r.name, "error", err)
+			relayer.CanaryFailures.WithLabelValues(r.name, canaryStageSent).Inc()
+
+			continue
+		}
+
+		relayer.CanaryMessagesSent.WithLabelValues(r.name).Inc()
+
+		b.wg.Add(1)
+
+		go b.followCanaryMessage(ctx, &canaryMessage{
+			route:          r,
+			txHash:         tx.Hash(),
+			stage:          canaryStageSent,
+			sentAt:         sentAt,
+			stageStartedAt: sentAt,
+		})
+	}
+}
+
+// followCanaryMessage polls the stage of the given message every canaryPollInterval, until it
+// is processed, it fails, or canaryTimeout elapses. An alert is raised once the message was
+// not processed within the SLO.
+func (b *Bridge) followCanaryMessage(ctx context.Context, m *canaryMessage) {
+	defer b.wg.Done()
+
+	relayer.CanaryInflightMessages.WithLabelValues(m.route.name).Inc()
+	defer relayer.CanaryInflightMessages.WithLabelValues(m.route.name).Dec()
+
+	t := time.NewTicker(b.canaryPollInterval)
+	defer t.Stop()
+
+	for {
+		done, err := b.advanceCanaryMessage(ctx, m)
+		if err != nil {
+			if errors.Is(err, errCanaryMessageFailed) {
+				slog.Error("canary message failed",
+					"route", m.route.name,
+					"txHash", m.txHash.Hex(),
+					"stage", m.stage,
+					"error", err,
+				)
+				relayer.CanaryFailures.WithLabelValues(m.route.name, m.stage).Inc()
+
+				return
+			}
+
+			// other errors are retried on the next poll.
+			slog.Warn("error checking canary message", "route", m.route.name, "stage", m.stage, "error", err)
+		}
+
+		if done {
+			return
+		}
+
+		elapsed := time.Since(m.sentAt)
+
+		if elapsed > b.canarySLO && !m.alerted {
+			m.alerted = true
+
+			b.alertCanarySLO(ctx, m, elapsed)
+		}
+
+		if elapsed > b.canaryTimeout {
+			slog.Error("canary message timed out",
+				"route", m.route.name,
+				"txHash", m.txHash.Hex(),
+				"stage", m.stage,
+				"elapsed", elapsed,
+			)
+			relayer.CanaryFailures.WithLabelValues(m.route.name, m.stage).Inc()
+
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// advanceCanaryMessage completes as many stages of the given message as possible, and returns
+// whether the message was processed.
+func (b *Bridge) advanceCanaryMessage(ctx context.Context, m *canaryMessage) (bool, error) {
+	for {
+		switch m.stage {
+		case canaryStageSent:
+			receipt, err := m.route.srcEthClient.TransactionReceipt(ctx, m.txHash)
+			if err != nil {
+				if err == ethereum.NotFound {
+					return false, nil
+				}
+
+				return false, errors.Wrap(err, "m.route.srcEthClient.TransactionReceipt")
+			}
+
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				return false, errors.Wrap(errCanaryMessageFailed, "sendMessage transaction reverted")
+			}
+
+			event, err := m.route.messageSentEvent(receipt)
+			if err != nil {
+				return false, err
+			}
+
+			m.msgHash = event.MsgHash
+			m.blockNumber = receipt.BlockNumber.Uint64()
+
+			completeCanaryStage(m, canaryStageSynced)
+		case canaryStageSynced:
+			synced, err := m.route.destSignalService.GetSyncedChainData(&bind.CallOpts{
+				Context: ctx,
+			}, m.route.srcChainId.Uint64(), stateRootKind, 0)
+			if err != nil {
+				return false, errors.Wrap(err, "m.route.destSignalService.GetSyncedChainData")
+			}
+
+			if synced.BlockId < m.blockNumber {
+				return false, nil
+			}
+
+			completeCanaryStage(m, canaryStageProcessed)
+		case canaryStageProcessed:
+			status, err := m.route.destBridge.MessageStatus(&bind.CallOpts{
+				Context: ctx,
+			}, m.msgHash)
+			if err != nil {
+				return false, errors.Wrap(err, "m.route.destBridge.MessageStatus")
+			}
+
+			switch relayer.EventStatus(status) {
+			case relayer.EventStatusNew:
+				return false, nil
+			case relayer.EventStatusDone:
+				completeCanaryStage(m, "")
+
+				relayer.CanaryStageLatency.WithLabelValues(m.route.name, canaryStageTotal).
+					Observe(time.Since(m.sentAt).Seconds())
+
+				slog.Info("canary message processed",
+					"route", m.route.name,
+					"msgHash", m.msgHash.Hex(),
+					"latency", time.Since(m.sentAt),
+				)
+
+				return true, nil
+			default:
+				return false, errors.Wrap(
+					errCanaryMessageFailed,
+					fmt.Sprintf("message status %v", relayer.EventStatus(status).String()),
+				)
+			}
+		default:
+			return true, nil
+		}
+	}
+}
+
+// completeCanaryStage records the latency of the current stage of the message, and moves it to
+// the next stage.
+func completeCanaryStage(m *canaryMessage, next string) {
+	relayer.CanaryStageLatency.WithLabelValues(m.route.name, m.stage).
+		Observe(time.Since(m.stageStartedAt).Seconds())
+
+	m.stage = next
+	m.stageStartedAt = time.Now()
+}
+
+// messageSentEvent returns the MessageSent event emitted by the source bridge in the given receipt.
+func (r *route) messageSentEvent(receipt *types.Receipt) (*bridge.BridgeMessageSent, error) {
+	for _, log := range receipt.Logs {
+		if log.Address != r.srcBridgeAddress {
+			continue
+		}
+
+		event, err := r.srcBridge.ParseMessageSent(*log)
+		if err != nil {
+			continue
+		}
+
+		return event, nil
+	}
+
+	return nil, errors.Wrap(errCanaryMessageFailed, "no MessageSent event in the receipt")
+}
+
+// alertCanarySLO alerts that the given message was not processed within the SLO, and POSTs the
+// alert to the alert webhook if configured.
+func (b *Bridge) alertCanarySLO(ctx context.Context, m *canaryMessage, elapsed time.Duration) {
+	relayer.CanarySLOBreaches.WithLabelValues(m.route.name, m.stage).Inc()
+
+	slog.Error("canary message exceeded the SLO",
+		"route", m.route.name,
+		"txHash", m.txHash.Hex(),
+		"msgHash", m.msgHash.Hex(),
+		"stage", m.stage,
+		"elapsed", elapsed,
+		"slo", b.canarySLO,
+	)
+
+	if b.canaryAlertWebhookURL == "" {
+		return
+	}
+
+	body, err := json.Marshal(canaryAlert{
+		Route:   m.route.name,
+		TxHash:  m.txHash.Hex(),
+		MsgHash: m.msgHash.Hex(),
+		Stage:   m.stage,
+		Elapsed: elapsed.String(),
+		SLO:     b.canarySLO.String(),
+	})
+	if err != nil {
+		slog.Error("error marshaling canary alert", "error", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, alertTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.canaryAlertWebhookURL, bytes.NewReader(body))
+	if err != nil {
+		slog.Error("error building canary alert request", "error", err)
+		return
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		slog.Error("error sending canary alert", "error", err)
+		return
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		slog.Error("canary alert rejected", "status", resp.StatusCode)
+	}
+}
@@ -0,0 +1,200 @@
+package bridge
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+var (
+	testSrcBridgeAddress = common.HexToAddress("0x1")
+	testMsgHash          = common.HexToHash("0x123")
+)
+
+// receiptEthClient is a mock eth client returning the configured receipt.
+type receiptEthClient struct {
+	mock.EthClient
+	receipt *types.Receipt
+}
+
+func (c *receiptEthClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return c.receipt, nil
+}
+
+// canaryBridge is a mock bridge emitting MessageSent events for the test message, and
+// returning its configured status.
+type canaryBridge struct {
+	mock.Bridge
+	status relayer.EventStatus
+}
+
+func (b *canaryBridge) ParseMessageSent(log types.Log) (*bridge.BridgeMessageSent, error) {
+	return &bridge.BridgeMessageSent{MsgHash: testMsgHash}, nil
+}
+
+func (b *canaryBridge) MessageStatus(opts *bind.CallOpts, msgHash [32]byte) (uint8, error) {
+	return uint8(b.status), nil
+}
+
+// syncingSignalService is a mock signal service which synced the source chain up to the
+// configured block.
+type syncingSignalService struct {
+	mock.SignalService
+	syncedBlockID uint64
+}
+
+func (s *syncingSignalService) GetSyncedChainData(
+	opts *bind.CallOpts,
+	_chainId uint64,
+	_kind [32]byte,
+	_blockId uint64,
+) (struct {
+	BlockId   uint64
+	ChainData [32]byte
+}, error) {
+	return struct {
+		BlockId   uint64
+		ChainData [32]byte
+	}{
+		BlockId: s.syncedBlockID,
+	}, nil
+}
+
+func newTestCanaryMessage() *canaryMessage {
+	b := &canaryBridge{}
+
+	return &canaryMessage{
+		route: &route{
+			name: "test",
+			srcEthClient: &receiptEthClient{
+				receipt: &types.Receipt{
+					Status:      types.ReceiptStatusSuccessful,
+					BlockNumber: big.NewInt(10),
+					Logs:        []*types.Log{{Address: testSrcBridgeAddress}},
+				},
+			},
+			srcBridgeAddress:  testSrcBridgeAddress,
+			srcBridge:         b,
+			destBridge:        b,
+			destSignalService: &syncingSignalService{},
+			srcChainId:        big.NewInt(1),
+			destChainId:       big.NewInt(2),
+		},
+		stage:          canaryStageSent,
+		sentAt:         time.Now(),
+		stageStartedAt: time.Now(),
+	}
+}
+
+func Test_advanceCanaryMessage(t *testing.T) {
+	b := &Bridge{}
+	m := newTestCanaryMessage()
+
+	// the source block is not synced yet.
+	m.route.destSignalService.(*syncingSignalService).syncedBlockID = 9
+
+	done, err := b.advanceCanaryMessage(context.Background(), m)
+	assert.Nil(t, err)
+	assert.False(t, done)
+	assert.Equal(t, canaryStageSynced, m.stage)
+	assert.Equal(t, testMsgHash, m.msgHash)
+	assert.Equal(t, uint64(10), m.blockNumber)
+
+	m.route.destSignalService.(*syncingSignalService).syncedBlockID = 10
+
+	done, err = b.advanceCanaryMessage(context.Background(), m)
+	assert.Nil(t, err)
+	assert.False(t, done)
+	assert.Equal(t, canaryStageProcessed, m.stage)
+
+	m.route.destBridge.(*canaryBridge).status = relayer.EventStatusDone
+
+	done, err = b.advanceCanaryMessage(context.Background(), m)
+	assert.Nil(t, err)
+	assert.True(t, done)
+}
+
+func Test_advanceCanaryMessage_failed(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(m *canaryMessage)
+	}{
+		{
+			"reverted",
+			func(m *canaryMessage) {
+				m.route.srcEthClient.(*receiptEthClient).receipt.Status = types.ReceiptStatusFailed
+			},
+		},
+		{
+			"noMessageSentEvent",
+			func(m *canaryMessage) {
+				m.route.srcEthClient.(*receiptEthClient).receipt.Logs = nil
+			},
+		},
+		{
+			"retriable",
+			func(m *canaryMessage) {
+				m.route.destSignalService.(*syncingSignalService).syncedBlockID = 10
+				m.route.destBridge.(*canaryBridge).status = relayer.EventStatusRetriable
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bridge{}
+			m := newTestCanaryMessage()
+			tt.modify(m)
+
+			done, err := b.advanceCanaryMessage(context.Background(), m)
+			assert.ErrorIs(t, err, errCanaryMessageFailed)
+			assert.False(t, done)
+		})
+	}
+}
+
+func Test_followCanaryMessage_timeout(t *testing.T) {
+	var alerts []canaryAlert
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert canaryAlert
+
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&alert))
+
+		alerts = append(alerts, alert)
+	}))
+	defer srv.Close()
+
+	b := &Bridge{
+		canaryPollInterval:    time.Millisecond,
+		canarySLO:             time.Millisecond,
+		canaryTimeout:         50 * time.Millisecond,
+		canaryAlertWebhookURL: srv.URL,
+	}
+
+	// the source block is never synced.
+	m := newTestCanaryMessage()
+
+	b.wg.Add(1)
+	b.followCanaryMessage(context.Background(), m)
+
+	assert.Equal(t, canaryStageSynced, m.stage)
+	assert.True(t, m.alerted)
+	assert.Equal(t, 1, len(alerts))
+	assert.Equal(t, "test", alerts[0].Route)
+	assert.Equal(t, canaryStageSynced, alerts[0].Stage)
+	assert.Equal(t, testMsgHash.Hex(), alerts[0].MsgHash)
+}
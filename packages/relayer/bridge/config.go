@@ -5,17 +5,20 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
 	"github.com/urfave/cli/v2"
 )
 
 type Config struct {
 	// address configs
-	SrcBridgeAddress  common.Address
-	DestBridgeAddress common.Address
+	SrcBridgeAddress         common.Address
+	DestBridgeAddress        common.Address
+	DestSignalServiceAddress common.Address
 
 	// private key
 	BridgePrivateKey *ecdsa.PrivateKey
@@ -36,6 +39,15 @@ type Config struct {
 
 	// BridgeMessage
 	BridgeMessageValue *big.Int
+
+	// canary configs
+	CanaryEnabled         bool
+	CanaryRoutes          []CanaryRoute
+	CanaryInterval        time.Duration
+	CanaryPollInterval    time.Duration
+	CanarySLO             time.Duration
+	CanaryTimeout         time.Duration
+	CanaryAlertWebhookURL string
 }
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
@@ -52,18 +64,39 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, errors.New("invalid bridgeMessageValue")
 	}
 
-	return &Config{
-		BridgePrivateKey:     bridgePrivateKey,
-		DestBridgeAddress:    common.HexToAddress(c.String(flags.DestBridgeAddress.Name)),
-		SrcBridgeAddress:     common.HexToAddress(c.String(flags.SrcBridgeAddress.Name)),
-		SrcRPCUrl:            c.String(flags.SrcRPCUrl.Name),
-		DestRPCUrl:           c.String(flags.DestRPCUrl.Name),
-		Confirmations:        c.Uint64(flags.Confirmations.Name),
-		ConfirmationsTimeout: c.Uint64(flags.ConfirmationTimeout.Name),
-		EnableTaikoL2:        c.Bool(flags.EnableTaikoL2.Name),
-		BackoffRetryInterval: c.Uint64(flags.BackOffRetryInterval.Name),
-		BackOffMaxRetrys:     c.Uint64(flags.BackOffMaxRetrys.Name),
-		ETHClientTimeout:     c.Uint64(flags.ETHClientTimeout.Name),
-		BridgeMessageValue:   bridgeMessageValue,
-	}, nil
+	cfg := &Config{
+		BridgePrivateKey:         bridgePrivateKey,
+		DestBridgeAddress:        common.HexToAddress(c.String(flags.DestBridgeAddress.Name)),
+		SrcBridgeAddress:         common.HexToAddress(c.String(flags.SrcBridgeAddress.Name)),
+		DestSignalServiceAddress: common.HexToAddress(c.String(flags.CanaryDestSignalServiceAddress.Name)),
+		SrcRPCUrl:                c.String(flags.SrcRPCUrl.Name),
+		DestRPCUrl:               c.String(flags.DestRPCUrl.Name),
+		Confirmations:            c.Uint64(flags.Confirmations.Name),
+		ConfirmationsTimeout:     c.Uint64(flags.ConfirmationTimeout.Name),
+		EnableTaikoL2:            c.Bool(flags.EnableTaikoL2.Name),
+		BackoffRetryInterval:     c.Uint64(flags.BackOffRetryInterval.Name),
+		BackOffMaxRetrys:         c.Uint64(flags.BackOffMaxRetrys.Name),
+		ETHClientTimeout:         c.Uint64(flags.ETHClientTimeout.Name),
+		BridgeMessageValue:       bridgeMessageValue,
+		CanaryEnabled:            c.Bool(flags.CanaryEnabled.Name),
+		CanaryInterval:           c.Duration(flags.CanaryInterval.Name),
+		CanaryPollInterval:       c.Duration(flags.CanaryPollInterval.Name),
+		CanarySLO:                c.Duration(flags.CanarySLO.Name),
+		CanaryTimeout:            c.Duration(flags.CanaryTimeout.Name),
+		CanaryAlertWebhookURL:    c.String(flags.CanaryAlertWebhookURL.Name),
+	}
+
+	if c.IsSet(flags.CanaryRoutesFile.Name) {
+		cfg.CanaryRoutes, err = LoadCanaryRoutes(c.String(flags.CanaryRoutesFile.Name))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the canary follows the messages until the source block is synced to the destination chain.
+	if cfg.CanaryEnabled && len(cfg.CanaryRoutes) == 0 && cfg.DestSignalServiceAddress == relayer.ZeroAddress {
+		return nil, errors.New("destSignalServiceAddress or canary.routes is required in canary mode")
+	}
+
+	return cfg, nil
 }
@@ -0,0 +1,151 @@
+package bridge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/signalservice"
+)
+
+// CanaryRoute is an entry of the route table of the bridge canary, which describes a source
+// and destination chain pair to send canary messages across.
+type CanaryRoute struct {
+	Name                     string         `json:"name"`
+	SrcRPCUrl                string         `json:"srcRpcUrl"`
+	DestRPCUrl               string         `json:"destRpcUrl"`
+	SrcBridgeAddress         common.Address `json:"srcBridgeAddress"`
+	DestBridgeAddress        common.Address `json:"destBridgeAddress"`
+	DestSignalServiceAddress common.Address `json:"destSignalServiceAddress"`
+}
+
+// LoadCanaryRoutes loads and validates the canary route table in the given JSON file.
+func LoadCanaryRoutes(path string) ([]CanaryRoute, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var routes []CanaryRoute
+	if err := json.Unmarshal(data, &routes); err != nil {
+		return nil, fmt.Errorf("invalid canary routes file %s: %w", path, err)
+	}
+
+	if len(routes) == 0 {
+		return nil, fmt.Errorf("no routes in %s", path)
+	}
+
+	names := make(map[string]bool, len(routes))
+
+	for i, r := range routes {
+		if r.Name == "" {
+			return nil, fmt.Errorf("route %d: name is required", i)
+		}
+
+		if names[r.Name] {
+			return nil, fmt.Errorf("route %s: duplicate name", r.Name)
+		}
+
+		names[r.Name] = true
+
+		if r.SrcRPCUrl == "" || r.DestRPCUrl == "" {
+			return nil, fmt.Errorf("route %s: srcRpcUrl and destRpcUrl are required", r.Name)
+		}
+
+		for field, addr := range map[string]common.Address{
+			"srcBridgeAddress":         r.SrcBridgeAddress,
+			"destBridgeAddress":        r.DestBridgeAddress,
+			"destSignalServiceAddress": r.DestSignalServiceAddress,
+		} {
+			if addr == relayer.ZeroAddress {
+				return nil, fmt.Errorf("route %s: %s is required", r.Name, field)
+			}
+		}
+	}
+
+	return routes, nil
+}
+
+// route is a source and destination chain pair the bridge sends messages across.
+type route struct {
+	name string
+
+	srcEthClient  ethClient
+	destEthClient ethClient
+
+	srcBridgeAddress common.Address
+
+	srcBridge  relayer.Bridge
+	destBridge relayer.Bridge
+
+	// destSignalService is only set in canary mode.
+	destSignalService relayer.SignalService
+
+	srcChainId  *big.Int
+	destChainId *big.Int
+}
+
+// newRoute connects to the chains of the given route. The route is named after its chain IDs
+// if it has no name.
+func newRoute(ctx context.Context, r CanaryRoute) (*route, error) {
+	srcEthClient, err := ethclient.Dial(r.SrcRPCUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	destEthClient, err := ethclient.Dial(r.DestRPCUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	srcBridge, err := bridge.NewBridge(r.SrcBridgeAddress, srcEthClient)
+	if err != nil {
+		return nil, err
+	}
+
+	destBridge, err := bridge.NewBridge(r.DestBridgeAddress, destEthClient)
+	if err != nil {
+		return nil, err
+	}
+
+	srcChainID, err := srcEthClient.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	destChainID, err := destEthClient.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rt := &route{
+		name:             r.Name,
+		srcEthClient:     srcEthClient,
+		destEthClient:    destEthClient,
+		srcBridgeAddress: r.SrcBridgeAddress,
+		srcBridge:        srcBridge,
+		destBridge:       destBridge,
+		srcChainId:       srcChainID,
+		destChainId:      destChainID,
+	}
+
+	if rt.name == "" {
+		rt.name = fmt.Sprintf("%v-to-%v", srcChainID, destChainID)
+	}
+
+	if r.DestSignalServiceAddress != relayer.ZeroAddress {
+		rt.destSignalService, err = signalservice.NewSignalService(r.DestSignalServiceAddress, destEthClient)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rt, nil
+}
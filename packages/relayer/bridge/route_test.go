@@ -0,0 +1,79 @@
+package bridge
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+var testCanaryRoutes = `[
+	{
+		"name": "l1-to-l2",
+		"srcRpcUrl": "http://l1",
+		"destRpcUrl": "http://l2",
+		"srcBridgeAddress": "0x0000000000000000000000000000000000000001",
+		"destBridgeAddress": "0x0000000000000000000000000000000000000002",
+		"destSignalServiceAddress": "0x0000000000000000000000000000000000000003"
+	},
+	{
+		"name": "l2-to-l1",
+		"srcRpcUrl": "http://l2",
+		"destRpcUrl": "http://l1",
+		"srcBridgeAddress": "0x0000000000000000000000000000000000000002",
+		"destBridgeAddress": "0x0000000000000000000000000000000000000001",
+		"destSignalServiceAddress": "0x0000000000000000000000000000000000000004"
+	}
+]`
+
+func writeCanaryRoutesFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "routes.json")
+	assert.Nil(t, os.WriteFile(path, []byte(content), 0600))
+
+	return path
+}
+
+func Test_LoadCanaryRoutes(t *testing.T) {
+	routes, err := LoadCanaryRoutes(writeCanaryRoutesFile(t, testCanaryRoutes))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(routes))
+	assert.Equal(t, "l1-to-l2", routes[0].Name)
+	assert.Equal(t, common.HexToAddress("0x4"), routes[1].DestSignalServiceAddress)
+}
+
+func Test_LoadCanaryRoutes_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"empty", `[]`},
+		{"invalidJSON", `{`},
+		{"noName", `[{"srcRpcUrl": "http://l1"}]`},
+		{
+			"duplicateName",
+			`[
+				{"name": "a", "srcRpcUrl": "http://l1", "destRpcUrl": "http://l2",
+				"srcBridgeAddress": "0x01", "destBridgeAddress": "0x02", "destSignalServiceAddress": "0x03"},
+				{"name": "a", "srcRpcUrl": "http://l1", "destRpcUrl": "http://l2",
+				"srcBridgeAddress": "0x01", "destBridgeAddress": "0x02", "destSignalServiceAddress": "0x03"}
+			]`,
+		},
+		{
+			"noSignalService",
+			`[{"name": "a", "srcRpcUrl": "http://l1", "destRpcUrl": "http://l2",
+			"srcBridgeAddress": "0x01", "destBridgeAddress": "0x02"}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadCanaryRoutes(writeCanaryRoutesFile(t, tt.content))
+			assert.NotNil(t, err)
+		})
+	}
+
+	_, err := LoadCanaryRoutes(filepath.Join(t.TempDir(), "missing.json"))
+	assert.NotNil(t, err)
+}
@@ -34,5 +34,8 @@ var APIFlags = MergeFlags(CommonFlags, []cli.Flag{
 	HTTPPort,
 	CORSOrigins,
 	ProcessingFeeMultiplier,
+	FeeOracleMargin,
 	DestTaikoAddress,
+	EnableTaikoL2,
+	HopRPCUrls,
 })
@@ -1,6 +1,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/urfave/cli/v2"
 )
 
@@ -19,6 +21,59 @@ var (
 		Category: bridgeCategory,
 		EnvVars:  []string{"BRIDGE_MESSAGE_VALUE"},
 	}
+	CanaryDestSignalServiceAddress = &cli.StringFlag{
+		Name:     "destSignalServiceAddress",
+		Usage:    "SignalService address for the destination chain, which syncs the source chain blocks",
+		Category: bridgeCategory,
+		EnvVars:  []string{"DEST_SIGNAL_SERVICE_ADDRESS"},
+	}
+	CanaryEnabled = &cli.BoolFlag{
+		Name:     "canary.enabled",
+		Usage:    "Send canary messages on a schedule, and follow them until they are processed",
+		Value:    false,
+		Category: bridgeCategory,
+		EnvVars:  []string{"CANARY_ENABLED"},
+	}
+	CanaryRoutesFile = &cli.StringFlag{
+		Name:     "canary.routes",
+		Usage:    "Path to a JSON file with the routes to send canary messages across, defaults to the configured route",
+		Category: bridgeCategory,
+		EnvVars:  []string{"CANARY_ROUTES"},
+	}
+	CanaryInterval = &cli.DurationFlag{
+		Name:     "canary.interval",
+		Usage:    "Interval between the canary messages sent on each route",
+		Value:    10 * time.Minute,
+		Category: bridgeCategory,
+		EnvVars:  []string{"CANARY_INTERVAL"},
+	}
+	CanaryPollInterval = &cli.DurationFlag{
+		Name:     "canary.pollInterval",
+		Usage:    "Interval between the checks of the stage of a canary message",
+		Value:    12 * time.Second,
+		Category: bridgeCategory,
+		EnvVars:  []string{"CANARY_POLL_INTERVAL"},
+	}
+	CanarySLO = &cli.DurationFlag{
+		Name:     "canary.slo",
+		Usage:    "Time for a canary message to be processed after it is sent, before alerting",
+		Value:    30 * time.Minute,
+		Category: bridgeCategory,
+		EnvVars:  []string{"CANARY_SLO"},
+	}
+	CanaryTimeout = &cli.DurationFlag{
+		Name:     "canary.timeout",
+		Usage:    "Time after which a canary message which is not processed is counted as failed",
+		Value:    2 * time.Hour,
+		Category: bridgeCategory,
+		EnvVars:  []string{"CANARY_TIMEOUT"},
+	}
+	CanaryAlertWebhookURL = &cli.StringFlag{
+		Name:     "canary.alertWebhookUrl",
+		Usage:    "URL to POST an alert to when a canary message exceeds the SLO",
+		Category: bridgeCategory,
+		EnvVars:  []string{"CANARY_ALERT_WEBHOOK_URL"},
+	}
 )
 
 var BridgeFlags = MergeFlags(CommonFlags, QueueFlags, []cli.Flag{
@@ -27,4 +82,12 @@ var BridgeFlags = MergeFlags(CommonFlags, QueueFlags, []cli.Flag{
 	SrcBridgeAddress,
 	DestBridgeAddress,
 	SrcTaikoAddress,
+	CanaryDestSignalServiceAddress,
+	CanaryEnabled,
+	CanaryRoutesFile,
+	CanaryInterval,
+	CanaryPollInterval,
+	CanarySLO,
+	CanaryTimeout,
+	CanaryAlertWebhookURL,
 })
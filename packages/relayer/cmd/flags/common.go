@@ -9,8 +9,10 @@ var (
 	indexerCategory   = "INDEXER"
 	processorCategory = "PROCESSOR"
 	watchdogCategory  = "WATCHDOG"
+	retrierCategory   = "RETRIER"
 	bridgeCategory    = "BRIDGE"
 	txmgrCategory     = "TX_MANAGER"
+	webhooksCategory  = "WEBHOOKS"
 )
 
 var (
@@ -42,6 +44,13 @@ var (
 		Category: commonCategory,
 		EnvVars:  []string{"DATABASE_NAME"},
 	}
+	DatabaseDialect = &cli.StringFlag{
+		Name:     "db.dialect",
+		Usage:    "Database dialect, one of: mysql, postgres",
+		Value:    "mysql",
+		Category: commonCategory,
+		EnvVars:  []string{"DATABASE_DIALECT"},
+	}
 	SrcRPCUrl = &cli.StringFlag{
 		Name:     "srcRpcUrl",
 		Usage:    "RPC URL for the source chain",
@@ -126,6 +135,7 @@ var CommonFlags = []cli.Flag{
 	SrcRPCUrl,
 	DestRPCUrl,
 	// optional
+	DatabaseDialect,
 	DatabaseMaxIdleConns,
 	DatabaseConnMaxLifetime,
 	DatabaseMaxOpenConns,
@@ -123,6 +123,55 @@ var (
 		Category: indexerCategory,
 		EnvVars:  []string{"CONFIRMATIONS_BEFORE_INDEXING"},
 	}
+	ConfirmationDepth = &cli.Uint64Flag{
+		Name:     "confirmationDepth",
+		Usage:    "Number of blocks the indexer stays behind the latest block, so messages are only queued once this deep",
+		Value:    0,
+		Category: indexerCategory,
+		EnvVars:  []string{"CONFIRMATION_DEPTH"},
+	}
+	WebhooksEnabled = &cli.BoolFlag{
+		Name:     "webhooks.enabled",
+		Usage:    "Notify the registered webhooks of the lifecycle transitions of the indexed messages",
+		Value:    false,
+		Category: indexerCategory,
+		EnvVars:  []string{"WEBHOOKS_ENABLED"},
+	}
+	WebhooksTimeout = &cli.DurationFlag{
+		Name:     "webhooks.timeout",
+		Usage:    "Timeout of a webhook delivery request",
+		Value:    10 * time.Second,
+		Category: indexerCategory,
+		EnvVars:  []string{"WEBHOOKS_TIMEOUT"},
+	}
+	WebhooksMaxAttempts = &cli.Uint64Flag{
+		Name:     "webhooks.maxAttempts",
+		Usage:    "Max number of attempts to deliver a webhook notification",
+		Value:    10,
+		Category: indexerCategory,
+		EnvVars:  []string{"WEBHOOKS_MAX_ATTEMPTS"},
+	}
+	WebhooksRetryBackoff = &cli.DurationFlag{
+		Name:     "webhooks.retryBackoff",
+		Usage:    "Delay before retrying a failed webhook delivery, doubled after every attempt",
+		Value:    10 * time.Second,
+		Category: indexerCategory,
+		EnvVars:  []string{"WEBHOOKS_RETRY_BACKOFF"},
+	}
+	WebhooksMaxRetryBackoff = &cli.DurationFlag{
+		Name:     "webhooks.maxRetryBackoff",
+		Usage:    "Max delay before retrying a failed webhook delivery",
+		Value:    time.Hour,
+		Category: indexerCategory,
+		EnvVars:  []string{"WEBHOOKS_MAX_RETRY_BACKOFF"},
+	}
+	WebhooksPollInterval = &cli.DurationFlag{
+		Name:     "webhooks.pollInterval",
+		Usage:    "Interval between polls of the due webhook deliveries",
+		Value:    time.Second,
+		Category: indexerCategory,
+		EnvVars:  []string{"WEBHOOKS_POLL_INTERVAL"},
+	}
 )
 
 var IndexerFlags = MergeFlags(CommonFlags, QueueFlags, []cli.Flag{
@@ -142,4 +191,11 @@ var IndexerFlags = MergeFlags(CommonFlags, QueueFlags, []cli.Flag{
 	TargetBlockNumber,
 	WaitForConfirmationTimeout,
 	IndexingConfirmations,
+	ConfirmationDepth,
+	WebhooksEnabled,
+	WebhooksTimeout,
+	WebhooksMaxAttempts,
+	WebhooksRetryBackoff,
+	WebhooksMaxRetryBackoff,
+	WebhooksPollInterval,
 })
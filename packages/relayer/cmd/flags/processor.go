@@ -1,6 +1,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/urfave/cli/v2"
 )
 
@@ -143,6 +145,47 @@ var (
 		Required: false,
 		EnvVars:  []string{"DEST_QUOTA_MANAGER_ADDRESS"},
 	}
+	Concurrency = &cli.Uint64Flag{
+		Name:     "concurrency",
+		Usage:    "Maximum number of messages to process concurrently, 0 means no limit",
+		Category: processorCategory,
+		Value:    0,
+		EnvVars:  []string{"PROCESSOR_CONCURRENCY"},
+	}
+	FeeOracleMargin = &cli.Float64Flag{
+		Name:     "feeOracle.margin",
+		Usage:    "Margin added on top of the estimated processing cost, e.g. 0.1 means 10%",
+		Category: processorCategory,
+		Value:    0,
+		EnvVars:  []string{"FEE_ORACLE_MARGIN"},
+	}
+	KeyPoolPrivateKeys = &cli.StringSliceFlag{
+		Name:     "keyPool.privateKeys",
+		Usage:    "Additional private keys to process messages with, each key has its own nonce and transaction manager",
+		Category: processorCategory,
+		EnvVars:  []string{"KEY_POOL_PRIVATE_KEYS"},
+	}
+	KeyPoolStrategy = &cli.StringFlag{
+		Name:     "keyPool.strategy",
+		Usage:    "Strategy to dispatch messages to the processor keys, either round-robin or least-pending",
+		Category: processorCategory,
+		Value:    "round-robin",
+		EnvVars:  []string{"KEY_POOL_STRATEGY"},
+	}
+	KeyPoolMinBalance = &cli.Float64Flag{
+		Name:     "keyPool.minBalance",
+		Usage:    "Minimum balance in ETH of a processor key, keys below it are retired until refunded, 0 disables it",
+		Category: processorCategory,
+		Value:    0,
+		EnvVars:  []string{"KEY_POOL_MIN_BALANCE"},
+	}
+	KeyPoolBalanceCheckInterval = &cli.DurationFlag{
+		Name:     "keyPool.balanceCheckInterval",
+		Usage:    "Interval to check the balances of the processor keys",
+		Category: processorCategory,
+		Value:    time.Minute,
+		EnvVars:  []string{"KEY_POOL_BALANCE_CHECK_INTERVAL"},
+	}
 	MinFeeToProcess = &cli.Uint64Flag{
 		Name:     "minFeeToProcess",
 		Usage:    "Minimum fee to process",
@@ -175,4 +218,56 @@ var ProcessorFlags = MergeFlags(CommonFlags, QueueFlags, TxmgrFlags, []cli.Flag{
 	MaxMessageRetries,
 	MinFeeToProcess,
 	DestQuotaManagerAddress,
+	Concurrency,
+	FeeOracleMargin,
+	KeyPoolPrivateKeys,
+	KeyPoolStrategy,
+	KeyPoolMinBalance,
+	KeyPoolBalanceCheckInterval,
+	WebhooksEnabled,
+})
+
+// multi-route processor
+var (
+	RoutesFile = &cli.StringFlag{
+		Name:     "routes",
+		Usage:    "Path to a JSON file with the route table to process, each route has its own queue",
+		Required: true,
+		Category: processorCategory,
+		EnvVars:  []string{"ROUTES_FILE"},
+	}
+)
+
+var MultiProcessorFlags = MergeFlags(QueueFlags, TxmgrFlags, []cli.Flag{
+	DatabaseUsername,
+	DatabasePassword,
+	DatabaseHost,
+	DatabaseName,
+	ProcessorPrivateKey,
+	RoutesFile,
+	// optional
+	DatabaseDialect,
+	DatabaseMaxIdleConns,
+	DatabaseConnMaxLifetime,
+	DatabaseMaxOpenConns,
+	MetricsHTTPPort,
+	ETHClientTimeout,
+	BackOffMaxRetrys,
+	BackOffRetryInterval,
+	HeaderSyncInterval,
+	Confirmations,
+	ConfirmationTimeout,
+	ProfitableOnly,
+	QueuePrefetchCount,
+	CacheOption,
+	UnprofitableMessageQueueExpiration,
+	MaxMessageRetries,
+	MinFeeToProcess,
+	Concurrency,
+	FeeOracleMargin,
+	KeyPoolPrivateKeys,
+	KeyPoolStrategy,
+	KeyPoolMinBalance,
+	KeyPoolBalanceCheckInterval,
+	WebhooksEnabled,
 })
@@ -1,41 +1,65 @@
 package flags
 
-import "github.com/urfave/cli/v2"
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
 
 var (
+	QueueType = &cli.StringFlag{
+		Name:     "queue.type",
+		Usage:    "Queue backend, one of: rabbitmq, db",
+		Value:    "rabbitmq",
+		Category: commonCategory,
+		EnvVars:  []string{"QUEUE_TYPE"},
+	}
 	QueueUsername = &cli.StringFlag{
 		Name:     "queue.username",
-		Usage:    "Queue connection username",
-		Required: true,
+		Usage:    "Queue connection username, required by the rabbitmq queue",
 		Category: commonCategory,
 		EnvVars:  []string{"QUEUE_USER"},
 	}
 	QueuePassword = &cli.StringFlag{
 		Name:     "queue.password",
-		Usage:    "Queue connection password",
-		Required: true,
+		Usage:    "Queue connection password, required by the rabbitmq queue",
 		Category: commonCategory,
 		EnvVars:  []string{"QUEUE_PASSWORD"},
 	}
 	QueueHost = &cli.StringFlag{
 		Name:     "queue.host",
-		Usage:    "Queue connection host",
-		Required: true,
+		Usage:    "Queue connection host, required by the rabbitmq queue",
 		Category: commonCategory,
 		EnvVars:  []string{"QUEUE_HOST"},
 	}
 	QueuePort = &cli.Uint64Flag{
 		Name:     "queue.port",
-		Usage:    "Queue connection port",
-		Required: true,
+		Usage:    "Queue connection port, required by the rabbitmq queue",
 		Category: commonCategory,
 		EnvVars:  []string{"QUEUE_PORT"},
 	}
+	QueueVisibilityTimeout = &cli.Uint64Flag{
+		Name:     "queue.visibilityTimeout",
+		Usage:    "Seconds a message delivered by the db queue stays invisible before it is delivered again",
+		Value:    300,
+		Category: commonCategory,
+		EnvVars:  []string{"QUEUE_VISIBILITY_TIMEOUT"},
+	}
+	QueuePollInterval = &cli.DurationFlag{
+		Name:     "queue.pollInterval",
+		Usage:    "How often the db queue is polled for new messages",
+		Value:    time.Second,
+		Category: commonCategory,
+		EnvVars:  []string{"QUEUE_POLL_INTERVAL"},
+	}
 )
 
 var QueueFlags = []cli.Flag{
+	QueueType,
 	QueueUsername,
 	QueuePassword,
 	QueueHost,
 	QueuePort,
+	QueueVisibilityTimeout,
+	QueuePollInterval,
 }
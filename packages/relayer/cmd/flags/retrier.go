@@ -0,0 +1,40 @@
+package flags
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	RetrierPrivateKey = &cli.StringFlag{
+		Name:     "retrierPrivateKey",
+		Usage:    "Private key to retry messages on the destination chain",
+		Required: true,
+		Category: retrierCategory,
+		EnvVars:  []string{"RETRIER_PRIVATE_KEY"},
+	}
+	DestSignalServiceAddress = &cli.StringFlag{
+		Name:     "destSignalServiceAddress",
+		Usage:    "SignalService address for the destination chain, which signals the failed messages",
+		Required: true,
+		Category: retrierCategory,
+		EnvVars:  []string{"DEST_SIGNAL_SERVICE_ADDRESS"},
+	}
+	RetrierInterval = &cli.DurationFlag{
+		Name:     "retrier.interval",
+		Usage:    "Interval to re-evaluate the retriable and failed messages",
+		Category: retrierCategory,
+		Value:    time.Minute,
+		EnvVars:  []string{"RETRIER_INTERVAL"},
+	}
+)
+
+var RetrierFlags = MergeFlags(CommonFlags, TxmgrFlags, []cli.Flag{
+	RetrierPrivateKey,
+	DestBridgeAddress,
+	DestSignalServiceAddress,
+	// optional
+	DestQuotaManagerAddress,
+	RetrierInterval,
+})
@@ -1,6 +1,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/urfave/cli/v2"
 )
 
@@ -12,6 +14,65 @@ var (
 		Category: watchdogCategory,
 		EnvVars:  []string{"WATCHDOG_PRIVATE_KEY"},
 	}
+	WatchdogInvariantsFile = &cli.StringFlag{
+		Name:     "watchdog.invariants",
+		Usage:    "Path to a JSON file with the invariants to check, defaults to pausing on processed messages not sent",
+		Category: watchdogCategory,
+		EnvVars:  []string{"WATCHDOG_INVARIANTS"},
+	}
+	WatchdogInvariantInterval = &cli.DurationFlag{
+		Name:     "watchdog.invariantInterval",
+		Usage:    "Interval between the checks of the periodic invariants",
+		Value:    1 * time.Minute,
+		Category: watchdogCategory,
+		EnvVars:  []string{"WATCHDOG_INVARIANT_INTERVAL"},
+	}
+	WatchdogProposalURL = &cli.StringFlag{
+		Name:     "watchdog.proposalUrl",
+		Usage:    "URL to POST an admin proposal to, when an invariant with the propose action is violated",
+		Category: watchdogCategory,
+		EnvVars:  []string{"WATCHDOG_PROPOSAL_URL"},
+	}
+	WatchdogIncidentWebhookURL = &cli.StringFlag{
+		Name:     "watchdog.incidentWebhookUrl",
+		Usage:    "URL to POST the signed incident reports to, when an invariant with the approve action is violated",
+		Category: watchdogCategory,
+		EnvVars:  []string{"WATCHDOG_INCIDENT_WEBHOOK_URL"},
+	}
+	WatchdogApprovers = &cli.StringSliceFlag{
+		Name:     "watchdog.approvers",
+		Usage:    "Addresses whose signatures approve or reject an incident",
+		Category: watchdogCategory,
+		EnvVars:  []string{"WATCHDOG_APPROVERS"},
+	}
+	WatchdogApprovalQuorum = &cli.Uint64Flag{
+		Name:     "watchdog.approvalQuorum",
+		Usage:    "Number of approver signatures needed to approve or reject an incident",
+		Value:    1,
+		Category: watchdogCategory,
+		EnvVars:  []string{"WATCHDOG_APPROVAL_QUORUM"},
+	}
+	WatchdogApprovalTimeout = &cli.DurationFlag{
+		Name:     "watchdog.approvalTimeout",
+		Usage:    "Time to wait for the approvers before taking the timeout action on an incident",
+		Value:    30 * time.Minute,
+		Category: watchdogCategory,
+		EnvVars:  []string{"WATCHDOG_APPROVAL_TIMEOUT"},
+	}
+	WatchdogApprovalTimeoutAction = &cli.StringFlag{
+		Name:     "watchdog.approvalTimeoutAction",
+		Usage:    "Action taken on an incident nobody approved or rejected in time, pause or alert",
+		Value:    "pause",
+		Category: watchdogCategory,
+		EnvVars:  []string{"WATCHDOG_APPROVAL_TIMEOUT_ACTION"},
+	}
+	WatchdogApprovalHTTPPort = &cli.Uint64Flag{
+		Name:     "watchdog.approvalPort",
+		Usage:    "Port to run the http server receiving the approver signatures on",
+		Value:    6062,
+		Category: watchdogCategory,
+		EnvVars:  []string{"WATCHDOG_APPROVAL_HTTP_PORT"},
+	}
 )
 
 var WatchdogFlags = MergeFlags(CommonFlags, QueueFlags, TxmgrFlags, []cli.Flag{
@@ -22,4 +83,13 @@ var WatchdogFlags = MergeFlags(CommonFlags, QueueFlags, TxmgrFlags, []cli.Flag{
 	QueuePrefetchCount,
 	DestBridgeAddress,
 	SrcBridgeAddress,
+	WatchdogInvariantsFile,
+	WatchdogInvariantInterval,
+	WatchdogProposalURL,
+	WatchdogIncidentWebhookURL,
+	WatchdogApprovers,
+	WatchdogApprovalQuorum,
+	WatchdogApprovalTimeout,
+	WatchdogApprovalTimeoutAction,
+	WatchdogApprovalHTTPPort,
 })
@@ -0,0 +1,68 @@
+package flags
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	WebhookURL = &cli.StringFlag{
+		Name:     "url",
+		Usage:    "URL the notifications are POSTed to",
+		Required: true,
+		Category: webhooksCategory,
+	}
+	WebhookSecret = &cli.StringFlag{
+		Name:     "secret",
+		Usage:    "Secret keying the HMAC-SHA256 signature of the deliveries",
+		Required: true,
+		Category: webhooksCategory,
+		EnvVars:  []string{"WEBHOOK_SECRET"},
+	}
+	WebhookAddress = &cli.StringFlag{
+		Name:     "address",
+		Usage:    "Only notify of the messages with this source or destination owner",
+		Category: webhooksCategory,
+	}
+	WebhookToken = &cli.StringFlag{
+		Name:     "token",
+		Usage:    "Only notify of the messages with this canonical token address",
+		Category: webhooksCategory,
+	}
+	WebhookEvents = &cli.StringFlag{
+		Name:     "events",
+		Usage:    "Comma separated list of the events to notify of, all events if empty",
+		Category: webhooksCategory,
+	}
+	WebhookID = &cli.IntFlag{
+		Name:     "id",
+		Usage:    "ID of the webhook",
+		Required: true,
+		Category: webhooksCategory,
+	}
+)
+
+// DatabaseFlags are the flags of the database connection.
+var DatabaseFlags = []cli.Flag{
+	// required
+	DatabaseUsername,
+	DatabasePassword,
+	DatabaseHost,
+	DatabaseName,
+	// optional
+	DatabaseDialect,
+	DatabaseMaxIdleConns,
+	DatabaseConnMaxLifetime,
+	DatabaseMaxOpenConns,
+}
+
+var WebhooksAddFlags = MergeFlags(DatabaseFlags, []cli.Flag{
+	WebhookURL,
+	WebhookSecret,
+	WebhookAddress,
+	WebhookToken,
+	WebhookEvents,
+})
+
+var WebhooksUpdateFlags = MergeFlags(DatabaseFlags, []cli.Flag{
+	WebhookID,
+})
@@ -12,6 +12,7 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/utils"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/indexer"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/processor"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/retrier"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/watchdog"
 	"github.com/urfave/cli/v2"
 )
@@ -60,6 +61,13 @@ func main() {
 			Description: "Taiko relayer processor software",
 			Action:      utils.SubcommandAction(new(processor.Processor)),
 		},
+		{
+			Name:        "multiprocessor",
+			Flags:       flags.MultiProcessorFlags,
+			Usage:       "Starts the processor software for multiple routes",
+			Description: "Taiko relayer processor software, which processes all routes in a route table",
+			Action:      utils.SubcommandAction(new(processor.MultiProcessor)),
+		},
 		{
 			Name:        "watchdog",
 			Flags:       flags.WatchdogFlags,
@@ -74,6 +82,14 @@ func main() {
 			Description: "Taiko relayer bridge software",
 			Action:      utils.SubcommandAction(new(bridge.Bridge)),
 		},
+		{
+			Name:        "retrier",
+			Flags:       flags.RetrierFlags,
+			Usage:       "Starts the retrier software",
+			Description: "Taiko relayer retrier software, which retries retriable messages and builds recall proofs",
+			Action:      utils.SubcommandAction(new(retrier.Retrier)),
+		},
+		webhooksCommand,
 	}
 
 	if err := app.Run(os.Args); err != nil {
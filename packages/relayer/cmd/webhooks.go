@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/repo"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/webhook"
+	"github.com/urfave/cli/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// webhooksCommand registers and lists the webhooks notified by the indexers and processors.
+var webhooksCommand = &cli.Command{
+	Name:        "webhooks",
+	Usage:       "Manages the registered webhooks",
+	Description: "Taiko relayer webhooks registration, notified of the lifecycle transitions of bridge messages",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "add",
+			Flags:  flags.WebhooksAddFlags,
+			Usage:  "Registers a webhook",
+			Action: addWebhook,
+		},
+		{
+			Name:   "list",
+			Flags:  flags.DatabaseFlags,
+			Usage:  "Lists the registered webhooks",
+			Action: listWebhooks,
+		},
+		{
+			Name:   "enable",
+			Flags:  flags.WebhooksUpdateFlags,
+			Usage:  "Enables a webhook",
+			Action: updateWebhookEnabled(true),
+		},
+		{
+			Name:   "disable",
+			Flags:  flags.WebhooksUpdateFlags,
+			Usage:  "Disables a webhook, its pending deliveries are marked failed",
+			Action: updateWebhookEnabled(false),
+		},
+	},
+}
+
+func addWebhook(c *cli.Context) error {
+	opts, err := webhook.NewSaveWebhookOpts(
+		c.String(flags.WebhookURL.Name),
+		c.String(flags.WebhookSecret.Name),
+		c.String(flags.WebhookAddress.Name),
+		c.String(flags.WebhookToken.Name),
+		c.String(flags.WebhookEvents.Name),
+	)
+	if err != nil {
+		return err
+	}
+
+	webhookRepo, err := openWebhookRepository(c)
+	if err != nil {
+		return err
+	}
+
+	w, err := webhookRepo.Save(c.Context, opts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.App.Writer, "registered webhook %v\n", w.ID)
+
+	return nil
+}
+
+func listWebhooks(c *cli.Context) error {
+	webhookRepo, err := openWebhookRepository(c)
+	if err != nil {
+		return err
+	}
+
+	webhooks, err := webhookRepo.FindAll(c.Context)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(c.App.Writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tURL\tADDRESS\tTOKEN\tEVENTS\tENABLED")
+
+	for _, webhook := range webhooks {
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\t%v\n",
+			webhook.ID, webhook.URL, webhook.Address, webhook.Token, webhook.Events, webhook.Enabled)
+	}
+
+	return w.Flush()
+}
+
+func updateWebhookEnabled(enabled bool) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		webhookRepo, err := openWebhookRepository(c)
+		if err != nil {
+			return err
+		}
+
+		id := c.Int(flags.WebhookID.Name)
+
+		if err := webhookRepo.UpdateEnabled(c.Context, id, enabled); err != nil {
+			return fmt.Errorf("webhook %v: %w", id, err)
+		}
+
+		return nil
+	}
+}
+
+func openWebhookRepository(c *cli.Context) (relayer.WebhookRepository, error) {
+	dbConn, err := db.OpenDBConnection(db.DBConnectionOpts{
+		Name:            c.String(flags.DatabaseUsername.Name),
+		Password:        c.String(flags.DatabasePassword.Name),
+		Database:        c.String(flags.DatabaseName.Name),
+		Host:            c.String(flags.DatabaseHost.Name),
+		Dialect:         c.String(flags.DatabaseDialect.Name),
+		MaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
+		MaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
+		MaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
+		OpenFunc: func(dialector gorm.Dialector) (db.DB, error) {
+			gormDB, err := gorm.Open(dialector, &gorm.Config{
+				Logger: logger.Default.LogMode(logger.Silent),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return db.New(gormDB), nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.NewWebhookRepository(dbConn)
+}
@@ -73,6 +73,7 @@ type Event struct {
 	MsgHash                 string         `json:"msgHash"`
 	MessageOwner            string         `json:"messageOwner"`
 	Event                   string         `json:"event"`
+	TxHash                  string         `json:"txHash"`
 	ClaimedBy               string         `json:"claimedBy" gorm:"-"`
 	ProcessedTxHash         string         `json:"processedTxHash" gorm:"-"`
 	Fee                     *uint64        `json:"fee"`
@@ -106,6 +107,7 @@ type SaveEventOpts struct {
 	SyncData               string
 	Kind                   string
 	SyncedInBlockID        uint64
+	TxHash                 string
 }
 
 type UpdateFeesAndProfitabilityOpts struct {
@@ -146,6 +148,21 @@ type EventRepository interface {
 		event string,
 		msgHash string,
 	) (*Event, error)
+	FindAllByMsgHash(
+		ctx context.Context,
+		msgHash string,
+	) ([]*Event, error)
+	FindAllByEventAndTxHash(
+		ctx context.Context,
+		event string,
+		txHash string,
+	) ([]*Event, error)
+	FindAllMessageSentByLatestStatus(
+		ctx context.Context,
+		srcChainID uint64,
+		destChainID uint64,
+		status EventStatus,
+	) ([]*Event, error)
 	Delete(ctx context.Context, id int) error
 	ChainDataSyncedEventByBlockNumberOrGreater(
 		ctx context.Context,
@@ -158,7 +175,13 @@ type EventRepository interface {
 		srcChainId uint64,
 		syncedChainId uint64,
 	) (uint64, error)
-	DeleteAllAfterBlockID(blockID uint64, srcChainID uint64, destChainID uint64) error
+	DeleteAllAfterBlockID(
+		ctx context.Context,
+		blockID uint64,
+		srcChainID uint64,
+		destChainID uint64,
+		events []string,
+	) error
 	FindLatestBlockID(
 		ctx context.Context,
 		event string,
@@ -0,0 +1,52 @@
+package relayer
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// Incident is an incident opened by the watchdog of a route, awaiting the decision of its
+// approvers. It is kept until its Outcome is decided, so a restarted watchdog resumes it.
+type Incident struct {
+	ID          int    `json:"id"`
+	IncidentID  string `json:"incidentID"`
+	SrcChainID  uint64 `json:"srcChainID"`
+	DestChainID uint64 `json:"destChainID"`
+	Invariant   string `json:"invariant"`
+	// ViolationKey identifies the violation the incident was opened for.
+	ViolationKey string `json:"violationKey"`
+	// Report is the JSON encoded signed incident report.
+	Report datatypes.JSON `json:"report"`
+	// Approvals and Rejections are the JSON encoded addresses of the approvers who signed them.
+	Approvals  datatypes.JSON `json:"approvals"`
+	Rejections datatypes.JSON `json:"rejections"`
+	// Outcome is empty while the incident is open.
+	Outcome   string    `json:"outcome"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type SaveIncidentOpts struct {
+	IncidentID   string
+	SrcChainID   uint64
+	DestChainID  uint64
+	Invariant    string
+	ViolationKey string
+	Report       string
+}
+
+type UpdateIncidentSignaturesOpts struct {
+	Approvals  []string
+	Rejections []string
+}
+
+// IncidentRepository is used to interact with the watchdog incidents in the store
+type IncidentRepository interface {
+	Save(ctx context.Context, opts *SaveIncidentOpts) error
+	// FindAllOpen returns the incidents of a route which have no outcome yet, oldest first.
+	FindAllOpen(ctx context.Context, srcChainID uint64, destChainID uint64) ([]*Incident, error)
+	UpdateSignatures(ctx context.Context, incidentID string, opts *UpdateIncidentSignaturesOpts) error
+	UpdateOutcome(ctx context.Context, incidentID string, outcome string) error
+}
@@ -0,0 +1,37 @@
+package relayer
+
+import (
+	"context"
+	"time"
+)
+
+// IndexedBlock is the last block of a block range filtered by an indexer. Its hash is
+// compared against the canonical chain on the next filter pass to detect reorgs.
+type IndexedBlock struct {
+	ID          int       `json:"id"`
+	ChainID     uint64    `json:"chainID"`
+	DestChainID uint64    `json:"destChainID"`
+	EventName   string    `json:"eventName"`
+	BlockID     uint64    `json:"blockID"`
+	BlockHash   string    `json:"blockHash"`
+	ParentHash  string    `json:"parentHash"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type SaveIndexedBlockOpts struct {
+	ChainID     uint64
+	DestChainID uint64
+	EventName   string
+	BlockID     uint64
+	BlockHash   string
+	ParentHash  string
+}
+
+// IndexedBlockRepository is used to interact with the indexed blocks in the store
+type IndexedBlockRepository interface {
+	Save(ctx context.Context, opts *SaveIndexedBlockOpts) error
+	// FindAll returns the indexed blocks of an indexer, latest first.
+	FindAll(ctx context.Context, chainID uint64, destChainID uint64, eventName string) ([]*IndexedBlock, error)
+	DeleteAfterBlockID(ctx context.Context, chainID uint64, destChainID uint64, eventName string, blockID uint64) error
+	DeleteBeforeBlockID(ctx context.Context, chainID uint64, destChainID uint64, eventName string, blockID uint64) error
+}
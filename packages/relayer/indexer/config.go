@@ -6,10 +6,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	pkgFlags "github.com/taikoxyz/taiko-mono/packages/relayer/pkg/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
-	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/rabbitmq"
 	"github.com/urfave/cli/v2"
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
@@ -27,6 +26,7 @@ type Config struct {
 	DatabasePassword        string
 	DatabaseName            string
 	DatabaseHost            string
+	DatabaseDialect         string
 	DatabaseMaxIdleConns    uint64
 	DatabaseMaxOpenConns    uint64
 	DatabaseMaxConnLifetime uint64
@@ -55,11 +55,19 @@ type Config struct {
 	OpenDBFunc                       func() (db.DB, error)
 	ConfirmationTimeout              time.Duration
 	Confirmations                    uint64
+	ConfirmationDepth                uint64
+	// webhook configs
+	WebhooksEnabled         bool
+	WebhooksTimeout         time.Duration
+	WebhooksMaxAttempts     uint64
+	WebhooksRetryBackoff    time.Duration
+	WebhooksMaxRetryBackoff time.Duration
+	WebhooksPollInterval    time.Duration
 }
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
 func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
-	return &Config{
+	cfg := &Config{
 		SrcBridgeAddress:                 common.HexToAddress(c.String(flags.SrcBridgeAddress.Name)),
 		SrcTaikoAddress:                  common.HexToAddress(c.String(flags.SrcTaikoAddress.Name)),
 		SrcSignalServiceAddress:          common.HexToAddress(c.String(flags.SrcSignalServiceAddress.Name)),
@@ -68,6 +76,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		DatabasePassword:                 c.String(flags.DatabasePassword.Name),
 		DatabaseName:                     c.String(flags.DatabaseName.Name),
 		DatabaseHost:                     c.String(flags.DatabaseHost.Name),
+		DatabaseDialect:                  c.String(flags.DatabaseDialect.Name),
 		DatabaseMaxIdleConns:             c.Uint64(flags.DatabaseMaxIdleConns.Name),
 		DatabaseMaxOpenConns:             c.Uint64(flags.DatabaseMaxOpenConns.Name),
 		DatabaseMaxConnLifetime:          c.Uint64(flags.DatabaseConnMaxLifetime.Name),
@@ -91,6 +100,13 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		MinFeeToIndex:                    c.Uint64(flags.MinFeeToIndex.Name),
 		ConfirmationTimeout:              c.Duration(flags.WaitForConfirmationTimeout.Name),
 		Confirmations:                    c.Uint64(flags.IndexingConfirmations.Name),
+		ConfirmationDepth:                c.Uint64(flags.ConfirmationDepth.Name),
+		WebhooksEnabled:                  c.Bool(flags.WebhooksEnabled.Name),
+		WebhooksTimeout:                  c.Duration(flags.WebhooksTimeout.Name),
+		WebhooksMaxAttempts:              c.Uint64(flags.WebhooksMaxAttempts.Name),
+		WebhooksRetryBackoff:             c.Duration(flags.WebhooksRetryBackoff.Name),
+		WebhooksMaxRetryBackoff:          c.Duration(flags.WebhooksMaxRetryBackoff.Name),
+		WebhooksPollInterval:             c.Duration(flags.WebhooksPollInterval.Name),
 		TargetBlockNumber: func() *uint64 {
 			if c.IsSet(flags.TargetBlockNumber.Name) {
 				value := c.Uint64(flags.TargetBlockNumber.Name)
@@ -104,11 +120,12 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 				Password:        c.String(flags.DatabasePassword.Name),
 				Database:        c.String(flags.DatabaseName.Name),
 				Host:            c.String(flags.DatabaseHost.Name),
+				Dialect:         c.String(flags.DatabaseDialect.Name),
 				MaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
 				MaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
 				MaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
-				OpenFunc: func(dsn string) (db.DB, error) {
-					gormDB, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+				OpenFunc: func(dialector gorm.Dialector) (db.DB, error) {
+					gormDB, err := gorm.Open(dialector, &gorm.Config{
 						Logger: logger.Default.LogMode(logger.Silent),
 					})
 					if err != nil {
@@ -119,20 +136,11 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 				},
 			})
 		},
-		OpenQueueFunc: func() (queue.Queue, error) {
-			opts := queue.NewQueueOpts{
-				Username: c.String(flags.QueueUsername.Name),
-				Password: c.String(flags.QueuePassword.Name),
-				Host:     c.String(flags.QueueHost.Name),
-				Port:     c.String(flags.QueuePort.Name),
-			}
+	}
 
-			q, err := rabbitmq.NewQueue(opts)
-			if err != nil {
-				return nil, err
-			}
+	cfg.OpenQueueFunc = func() (queue.Queue, error) {
+		return pkgFlags.OpenQueueFromCli(c, cfg.OpenDBFunc)
+	}
 
-			return q, nil
-		},
-	}, nil
+	return cfg, nil
 }
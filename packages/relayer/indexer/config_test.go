@@ -2,6 +2,7 @@ package indexer
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
@@ -70,6 +71,10 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		assert.Equal(t, SyncMode(syncMode), c.SyncMode)
 		assert.Equal(t, WatchMode(watchMode), c.WatchMode)
 		assert.Equal(t, eventName, c.EventName)
+		assert.Equal(t, uint64(12), c.ConfirmationDepth)
+		assert.Equal(t, true, c.WebhooksEnabled)
+		assert.Equal(t, uint64(3), c.WebhooksMaxAttempts)
+		assert.Equal(t, 10*time.Second, c.WebhooksTimeout)
 
 		c.OpenDBFunc = func() (db.DB, error) {
 			return &mock.DB{}, nil
@@ -109,5 +114,8 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		"--" + flags.SyncMode.Name, syncMode,
 		"--" + flags.WatchMode.Name, watchMode,
 		"--" + flags.EventName.Name, eventName,
+		"--" + flags.ConfirmationDepth.Name, "12",
+		"--" + flags.WebhooksEnabled.Name,
+		"--" + flags.WebhooksMaxAttempts.Name, "3",
 	}))
 }
@@ -0,0 +1,197 @@
+package indexer
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+// indexedBlocksHistory is how many blocks behind the last indexed block the indexed block
+// hashes are kept for, which is the deepest reorg the indexer can roll back.
+var indexedBlocksHistory uint64 = 1024
+
+var (
+	errReorgDetected = errors.New("reorg detected")
+	errReorgTooDeep  = errors.New("reorg deeper than the indexed block history, resync required")
+)
+
+// indexedEventNames returns the names of the events this indexer saves to the database.
+func (i *Indexer) indexedEventNames() []string {
+	// the MessageSent indexer also indexes the related status changes and chain data syncs.
+	if i.eventName == relayer.EventNameMessageSent {
+		return []string{
+			relayer.EventNameMessageSent,
+			relayer.EventNameMessageStatusChanged,
+			relayer.EventNameChainDataSynced,
+		}
+	}
+
+	return []string{i.eventName}
+}
+
+// detectReorg checks the hashes of the indexed blocks against the canonical chain, latest
+// first. If the last indexed block is no longer canonical, it rolls the indexer back to the
+// latest indexed block which still is.
+func (i *Indexer) detectReorg(ctx context.Context) error {
+	blocks, err := i.indexedBlockRepo.FindAll(ctx, i.srcChainId.Uint64(), i.destChainId.Uint64(), i.eventName)
+	if err != nil {
+		return errors.Wrap(err, "i.indexedBlockRepo.FindAll")
+	}
+
+	for n, block := range blocks {
+		header, err := i.srcEthClient.HeaderByNumber(ctx, new(big.Int).SetUint64(block.BlockID))
+		if err != nil {
+			return errors.Wrap(err, "i.srcEthClient.HeaderByNumber")
+		}
+
+		if header.Hash() != common.HexToHash(block.BlockHash) {
+			continue
+		}
+
+		if n > 0 {
+			return i.rollback(ctx, block, blocks[n-1])
+		}
+
+		i.lastIndexedBlock = block
+
+		return nil
+	}
+
+	if len(blocks) > 0 {
+		relayer.ReorgsTooDeep.Inc()
+
+		slog.Error("no indexed block is canonical anymore",
+			"oldestIndexedBlock", blocks[len(blocks)-1].BlockID,
+			"latestIndexedBlock", blocks[0].BlockID,
+		)
+
+		return errReorgTooDeep
+	}
+
+	i.lastIndexedBlock = nil
+
+	return nil
+}
+
+// rollback deletes the events indexed after the given common ancestor of the canonical chain
+// and the reorged blocks, and makes the indexer filter them again. The messages sent in the
+// reorged blocks are queued again if they were included in the canonical chain, and the
+// processor drops the messages queued from the reorged blocks.
+func (i *Indexer) rollback(ctx context.Context, ancestor *relayer.IndexedBlock, orphaned *relayer.IndexedBlock) error {
+	relayer.ReorgsDetected.Inc()
+
+	slog.Warn("reorg detected, rolling back",
+		"ancestorBlock", ancestor.BlockID,
+		"ancestorHash", ancestor.BlockHash,
+		"orphanedBlock", orphaned.BlockID,
+		"orphanedHash", orphaned.BlockHash,
+		"latestIndexedBlockNumber", i.latestIndexedBlockNumber,
+	)
+
+	if err := i.eventRepo.DeleteAllAfterBlockID(
+		ctx,
+		ancestor.BlockID,
+		i.srcChainId.Uint64(),
+		i.destChainId.Uint64(),
+		i.indexedEventNames(),
+	); err != nil {
+		return errors.Wrap(err, "i.eventRepo.DeleteAllAfterBlockID")
+	}
+
+	if err := i.indexedBlockRepo.DeleteAfterBlockID(
+		ctx,
+		i.srcChainId.Uint64(),
+		i.destChainId.Uint64(),
+		i.eventName,
+		ancestor.BlockID,
+	); err != nil {
+		return errors.Wrap(err, "i.indexedBlockRepo.DeleteAfterBlockID")
+	}
+
+	if i.latestIndexedBlockNumber > ancestor.BlockID {
+		i.latestIndexedBlockNumber = ancestor.BlockID
+	}
+
+	i.lastIndexedBlock = ancestor
+
+	return nil
+}
+
+// batchEndHeader checks the first block of a batch builds on the last indexed block, and
+// returns the header of the last block of the batch. The header is fetched before the events
+// of the batch are filtered, so a reorg while filtering is detected by the next filter pass.
+func (i *Indexer) batchEndHeader(ctx context.Context, start uint64, end uint64) (*types.Header, error) {
+	if last := i.lastIndexedBlock; last != nil && last.BlockID+1 == start {
+		header, err := i.srcEthClient.HeaderByNumber(ctx, new(big.Int).SetUint64(start))
+		if err != nil {
+			return nil, errors.Wrap(err, "i.srcEthClient.HeaderByNumber")
+		}
+
+		if header.ParentHash != common.HexToHash(last.BlockHash) {
+			slog.Warn("block does not build on the last indexed block",
+				"blockNumber", start,
+				"parentHash", header.ParentHash.Hex(),
+				"lastIndexedBlockHash", last.BlockHash,
+			)
+
+			return nil, errReorgDetected
+		}
+
+		if start == end {
+			return header, nil
+		}
+	}
+
+	header, err := i.srcEthClient.HeaderByNumber(ctx, new(big.Int).SetUint64(end))
+	if err != nil {
+		return nil, errors.Wrap(err, "i.srcEthClient.HeaderByNumber")
+	}
+
+	return header, nil
+}
+
+// saveIndexedBlock saves the hash of the last block of an indexed batch, and prunes the
+// hashes older than indexedBlocksHistory.
+func (i *Indexer) saveIndexedBlock(ctx context.Context, header *types.Header) error {
+	opts := &relayer.SaveIndexedBlockOpts{
+		ChainID:     i.srcChainId.Uint64(),
+		DestChainID: i.destChainId.Uint64(),
+		EventName:   i.eventName,
+		BlockID:     header.Number.Uint64(),
+		BlockHash:   header.Hash().Hex(),
+		ParentHash:  header.ParentHash.Hex(),
+	}
+
+	if err := i.indexedBlockRepo.Save(ctx, opts); err != nil {
+		return errors.Wrap(err, "i.indexedBlockRepo.Save")
+	}
+
+	i.lastIndexedBlock = &relayer.IndexedBlock{
+		ChainID:     opts.ChainID,
+		DestChainID: opts.DestChainID,
+		EventName:   opts.EventName,
+		BlockID:     opts.BlockID,
+		BlockHash:   opts.BlockHash,
+		ParentHash:  opts.ParentHash,
+	}
+
+	if opts.BlockID > indexedBlocksHistory {
+		if err := i.indexedBlockRepo.DeleteBeforeBlockID(
+			ctx,
+			opts.ChainID,
+			opts.DestChainID,
+			opts.EventName,
+			opts.BlockID-indexedBlocksHistory,
+		); err != nil {
+			return errors.Wrap(err, "i.indexedBlockRepo.DeleteBeforeBlockID")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package indexer
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+// chainEthClient is a mock eth client serving the headers of a chain which can be reorged.
+type chainEthClient struct {
+	mock.EthClient
+	headers []*types.Header
+}
+
+func newChainEthClient(length uint64) *chainEthClient {
+	c := &chainEthClient{}
+	c.reorg(0, length, 0)
+
+	return c
+}
+
+// reorg replaces the blocks from the given block number with the blocks of another fork.
+func (c *chainEthClient) reorg(from uint64, length uint64, fork byte) {
+	c.headers = c.headers[:from]
+
+	for n := from; n < length; n++ {
+		header := &types.Header{
+			Number: new(big.Int).SetUint64(n),
+			Extra:  []byte{fork},
+		}
+
+		if n > 0 {
+			header.ParentHash = c.headers[n-1].Hash()
+		}
+
+		c.headers = append(c.headers, header)
+	}
+}
+
+func (c *chainEthClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if number == nil {
+		return c.headers[len(c.headers)-1], nil
+	}
+
+	return c.headers[number.Uint64()], nil
+}
+
+func newTestReorgService(t *testing.T, length uint64, indexed ...uint64) (*Indexer, *chainEthClient) {
+	i, _ := newTestService(Sync, Filter)
+
+	chain := newChainEthClient(length)
+	i.srcEthClient = chain
+
+	for _, n := range indexed {
+		assert.Nil(t, i.saveIndexedBlock(context.Background(), chain.headers[n]))
+
+		i.latestIndexedBlockNumber = n
+	}
+
+	return i, chain
+}
+
+func saveTestEvent(t *testing.T, i *Indexer, event string, msgHash string, emittedBlockID uint64) {
+	_, err := i.eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+		Name:           event,
+		Event:          event,
+		ChainID:        i.srcChainId,
+		DestChainID:    i.destChainId,
+		MsgHash:        msgHash,
+		EmittedBlockID: emittedBlockID,
+	})
+	assert.Nil(t, err)
+}
+
+func Test_detectReorg_noReorg(t *testing.T) {
+	i, _ := newTestReorgService(t, 31, 10, 20, 30)
+
+	i.lastIndexedBlock = nil
+
+	assert.Nil(t, i.detectReorg(context.Background()))
+	assert.Equal(t, uint64(30), i.lastIndexedBlock.BlockID)
+	assert.Equal(t, uint64(30), i.latestIndexedBlockNumber)
+}
+
+func Test_detectReorg_noIndexedBlocks(t *testing.T) {
+	i, _ := newTestReorgService(t, 31)
+
+	assert.Nil(t, i.detectReorg(context.Background()))
+	assert.Nil(t, i.lastIndexedBlock)
+}
+
+func Test_detectReorg_rollsBack(t *testing.T) {
+	i, chain := newTestReorgService(t, 31, 10, 20, 30)
+
+	saveTestEvent(t, i, relayer.EventNameMessageSent, "0x1", 5)
+	saveTestEvent(t, i, relayer.EventNameMessageSent, "0x2", 15)
+	saveTestEvent(t, i, relayer.EventNameMessageStatusChanged, "0x2", 25)
+	// events of other indexers are not rolled back.
+	saveTestEvent(t, i, relayer.EventNameMessageProcessed, "0x3", 25)
+
+	// a same-height reorg, the chain does not get longer.
+	chain.reorg(16, 31, 1)
+
+	assert.Nil(t, i.detectReorg(context.Background()))
+	assert.Equal(t, uint64(10), i.latestIndexedBlockNumber)
+	assert.Equal(t, uint64(10), i.lastIndexedBlock.BlockID)
+
+	blocks, err := i.indexedBlockRepo.FindAll(
+		context.Background(),
+		i.srcChainId.Uint64(),
+		i.destChainId.Uint64(),
+		i.eventName,
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(blocks))
+
+	for msgHash, want := range map[string]int{"0x1": 1, "0x2": 0, "0x3": 1} {
+		events, err := i.eventRepo.FindAllByMsgHash(context.Background(), msgHash)
+		assert.Nil(t, err)
+		assert.Equal(t, want, len(events), msgHash)
+	}
+}
+
+func Test_detectReorg_tooDeep(t *testing.T) {
+	i, chain := newTestReorgService(t, 31, 10, 20, 30)
+
+	chain.reorg(5, 31, 1)
+
+	assert.Equal(t, errReorgTooDeep, i.detectReorg(context.Background()))
+	assert.Equal(t, uint64(30), i.latestIndexedBlockNumber)
+}
+
+func Test_batchEndHeader(t *testing.T) {
+	i, chain := newTestReorgService(t, 31, 10)
+
+	header, err := i.batchEndHeader(context.Background(), 11, 20)
+	assert.Nil(t, err)
+	assert.Equal(t, chain.headers[20].Hash(), header.Hash())
+
+	header, err = i.batchEndHeader(context.Background(), 11, 11)
+	assert.Nil(t, err)
+	assert.Equal(t, chain.headers[11].Hash(), header.Hash())
+
+	// the continuity is only checked for the batch following the last indexed block.
+	header, err = i.batchEndHeader(context.Background(), 21, 30)
+	assert.Nil(t, err)
+	assert.Equal(t, chain.headers[30].Hash(), header.Hash())
+
+	chain.reorg(10, 31, 1)
+
+	_, err = i.batchEndHeader(context.Background(), 11, 20)
+	assert.Equal(t, errReorgDetected, err)
+}
+
+func Test_saveIndexedBlock_prunes(t *testing.T) {
+	i, chain := newTestReorgService(t, indexedBlocksHistory+21, 10, 20)
+
+	assert.Nil(t, i.saveIndexedBlock(context.Background(), chain.headers[indexedBlocksHistory+20]))
+	assert.Equal(t, common.HexToHash(i.lastIndexedBlock.BlockHash), chain.headers[indexedBlocksHistory+20].Hash())
+
+	blocks, err := i.indexedBlockRepo.FindAll(
+		context.Background(),
+		i.srcChainId.Uint64(),
+		i.destChainId.Uint64(),
+		i.eventName,
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(blocks))
+	assert.Equal(t, uint64(20), blocks[1].BlockID)
+}
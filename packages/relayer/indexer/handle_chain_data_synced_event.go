@@ -65,6 +65,7 @@ func (i *Indexer) handleChainDataSyncedEvent(
 		SyncData:        common.BytesToHash(event.Data[:]).Hex(),
 		Kind:            common.BytesToHash(event.Kind[:]).Hex(),
 		SyncedInBlockID: event.Raw.BlockNumber,
+		TxHash:          event.Raw.TxHash.Hex(),
 	})
 	if err != nil {
 		return errors.Wrap(err, "i.eventRepo.Save")
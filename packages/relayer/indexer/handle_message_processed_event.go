@@ -81,6 +81,7 @@ func (i *Indexer) handleMessageProcessedEvent(
 		message.Data,
 		message.Value,
 		event.Raw.BlockNumber,
+		event.Raw.TxHash.Hex(),
 	)
 	if err != nil {
 		return errors.Wrap(err, "i.saveEventToDB")
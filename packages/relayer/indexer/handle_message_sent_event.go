@@ -85,11 +85,23 @@ func (i *Indexer) handleMessageSentEvent(
 		event.Message.Data,
 		event.Message.Value,
 		event.Raw.BlockNumber,
+		event.Raw.TxHash.Hex(),
 	)
 	if err != nil {
 		return errors.Wrap(err, "i.saveEventToDB")
 	}
 
+	if err := i.notifyMessageWebhooks(
+		ctx,
+		relayer.WebhookEventMessageSent,
+		common.Hash(event.MsgHash).Hex(),
+		eventStatus,
+		event.Message,
+		event.Raw,
+	); err != nil {
+		return errors.Wrap(err, "i.notifyMessageWebhooks")
+	}
+
 	// only add messages with new status to queue
 	if eventStatus != relayer.EventStatusNew {
 		return nil
@@ -43,11 +43,21 @@ func (i *Indexer) handleMessageStatusChangedEvent(
 		MsgHash:        common.Hash(event.MsgHash).Hex(),
 		Event:          relayer.EventNameMessageStatusChanged,
 		EmittedBlockID: event.Raw.BlockNumber,
+		TxHash:         event.Raw.TxHash.Hex(),
 	})
 	if err != nil {
 		return errors.Wrap(err, "i.eventRepo.Save")
 	}
 
+	if err := i.notifyStatusChangedWebhooks(
+		ctx,
+		common.Hash(event.MsgHash).Hex(),
+		relayer.EventStatus(event.Status),
+		event.Raw,
+	); err != nil {
+		return errors.Wrap(err, "i.notifyStatusChangedWebhooks")
+	}
+
 	relayer.MessageStatusChangedEventsIndexed.Inc()
 
 	return nil
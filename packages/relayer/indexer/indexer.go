@@ -28,6 +28,7 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/repo"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/utils"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/webhook"
 )
 
 var (
@@ -83,11 +84,16 @@ type ethClient interface {
 // as its source, and vice versa for the L2-L1 indexer. They will add messages to a queue
 // specifically for a processor of the same configuration.
 type Indexer struct {
-	eventRepo    relayer.EventRepository
-	srcEthClient ethClient
+	eventRepo        relayer.EventRepository
+	indexedBlockRepo relayer.IndexedBlockRepository
+	srcEthClient     ethClient
 
 	latestIndexedBlockNumber uint64
 
+	// lastIndexedBlock is the last block of the last indexed batch, the next batch
+	// must build on it.
+	lastIndexedBlock *relayer.IndexedBlock
+
 	bridge     relayer.Bridge
 	destBridge relayer.Bridge
 
@@ -127,6 +133,11 @@ type Indexer struct {
 	cfg *Config
 
 	confirmations uint64
+
+	confirmationDepth uint64
+
+	// webhookNotifier is nil when webhooks are disabled.
+	webhookNotifier *webhook.Notifier
 }
 
 // InitFromCli inits a new Indexer from command line or environment variables.
@@ -151,6 +162,11 @@ func InitFromConfig(ctx context.Context, i *Indexer, cfg *Config) (err error) {
 		return err
 	}
 
+	indexedBlockRepository, err := repo.NewIndexedBlockRepository(db)
+	if err != nil {
+		return err
+	}
+
 	srcEthClient, err := ethclient.Dial(cfg.SrcRPCUrl)
 	if err != nil {
 		return err
@@ -224,6 +240,7 @@ func InitFromConfig(ctx context.Context, i *Indexer, cfg *Config) (err error) {
 	}
 
 	i.eventRepo = eventRepository
+	i.indexedBlockRepo = indexedBlockRepository
 	i.srcEthClient = srcEthClient
 
 	i.bridge = srcBridge
@@ -258,10 +275,33 @@ func InitFromConfig(ctx context.Context, i *Indexer, cfg *Config) (err error) {
 
 	i.confirmations = cfg.Confirmations
 
+	i.confirmationDepth = cfg.ConfirmationDepth
+
 	i.ctx = ctx
 
 	i.minFeeToIndex = i.cfg.MinFeeToIndex
 
+	if cfg.WebhooksEnabled {
+		webhookRepository, err := repo.NewWebhookRepository(db)
+		if err != nil {
+			return err
+		}
+
+		notifier, err := webhook.New(webhook.Opts{
+			Repo:            webhookRepository,
+			Timeout:         cfg.WebhooksTimeout,
+			MaxAttempts:     cfg.WebhooksMaxAttempts,
+			RetryBackoff:    cfg.WebhooksRetryBackoff,
+			MaxRetryBackoff: cfg.WebhooksMaxRetryBackoff,
+			PollInterval:    cfg.WebhooksPollInterval,
+		})
+		if err != nil {
+			return errors.Wrap(err, "webhook.New")
+		}
+
+		i.webhookNotifier = notifier
+	}
+
 	slog.Info("minFeeToIndex", "minFeeToIndex", i.minFeeToIndex)
 
 	return nil
@@ -304,6 +344,10 @@ func (i *Indexer) Start() error {
 
 	go i.eventLoop(i.ctx)
 
+	if i.webhookNotifier != nil {
+		go i.webhookNotifier.Start(i.ctx, &i.wg)
+	}
+
 	go func() {
 		if err := backoff.Retry(func() error {
 			return utils.ScanBlocks(i.ctx, i.srcEthClient, &i.wg)
@@ -382,6 +426,19 @@ func (i *Indexer) filter(ctx context.Context) error {
 				endBlockID -= i.numLatestBlocksEndWhenCrawling
 			}
 		}
+	} else {
+		// only index the blocks deep enough to be unlikely to be reorged, so their
+		// messages are only queued once they reached the confirmation depth.
+		if endBlockID <= i.confirmationDepth {
+			return nil
+		}
+
+		endBlockID -= i.confirmationDepth
+
+		// roll back the blocks indexed since the last filter pass which were reorged.
+		if err := i.detectReorg(ctx); err != nil {
+			return errors.Wrap(err, "i.detectReorg")
+		}
 	}
 
 	slog.Info("fetching batch block events",
@@ -404,6 +461,22 @@ func (i *Indexer) filter(ctx context.Context) error {
 
 		slog.Info("block batch", "start", j, "end", end)
 
+		var endHeader *types.Header
+
+		if i.watchMode != CrawlPastBlocks {
+			header, err := i.batchEndHeader(ctx, j, end)
+			if err != nil {
+				if err == errReorgDetected {
+					// the indexer is rolled back, and filters the reorged blocks again next pass.
+					return i.detectReorg(ctx)
+				}
+
+				return errors.Wrap(err, "i.batchEndHeader")
+			}
+
+			endHeader = header
+		}
+
 		filterOpts := &bind.FilterOpts{
 			Start:   j,
 			End:     &end,
@@ -441,6 +514,12 @@ func (i *Indexer) filter(ctx context.Context) error {
 			}
 		}
 
+		if endHeader != nil {
+			if err := i.saveIndexedBlock(ctx, endHeader); err != nil {
+				return errors.Wrap(err, "i.saveIndexedBlock")
+			}
+		}
+
 		i.latestIndexedBlockNumber = end
 	}
 
@@ -460,19 +539,9 @@ func (i *Indexer) indexMessageSentEvents(ctx context.Context,
 	group, _ := errgroup.WithContext(ctx)
 	group.SetLimit(i.numGoroutines)
 
-	first := true
-
 	for events.Next() {
 		event := events.Event
 
-		if i.watchMode != CrawlPastBlocks && first {
-			first = false
-
-			if err := i.checkReorg(ctx, event.Raw.BlockNumber); err != nil {
-				return err
-			}
-		}
-
 		group.Go(func() error {
 			err := i.handleMessageSentEvent(ctx, i.srcChainId, event, true)
 			if err != nil {
@@ -495,21 +564,6 @@ func (i *Indexer) indexMessageSentEvents(ctx context.Context,
 	return nil
 }
 
-func (i *Indexer) checkReorg(ctx context.Context, emittedInBlockNumber uint64) error {
-	n, err := i.eventRepo.FindLatestBlockID(ctx, i.eventName, i.srcChainId.Uint64(), i.destChainId.Uint64())
-	if err != nil {
-		return err
-	}
-
-	if n >= emittedInBlockNumber {
-		slog.Info("reorg detected", "event emitted in", emittedInBlockNumber, "latest emitted block id from db", n)
-		// reorg detected, we have seen a higher block number than this already.
-		return i.eventRepo.DeleteAllAfterBlockID(emittedInBlockNumber, i.srcChainId.Uint64(), i.destChainId.Uint64())
-	}
-
-	return nil
-}
-
 // indexMessageProcessedEvents indexes `MessageProcessed` events on the bridge contract
 // and stores them to the database, and adds the message to the queue if it has not been
 // seen before.
@@ -524,19 +578,9 @@ func (i *Indexer) indexMessageProcessedEvents(ctx context.Context,
 	group, _ := errgroup.WithContext(ctx)
 	group.SetLimit(i.numGoroutines)
 
-	first := true
-
 	for events.Next() {
 		event := events.Event
 
-		if i.watchMode != CrawlPastBlocks && first {
-			first = false
-
-			if err := i.checkReorg(ctx, event.Raw.BlockNumber); err != nil {
-				return err
-			}
-		}
-
 		group.Go(func() error {
 			err := i.handleMessageProcessedEvent(ctx, i.srcChainId, event, true)
 			if err != nil {
@@ -0,0 +1,83 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/webhook"
+)
+
+// notifyMessageWebhooks notifies the registered webhooks of a lifecycle transition of the given message.
+func (i *Indexer) notifyMessageWebhooks(
+	ctx context.Context,
+	webhookEvent relayer.WebhookEvent,
+	msgHash string,
+	status relayer.EventStatus,
+	message bridge.IBridgeMessage,
+	raw types.Log,
+) error {
+	if i.webhookNotifier == nil {
+		return nil
+	}
+
+	notification, err := webhook.NewMessageNotification(
+		webhookEvent,
+		msgHash,
+		status,
+		message,
+		i.srcChainId.Uint64(),
+		raw,
+	)
+	if err != nil {
+		return errors.Wrap(err, "webhook.NewMessageNotification")
+	}
+
+	if err := i.webhookNotifier.Notify(ctx, notification); err != nil {
+		return errors.Wrap(err, "i.webhookNotifier.Notify")
+	}
+
+	return nil
+}
+
+// notifyStatusChangedWebhooks notifies the registered webhooks of a message status change, the
+// message is read from its indexed MessageSent event, as MessageStatusChanged events only contain
+// the msgHash.
+func (i *Indexer) notifyStatusChangedWebhooks(
+	ctx context.Context,
+	msgHash string,
+	status relayer.EventStatus,
+	raw types.Log,
+) error {
+	if i.webhookNotifier == nil {
+		return nil
+	}
+
+	webhookEvent, ok := relayer.WebhookEventFromStatus(status)
+	if !ok {
+		return nil
+	}
+
+	msgSentEvent, err := i.eventRepo.FirstByEventAndMsgHash(ctx, relayer.EventNameMessageSent, msgHash)
+	if err != nil {
+		return errors.Wrap(err, "i.eventRepo.FirstByEventAndMsgHash")
+	}
+
+	// the message was not sent on a route indexed by this relayer.
+	if msgSentEvent == nil {
+		return nil
+	}
+
+	var event struct {
+		Message bridge.IBridgeMessage
+	}
+
+	if err := json.Unmarshal(msgSentEvent.Data, &event); err != nil {
+		return errors.Wrap(err, "json.Unmarshal")
+	}
+
+	return i.notifyMessageWebhooks(ctx, webhookEvent, msgHash, status, event.Message, raw)
+}
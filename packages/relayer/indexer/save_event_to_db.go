@@ -21,6 +21,7 @@ func (i *Indexer) saveEventToDB(
 	eventData []byte,
 	eventValue *big.Int,
 	emittedBlockNumber uint64,
+	txHash string,
 ) (int, error) {
 	eventType, canonicalToken, amount, err := relayer.DecodeMessageData(eventData, eventValue)
 	if err != nil {
@@ -62,6 +63,7 @@ func (i *Indexer) saveEventToDB(
 			MessageOwner:   msgOwner,
 			Event:          i.eventName,
 			EmittedBlockID: emittedBlockNumber,
+			TxHash:         txHash,
 		}
 
 		if canonicalToken != nil {
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS queue_messages (
+    id BIGINT UNSIGNED NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    message_id VARCHAR(255) NOT NULL,
+    queue_name VARCHAR(255) NOT NULL,
+    body LONGBLOB NOT NULL,
+    headers TEXT NOT NULL,
+    expires_at DATETIME(3) NULL,
+    visible_at DATETIME(3) NOT NULL,
+    delivery_count BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    created_at DATETIME(3) NOT NULL,
+    INDEX queue_messages_queue_name_visible_at_index (queue_name, visible_at)
+);
+
+CREATE TABLE IF NOT EXISTS queue_dead_letters (
+    id BIGINT UNSIGNED NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    message_id VARCHAR(255) NOT NULL,
+    queue_name VARCHAR(255) NOT NULL,
+    body LONGBLOB NOT NULL,
+    headers TEXT NOT NULL,
+    reason VARCHAR(255) NOT NULL,
+    created_at DATETIME(3) NOT NULL,
+    INDEX queue_dead_letters_queue_name_index (queue_name)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE queue_dead_letters;
+DROP TABLE queue_messages;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `events` ADD COLUMN `tx_hash` VARCHAR(255) NOT NULL DEFAULT '';
+
+UPDATE `events` SET `tx_hash` = COALESCE(JSON_UNQUOTE(JSON_EXTRACT(`data`, '$.Raw.transactionHash')), '');
+
+ALTER TABLE `events` ADD INDEX `tx_hash_event_index` (`tx_hash`, `event`);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `events` DROP INDEX `tx_hash_event_index`;
+
+ALTER TABLE `events` DROP COLUMN `tx_hash`;
+-- +goose StatementEnd
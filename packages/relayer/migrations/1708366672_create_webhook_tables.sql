@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS webhooks (
+    id int NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    address VARCHAR(42) NOT NULL DEFAULT '',
+    token VARCHAR(42) NOT NULL DEFAULT '',
+    events VARCHAR(255) NOT NULL DEFAULT '',
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id int NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    webhook_id int NOT NULL,
+    event VARCHAR(255) NOT NULL,
+    msg_hash VARCHAR(255) NOT NULL,
+    payload TEXT NOT NULL,
+    status VARCHAR(255) NOT NULL,
+    attempts BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    response_status INT NOT NULL DEFAULT 0,
+    error TEXT NOT NULL,
+    next_attempt_at DATETIME(3) NOT NULL,
+    created_at DATETIME(3) NOT NULL,
+    updated_at DATETIME(3) NOT NULL,
+    UNIQUE KEY `webhook_id_event_msg_hash_index` (`webhook_id`, `event`, `msg_hash`),
+    KEY `status_next_attempt_at_index` (`status`, `next_attempt_at`),
+    FOREIGN KEY (webhook_id) REFERENCES webhooks(id) ON DELETE CASCADE
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE webhook_deliveries;
+DROP TABLE webhooks;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS recallable_messages (
+    id int NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    msg_hash VARCHAR(255) NOT NULL,
+    src_chain_id BIGINT UNSIGNED NOT NULL,
+    dest_chain_id BIGINT UNSIGNED NOT NULL,
+    src_owner VARCHAR(42) NOT NULL,
+    message JSON NOT NULL,
+    proof TEXT NOT NULL,
+    proof_block_id BIGINT UNSIGNED NOT NULL,
+    created_at DATETIME(3) NOT NULL,
+    updated_at DATETIME(3) NOT NULL,
+    UNIQUE KEY `msg_hash_index` (`msg_hash`),
+    KEY `src_owner_src_chain_id_index` (`src_owner`, `src_chain_id`),
+    KEY `src_chain_id_dest_chain_id_index` (`src_chain_id`, `dest_chain_id`)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE recallable_messages;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS indexed_blocks (
+    id int NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    chain_id BIGINT UNSIGNED NOT NULL,
+    dest_chain_id BIGINT UNSIGNED NOT NULL,
+    event_name VARCHAR(255) NOT NULL,
+    block_id BIGINT UNSIGNED NOT NULL,
+    block_hash VARCHAR(66) NOT NULL,
+    parent_hash VARCHAR(66) NOT NULL,
+    created_at DATETIME(3) NOT NULL,
+    UNIQUE KEY `route_event_name_block_id_index` (`chain_id`, `dest_chain_id`, `event_name`, `block_id`)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE indexed_blocks;
+-- +goose StatementEnd
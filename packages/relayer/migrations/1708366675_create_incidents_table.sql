@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS incidents (
+    id int NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    incident_id VARCHAR(66) NOT NULL,
+    src_chain_id BIGINT UNSIGNED NOT NULL,
+    dest_chain_id BIGINT UNSIGNED NOT NULL,
+    invariant VARCHAR(255) NOT NULL,
+    violation_key VARCHAR(255) NOT NULL,
+    report JSON NOT NULL,
+    approvals JSON NOT NULL,
+    rejections JSON NOT NULL,
+    outcome VARCHAR(255) NOT NULL DEFAULT '',
+    created_at DATETIME(3) NOT NULL,
+    updated_at DATETIME(3) NOT NULL,
+    UNIQUE KEY `incident_id_index` (`incident_id`),
+    KEY `src_chain_id_dest_chain_id_outcome_index` (`src_chain_id`, `dest_chain_id`, `outcome`)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE incidents;
+-- +goose StatementEnd
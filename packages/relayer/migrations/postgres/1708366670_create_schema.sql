@@ -0,0 +1,111 @@
+-- +goose Up
+-- Postgres schema of the relayer, equivalent to the MySQL migrations up to 1708366670_create_queue_tables.sql.
+-- Hex strings are compared case-insensitively by the MySQL default collation, so they are CITEXT here.
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS citext;
+
+CREATE TABLE IF NOT EXISTS events (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    status INT NOT NULL DEFAULT 0,
+    event_type INT NOT NULL DEFAULT 0,
+    event VARCHAR(255) NOT NULL DEFAULT '',
+    chain_id INT NOT NULL,
+    dest_chain_id INT NOT NULL,
+    synced_chain_id INT DEFAULT NULL,
+    emitted_block_id BIGINT NOT NULL,
+    block_id BIGINT NOT NULL DEFAULT 0,
+    synced_in_block_id BIGINT NOT NULL DEFAULT 0,
+    sync_data VARCHAR(255) NOT NULL DEFAULT '',
+    kind VARCHAR(255) NOT NULL DEFAULT '',
+    data JSONB NOT NULL,
+    canonical_token_address CITEXT DEFAULT '',
+    canonical_token_symbol VARCHAR(255) DEFAULT '',
+    canonical_token_name VARCHAR(255) DEFAULT '',
+    canonical_token_decimals INT DEFAULT 0,
+    amount VARCHAR(255) NOT NULL DEFAULT '0',
+    msg_hash CITEXT NOT NULL DEFAULT '',
+    message_owner CITEXT NOT NULL DEFAULT '',
+    dest_owner_json CITEXT GENERATED ALWAYS AS ((data -> 'Message' ->> 'DestOwner')::CITEXT) STORED,
+    fee NUMERIC(20, 0) NULL,
+    dest_chain_base_fee NUMERIC(20, 0) NULL,
+    gas_tip_cap NUMERIC(20, 0) NULL,
+    gas_limit NUMERIC(20, 0) NULL,
+    is_profitable BOOLEAN NULL,
+    estimated_onchain_fee NUMERIC(20, 0) NULL,
+    is_profitable_evaluated_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX message_owner_event_type_chain_id_event_index ON events (message_owner, event_type, chain_id, event);
+CREATE INDEX message_owner_event_type_chain_id_index ON events (message_owner, event_type, chain_id);
+CREATE INDEX message_owner_event_type_index ON events (message_owner, event_type);
+CREATE INDEX message_owner_index ON events (message_owner);
+CREATE INDEX msg_hash_index ON events (msg_hash);
+CREATE INDEX message_owner_chain_id_index ON events (message_owner, chain_id);
+CREATE INDEX message_owner_event_index ON events (message_owner, event);
+CREATE INDEX msg_hash_event_index ON events (msg_hash, event);
+CREATE INDEX events_chain_id_synced_chain_id_block_id_index ON events (chain_id, synced_chain_id, block_id);
+CREATE INDEX events_name_cid_scid_bid ON events (name, chain_id, synced_chain_id, block_id);
+CREATE INDEX idx_events_chain_ids ON events (chain_id, dest_chain_id, event);
+CREATE INDEX message_owner_event_type_chain_id_event_data_index
+    ON events (message_owner, dest_owner_json, event_type, chain_id, event);
+CREATE INDEX message_owner_event_type_chain_id_data_index ON events (message_owner, dest_owner_json, event_type, chain_id);
+CREATE INDEX message_owner_event_type_data_index ON events (message_owner, dest_owner_json, event_type);
+CREATE INDEX message_owner_data_index ON events (message_owner, dest_owner_json);
+CREATE INDEX message_owner_chain_id_data_index ON events (message_owner, dest_owner_json, chain_id);
+CREATE INDEX message_owner_event_data_index ON events (message_owner, dest_owner_json, event);
+CREATE INDEX msg_hash_event_data_index ON events (msg_hash, dest_owner_json, event);
+CREATE INDEX data_event_type_chain_id_index ON events (event_type, dest_owner_json, chain_id);
+CREATE INDEX data_event_type_index ON events (dest_owner_json, event_type);
+CREATE INDEX data_index ON events (dest_owner_json);
+CREATE INDEX data_chain_id_index ON events (dest_owner_json, chain_id);
+CREATE INDEX data_event_index ON events (dest_owner_json, event);
+
+CREATE TABLE IF NOT EXISTS suspended_transactions (
+    id SERIAL PRIMARY KEY,
+    message_id INT NOT NULL,
+    src_chain_id INT NOT NULL,
+    dest_chain_id INT NOT NULL,
+    suspended BOOLEAN NOT NULL,
+    msg_hash CITEXT NOT NULL,
+    message_owner CITEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS queue_messages (
+    id BIGSERIAL PRIMARY KEY,
+    message_id VARCHAR(255) NOT NULL,
+    queue_name VARCHAR(255) NOT NULL,
+    body BYTEA NOT NULL,
+    headers TEXT NOT NULL,
+    expires_at TIMESTAMP(3) NULL,
+    visible_at TIMESTAMP(3) NOT NULL,
+    delivery_count BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP(3) NOT NULL
+);
+
+CREATE INDEX queue_messages_queue_name_visible_at_index ON queue_messages (queue_name, visible_at);
+
+CREATE TABLE IF NOT EXISTS queue_dead_letters (
+    id BIGSERIAL PRIMARY KEY,
+    message_id VARCHAR(255) NOT NULL,
+    queue_name VARCHAR(255) NOT NULL,
+    body BYTEA NOT NULL,
+    headers TEXT NOT NULL,
+    reason VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP(3) NOT NULL
+);
+
+CREATE INDEX queue_dead_letters_queue_name_index ON queue_dead_letters (queue_name);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE queue_dead_letters;
+DROP TABLE queue_messages;
+DROP TABLE suspended_transactions;
+DROP TABLE events;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE events ADD COLUMN tx_hash CITEXT NOT NULL DEFAULT '';
+
+UPDATE events SET tx_hash = COALESCE(data -> 'Raw' ->> 'transactionHash', '');
+
+CREATE INDEX tx_hash_event_index ON events (tx_hash, event);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX tx_hash_event_index;
+
+ALTER TABLE events DROP COLUMN tx_hash;
+-- +goose StatementEnd
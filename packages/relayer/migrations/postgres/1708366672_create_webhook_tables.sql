@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS webhooks (
+    id SERIAL PRIMARY KEY,
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    address CITEXT NOT NULL DEFAULT '',
+    token CITEXT NOT NULL DEFAULT '',
+    events VARCHAR(255) NOT NULL DEFAULT '',
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id SERIAL PRIMARY KEY,
+    webhook_id INT NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    event VARCHAR(255) NOT NULL,
+    msg_hash CITEXT NOT NULL,
+    payload TEXT NOT NULL,
+    status VARCHAR(255) NOT NULL,
+    attempts NUMERIC(20, 0) NOT NULL DEFAULT 0,
+    response_status INT NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMP(3) NOT NULL,
+    created_at TIMESTAMP(3) NOT NULL,
+    updated_at TIMESTAMP(3) NOT NULL
+);
+
+CREATE UNIQUE INDEX webhook_id_event_msg_hash_index ON webhook_deliveries (webhook_id, event, msg_hash);
+CREATE INDEX status_next_attempt_at_index ON webhook_deliveries (status, next_attempt_at);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE webhook_deliveries;
+DROP TABLE webhooks;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS recallable_messages (
+    id SERIAL PRIMARY KEY,
+    msg_hash CITEXT NOT NULL,
+    src_chain_id NUMERIC(20, 0) NOT NULL,
+    dest_chain_id NUMERIC(20, 0) NOT NULL,
+    src_owner CITEXT NOT NULL,
+    message JSONB NOT NULL,
+    proof TEXT NOT NULL,
+    proof_block_id NUMERIC(20, 0) NOT NULL,
+    created_at TIMESTAMP(3) NOT NULL,
+    updated_at TIMESTAMP(3) NOT NULL
+);
+
+CREATE UNIQUE INDEX recallable_messages_msg_hash_index ON recallable_messages (msg_hash);
+CREATE INDEX recallable_messages_src_owner_src_chain_id_index ON recallable_messages (src_owner, src_chain_id);
+CREATE INDEX recallable_messages_src_chain_id_dest_chain_id_index ON recallable_messages (src_chain_id, dest_chain_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE recallable_messages;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS incidents (
+    id SERIAL PRIMARY KEY,
+    incident_id CITEXT NOT NULL,
+    src_chain_id NUMERIC(20, 0) NOT NULL,
+    dest_chain_id NUMERIC(20, 0) NOT NULL,
+    invariant CITEXT NOT NULL,
+    violation_key CITEXT NOT NULL,
+    report JSONB NOT NULL,
+    approvals JSONB NOT NULL,
+    rejections JSONB NOT NULL,
+    outcome CITEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP(3) NOT NULL,
+    updated_at TIMESTAMP(3) NOT NULL
+);
+
+CREATE UNIQUE INDEX incidents_incident_id_index ON incidents (incident_id);
+CREATE INDEX incidents_src_chain_id_dest_chain_id_outcome_index ON incidents (src_chain_id, dest_chain_id, outcome);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE incidents;
+-- +goose StatementEnd
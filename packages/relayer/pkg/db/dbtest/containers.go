@@ -0,0 +1,166 @@
+// Package dbtest runs the integration tests of the database repositories against MySQL and
+// Postgres containers, migrated with the goose migrations of the package under test.
+package dbtest
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pressly/goose/v3"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db/dialect"
+)
+
+var (
+	dbUsername = "root"
+	dbPassword = "password"
+)
+
+// Opts describe the databases to run the tests against.
+type Opts struct {
+	// Name is the name of the database created in the containers.
+	Name string
+	// MigrationsDir is the directory of the MySQL migrations, the Postgres migrations are in
+	// its postgres directory.
+	MigrationsDir string
+}
+
+func mysqlContainer(t *testing.T, opts Opts) (*gorm.DB, func()) {
+	req := testcontainers.ContainerRequest{
+		Image:        "mysql:latest",
+		ExposedPorts: []string{"3306/tcp", "33060/tcp"},
+		Env: map[string]string{
+			"MYSQL_ROOT_PASSWORD": dbPassword,
+			"MYSQL_DATABASE":      opts.Name,
+		},
+		WaitingFor: wait.ForLog("port: 3306  MySQL Community Server - GPL"),
+	}
+
+	ctx := context.Background()
+
+	mysqlC, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	closeContainer := func() {
+		err := mysqlC.Terminate(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	host, _ := mysqlC.Host(ctx)
+	p, _ := mysqlC.MappedPort(ctx, "3306/tcp")
+
+	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?tls=skip-verify&parseTime=true&multiStatements=true",
+		dbUsername, dbPassword, host, p.Int(), opts.Name)
+
+	return migrate(t, dialect.MySQL, dsn, opts.MigrationsDir), closeContainer
+}
+
+func postgresContainer(t *testing.T, opts Opts) (*gorm.DB, func()) {
+	req := testcontainers.ContainerRequest{
+		Image:        "postgres:latest",
+		ExposedPorts: []string{"5432/tcp"},
+		Env: map[string]string{
+			"POSTGRES_PASSWORD": dbPassword,
+			"POSTGRES_DB":       opts.Name,
+		},
+		// postgres restarts once after the initialization
+		WaitingFor: wait.ForLog("database system is ready to accept connections").
+			WithOccurrence(2).
+			WithStartupTimeout(time.Minute),
+	}
+
+	ctx := context.Background()
+
+	postgresC, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	closeContainer := func() {
+		err := postgresC.Terminate(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	host, _ := postgresC.Host(ctx)
+	p, _ := postgresC.MappedPort(ctx, "5432/tcp")
+
+	dsn := fmt.Sprintf("postgres://postgres:%s@%s:%d/%s?sslmode=disable&TimeZone=UTC",
+		dbPassword, host, p.Int(), opts.Name)
+
+	return migrate(t, dialect.Postgres, dsn, filepath.Join(opts.MigrationsDir, "postgres")), closeContainer
+}
+
+// migrate connects to the database and applies the migrations of the given directory.
+func migrate(t *testing.T, dialectName, dsn, migrationsDir string) *gorm.DB {
+	var dialector gorm.Dialector
+
+	switch dialectName {
+	case dialect.Postgres:
+		dialector = postgres.Open(dsn)
+	default:
+		dialector = mysql.Open(dsn)
+	}
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := goose.SetDialect(dialectName); err != nil {
+		t.Fatal(err)
+	}
+
+	sqlDB, _ := gormDB.DB()
+	if err := goose.Up(sqlDB, migrationsDir); err != nil {
+		t.Fatal(err)
+	}
+
+	return gormDB
+}
+
+// databases are the databases the integration tests run against.
+var databases = []struct {
+	dialect string
+	open    func(t *testing.T, opts Opts) (*gorm.DB, func())
+}{
+	{dialect.MySQL, mysqlContainer},
+	{dialect.Postgres, postgresContainer},
+}
+
+// ForEachDatabase runs the given test against each of the supported databases.
+func ForEachDatabase(t *testing.T, opts Opts, f func(t *testing.T, gormDB *gorm.DB)) {
+	for _, d := range databases {
+		t.Run(d.dialect, func(t *testing.T) {
+			gormDB, closeContainer := d.open(t, opts)
+
+			defer closeContainer()
+
+			f(t, gormDB)
+		})
+	}
+}
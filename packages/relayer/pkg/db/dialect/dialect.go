@@ -0,0 +1,79 @@
+// Package dialect builds the GORM dialectors of the databases supported by the relayer,
+// eventindexer, blobstorage and guardian prover health check.
+package dialect
+
+import (
+	"fmt"
+	"net/url"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// Supported database dialects.
+const (
+	MySQL    = "mysql"
+	Postgres = "postgres"
+)
+
+// Opts describe the database to connect to.
+type Opts struct {
+	Dialect  string
+	Username string
+	Password string
+	// Host can include the port, for both dialects.
+	Host     string
+	Database string
+}
+
+// New returns the GORM dialector for the database described by the given options,
+// MySQL is used when no dialect is set.
+func New(opts Opts) (gorm.Dialector, error) {
+	switch opts.Dialect {
+	case "", MySQL:
+		return mysql.Open(mysqlDSN(opts)), nil
+	case Postgres:
+		return postgres.Open(postgresDSN(opts)), nil
+	default:
+		return nil, fmt.Errorf("unsupported database dialect: %v", opts.Dialect)
+	}
+}
+
+func mysqlDSN(opts Opts) string {
+	if opts.Password == "" {
+		return fmt.Sprintf(
+			"%v@tcp(%v)/%v?charset=utf8mb4&parseTime=True&loc=%v",
+			opts.Username,
+			opts.Host,
+			opts.Database,
+			"UTC",
+		)
+	}
+
+	return fmt.Sprintf(
+		"%v:%v@tcp(%v)/%v?charset=utf8mb4&parseTime=True&loc=%v",
+		opts.Username,
+		opts.Password,
+		opts.Host,
+		opts.Database,
+		"UTC",
+	)
+}
+
+// postgresDSN returns a connection URL, the host can include the port as for MySQL.
+func postgresDSN(opts Opts) string {
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.User(opts.Username),
+		Host:     opts.Host,
+		Path:     opts.Database,
+		RawQuery: "TimeZone=UTC",
+	}
+
+	if opts.Password != "" {
+		u.User = url.UserPassword(opts.Username, opts.Password)
+	}
+
+	return u.String()
+}
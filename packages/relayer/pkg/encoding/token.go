@@ -0,0 +1,40 @@
+package encoding
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// tokenABIJSON is the ABI of the `balanceOf` and `totalSupply` methods of the ERC20 tokens.
+const tokenABIJSON = `[{"inputs":[{"internalType":"address","name":"account","type":"address"}],` +
+	`"name":"balanceOf","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],` +
+	`"stateMutability":"view","type":"function"},` +
+	`{"inputs":[],"name":"totalSupply","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],` +
+	`"stateMutability":"view","type":"function"}]`
+
+// erc721ABIJSON is the ABI of the `ownerOf` method of the ERC721 tokens.
+const erc721ABIJSON = `[{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],` +
+	`"name":"ownerOf","outputs":[{"internalType":"address","name":"","type":"address"}],` +
+	`"stateMutability":"view","type":"function"}]`
+
+var TokenABI *abi.ABI
+
+var ERC721ABI *abi.ABI
+
+func init() {
+	tokenABI, err := abi.JSON(strings.NewReader(tokenABIJSON))
+	if err != nil {
+		log.Crit("Get token ABI error", "error", err)
+	}
+
+	TokenABI = &tokenABI
+
+	erc721ABI, err := abi.JSON(strings.NewReader(erc721ABIJSON))
+	if err != nil {
+		log.Crit("Get ERC721 ABI error", "error", err)
+	}
+
+	ERC721ABI = &erc721ABI
+}
@@ -0,0 +1,193 @@
+package feeoracle
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	ErrGasLimitExceeded = errors.New("estimated gas exceeds message gas limit")
+)
+
+// When the processMessage call is not known yet, e.g. when recommending a processing fee for a new
+// message, its calldata size is estimated by the message size plus a signal proof for each hop.
+const (
+	EstimatedMessageCalldataSize = 1024
+	EstimatedProofSizePerHop     = 2048
+)
+
+// FeeOracle estimates the cost to process a bridge message on the destination chain, it is used both by
+// the processor to decide whether a message is profitable, and by the API to recommend processing fees.
+type FeeOracle interface {
+	Estimate(ctx context.Context, req *Request) (*Estimate, error)
+}
+
+// Request describes a processMessage call to estimate.
+type Request struct {
+	// GasLimit is the (padded) gas limit of the message.
+	GasLimit uint64
+	// Call is the processMessage call to simulate, it can be nil when the message is not known yet,
+	// then the gas limit and an estimated calldata size will be used.
+	Call *ethereum.CallMsg
+}
+
+// Estimate is the estimated cost of a processMessage call.
+type Estimate struct {
+	GasUsed   uint64
+	BaseFee   *big.Int
+	GasTipCap *big.Int
+	// L1DataFee is the L1 data availability cost of the call, only for L2 destination chains.
+	L1DataFee *big.Int
+	// Cost is the total cost, including the margin.
+	Cost *big.Int
+}
+
+type ethClient interface {
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
+}
+
+// BlockClient fetches the blocks of a chain, to get its base fee.
+type BlockClient interface {
+	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+}
+
+// BaseFeeFunc returns the base fee of the next block.
+type BaseFeeFunc func(ctx context.Context) (*big.Int, error)
+
+// LatestBaseFee returns a BaseFeeFunc which uses the base fee of the latest block of the given client,
+// it is used to estimate the L1 data fee.
+func LatestBaseFee(client BlockClient) BaseFeeFunc {
+	return func(ctx context.Context) (*big.Int, error) {
+		blk, err := client.BlockByNumber(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if blk.BaseFee() == nil {
+			return new(big.Int), nil
+		}
+
+		return blk.BaseFee(), nil
+	}
+}
+
+// Opts are the options of an Oracle.
+type Opts struct {
+	// DestEthClient is the client of the destination chain.
+	DestEthClient ethClient
+	// DestBaseFee returns the base fee of the destination chain.
+	DestBaseFee BaseFeeFunc
+	// L1BaseFee returns the base fee of L1, it should only be set when the destination chain is a L2,
+	// to account for the L1 data fee.
+	L1BaseFee BaseFeeFunc
+	// NumHops is the number of intermediary hops, used to estimate the signal proof size.
+	NumHops int
+	// Simulate enables simulating the processMessage call with eth_estimateGas.
+	Simulate bool
+	// Margin is added on top of the estimated cost, e.g. 0.1 means 10%.
+	Margin float64
+}
+
+// Oracle is the default FeeOracle implementation, the cost is
+// `(gasUsed * (baseFee * 2 + gasTipCap) + l1DataFee) * (1 + margin)`.
+type Oracle struct {
+	opts Opts
+}
+
+// New creates a new Oracle instance.
+func New(opts Opts) (*Oracle, error) {
+	if opts.DestEthClient == nil || opts.DestBaseFee == nil {
+		return nil, errors.New("destination eth client and base fee func are required")
+	}
+
+	if opts.Margin < 0 {
+		return nil, errors.New("margin can not be negative")
+	}
+
+	return &Oracle{opts: opts}, nil
+}
+
+// Estimate implements the FeeOracle interface.
+func (o *Oracle) Estimate(ctx context.Context, req *Request) (*Estimate, error) {
+	baseFee, err := o.opts.DestBaseFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gasTipCap, err := o.opts.DestEthClient.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gasUsed := req.GasLimit
+
+	if o.opts.Simulate && req.Call != nil {
+		if gasUsed, err = o.opts.DestEthClient.EstimateGas(ctx, *req.Call); err != nil {
+			return nil, err
+		}
+
+		if gasUsed > req.GasLimit {
+			return nil, ErrGasLimitExceeded
+		}
+	}
+
+	l1DataFee := new(big.Int)
+
+	if o.opts.L1BaseFee != nil {
+		l1BaseFee, err := o.opts.L1BaseFee(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		l1DataFee.Mul(l1BaseFee, new(big.Int).SetUint64(calldataGas(req, o.opts.NumHops)))
+	}
+
+	cost := new(big.Int).Mul(
+		new(big.Int).SetUint64(gasUsed),
+		new(big.Int).Add(gasTipCap, new(big.Int).Mul(baseFee, big.NewInt(2))),
+	)
+	cost.Add(cost, l1DataFee)
+
+	return &Estimate{
+		GasUsed:   gasUsed,
+		BaseFee:   baseFee,
+		GasTipCap: gasTipCap,
+		L1DataFee: l1DataFee,
+		Cost:      ApplyMultiplier(cost, 1+o.opts.Margin),
+	}, nil
+}
+
+// calldataGas returns the L1 calldata gas of the given request, when the call is unknown,
+// all estimated calldata bytes are treated as non-zero bytes.
+func calldataGas(req *Request, numHops int) uint64 {
+	if req.Call == nil {
+		size := EstimatedMessageCalldataSize + EstimatedProofSizePerHop*uint64(numHops+1)
+		return size * params.TxDataNonZeroGasEIP2028
+	}
+
+	var gas uint64
+
+	for _, b := range req.Call.Data {
+		if b == 0 {
+			gas += params.TxDataZeroGas
+		} else {
+			gas += params.TxDataNonZeroGasEIP2028
+		}
+	}
+
+	return gas
+}
+
+// ApplyMultiplier multiplies the given value with the given float multiplier.
+func ApplyMultiplier(value *big.Int, multiplier float64) *big.Int {
+	rat := new(big.Rat).SetInt(value)
+	rat.Mul(rat, new(big.Rat).SetFloat64(multiplier))
+
+	return new(big.Int).Div(rat.Num(), rat.Denom())
+}
@@ -0,0 +1,130 @@
+package feeoracle
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubEthClient struct {
+	gasTipCap *big.Int
+	gasUsed   uint64
+	baseFee   *big.Int
+}
+
+func (c *stubEthClient) SuggestGasTipCap(_ context.Context) (*big.Int, error) {
+	return c.gasTipCap, nil
+}
+
+func (c *stubEthClient) EstimateGas(_ context.Context, _ ethereum.CallMsg) (uint64, error) {
+	return c.gasUsed, nil
+}
+
+func (c *stubEthClient) BlockByNumber(_ context.Context, _ *big.Int) (*types.Block, error) {
+	return types.NewBlockWithHeader(&types.Header{BaseFee: c.baseFee}), nil
+}
+
+func fixedBaseFee(baseFee int64) BaseFeeFunc {
+	return func(_ context.Context) (*big.Int, error) {
+		return big.NewInt(baseFee), nil
+	}
+}
+
+func Test_New(t *testing.T) {
+	_, err := New(Opts{})
+	assert.NotNil(t, err)
+
+	_, err = New(Opts{DestEthClient: &stubEthClient{}, DestBaseFee: fixedBaseFee(1), Margin: -1})
+	assert.NotNil(t, err)
+}
+
+func Test_Estimate(t *testing.T) {
+	client := &stubEthClient{gasTipCap: big.NewInt(1), gasUsed: 100_000, baseFee: big.NewInt(10)}
+
+	tests := []struct {
+		name     string
+		opts     Opts
+		req      *Request
+		wantGas  uint64
+		wantL1   *big.Int
+		wantCost *big.Int
+		wantErr  error
+	}{
+		{
+			"gasLimit",
+			Opts{DestEthClient: client, DestBaseFee: fixedBaseFee(1000)},
+			&Request{GasLimit: 200_000},
+			200_000,
+			big.NewInt(0),
+			big.NewInt(200_000 * 2001),
+			nil,
+		},
+		{
+			"simulated",
+			Opts{DestEthClient: client, DestBaseFee: fixedBaseFee(1000), Simulate: true},
+			&Request{GasLimit: 200_000, Call: &ethereum.CallMsg{}},
+			100_000,
+			big.NewInt(0),
+			big.NewInt(100_000 * 2001),
+			nil,
+		},
+		{
+			"simulatedExceedsGasLimit",
+			Opts{DestEthClient: client, DestBaseFee: fixedBaseFee(1000), Simulate: true},
+			&Request{GasLimit: 50_000, Call: &ethereum.CallMsg{}},
+			0,
+			nil,
+			nil,
+			ErrGasLimitExceeded,
+		},
+		{
+			"l1DataFee",
+			Opts{DestEthClient: client, DestBaseFee: fixedBaseFee(1000), L1BaseFee: LatestBaseFee(client), Simulate: true},
+			&Request{GasLimit: 200_000, Call: &ethereum.CallMsg{Data: []byte{0, 1, 2}}},
+			100_000,
+			big.NewInt(10 * (4 + 16 + 16)),
+			big.NewInt(100_000*2001 + 10*(4+16+16)),
+			nil,
+		},
+		{
+			"l1DataFeeEstimatedCalldata",
+			Opts{DestEthClient: client, DestBaseFee: fixedBaseFee(1000), L1BaseFee: LatestBaseFee(client), NumHops: 1},
+			&Request{GasLimit: 200_000},
+			200_000,
+			big.NewInt(10 * 16 * (1024 + 2048*2)),
+			big.NewInt(200_000*2001 + 10*16*(1024+2048*2)),
+			nil,
+		},
+		{
+			"margin",
+			Opts{DestEthClient: client, DestBaseFee: fixedBaseFee(1000), Margin: 0.5},
+			&Request{GasLimit: 200_000},
+			200_000,
+			big.NewInt(0),
+			big.NewInt(200_000 * 2001 * 3 / 2),
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oracle, err := New(tt.opts)
+			assert.Nil(t, err)
+
+			estimate, err := oracle.Estimate(context.Background(), tt.req)
+			assert.Equal(t, tt.wantErr, err)
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			assert.Equal(t, tt.wantGas, estimate.GasUsed)
+			assert.Equal(t, tt.wantL1, estimate.L1DataFee)
+			assert.Equal(t, tt.wantCost, estimate.Cost)
+		})
+	}
+}
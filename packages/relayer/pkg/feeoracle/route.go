@@ -0,0 +1,109 @@
+package feeoracle
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// TaikoL2 computes the base fee of a Taiko L2 chain.
+type TaikoL2 interface {
+	GetBasefee(opts *bind.CallOpts, _l1BlockId uint64, _parentGasUsed uint32) (struct {
+		Basefee   *big.Int
+		GasExcess uint64
+	}, error)
+}
+
+type routeEthClient interface {
+	ethClient
+	BlockClient
+}
+
+// RouteOpts describe the route of the messages to estimate, the processor and the API create their
+// oracles from the same route configuration, so that the recommended processing fees are the ones
+// the processor accepts.
+type RouteOpts struct {
+	// SrcEthClient is the client of the source chain.
+	SrcEthClient BlockClient
+	// DestEthClient is the client of the destination chain.
+	DestEthClient routeEthClient
+	// DestChainID is the chain ID of the destination chain.
+	DestChainID *big.Int
+	// HopEthClients are the clients of the intermediary chains, in order.
+	HopEthClients []BlockClient
+	// DestTaikoL2 is the TaikoL2 contract of the destination chain, it should only be set when the
+	// destination chain is a Taiko L2, whose L1 is the last intermediary chain if there are hops,
+	// otherwise the source chain. The L1 data fee of the processMessage calldata is then added to the cost.
+	DestTaikoL2 TaikoL2
+	// Simulate enables simulating the processMessage call with eth_estimateGas.
+	Simulate bool
+	// Margin is added on top of the estimated cost, e.g. 0.1 means 10%.
+	Margin float64
+}
+
+// NewForRoute creates a new Oracle instance for the given route.
+func NewForRoute(opts RouteOpts) (*Oracle, error) {
+	if opts.SrcEthClient == nil || opts.DestEthClient == nil || opts.DestChainID == nil {
+		return nil, errors.New("source and destination eth clients and destination chain ID are required")
+	}
+
+	oracleOpts := Opts{
+		DestEthClient: opts.DestEthClient,
+		DestBaseFee:   NextBaseFee(opts.DestEthClient, opts.DestChainID),
+		NumHops:       len(opts.HopEthClients),
+		Simulate:      opts.Simulate,
+		Margin:        opts.Margin,
+	}
+
+	if opts.DestTaikoL2 != nil {
+		l1EthClient := opts.SrcEthClient
+		if len(opts.HopEthClients) != 0 {
+			l1EthClient = opts.HopEthClients[len(opts.HopEthClients)-1]
+		}
+
+		oracleOpts.DestBaseFee = taikoL2BaseFee(opts.DestTaikoL2, l1EthClient, opts.DestEthClient)
+		oracleOpts.L1BaseFee = LatestBaseFee(l1EthClient)
+	}
+
+	return New(oracleOpts)
+}
+
+// NextBaseFee returns a BaseFeeFunc which computes the EIP-1559 base fee of the next block
+// of the given client.
+func NextBaseFee(client BlockClient, chainID *big.Int) BaseFeeFunc {
+	return func(ctx context.Context) (*big.Int, error) {
+		blk, err := client.BlockByNumber(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return eip1559.CalcBaseFee(params.NetworkIDToChainConfigOrDefault(chainID), blk.Header()), nil
+	}
+}
+
+// taikoL2BaseFee returns a BaseFeeFunc which asks the TaikoL2 contract for the base fee of
+// the next L2 block, given the latest L1 block and the gas used by the latest L2 block.
+func taikoL2BaseFee(taikoL2 TaikoL2, l1EthClient BlockClient, l2EthClient BlockClient) BaseFeeFunc {
+	return func(ctx context.Context) (*big.Int, error) {
+		l1Block, err := l1EthClient.BlockByNumber(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		l2Block, err := l2EthClient.BlockByNumber(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		bf, err := taikoL2.GetBasefee(&bind.CallOpts{Context: ctx}, l1Block.NumberU64(), uint32(l2Block.GasUsed()))
+		if err != nil {
+			return nil, err
+		}
+
+		return bf.Basefee, nil
+	}
+}
@@ -0,0 +1,103 @@
+package feeoracle
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubBlockClient struct {
+	header *types.Header
+}
+
+func (c *stubBlockClient) BlockByNumber(_ context.Context, _ *big.Int) (*types.Block, error) {
+	return types.NewBlockWithHeader(c.header), nil
+}
+
+type stubDestClient struct {
+	stubEthClient
+	header *types.Header
+}
+
+func (c *stubDestClient) BlockByNumber(_ context.Context, _ *big.Int) (*types.Block, error) {
+	return types.NewBlockWithHeader(c.header), nil
+}
+
+type stubTaikoL2 struct {
+	baseFee   *big.Int
+	l1BlockID uint64
+}
+
+func (t *stubTaikoL2) GetBasefee(_ *bind.CallOpts, l1BlockID uint64, _ uint32) (struct {
+	Basefee   *big.Int
+	GasExcess uint64
+}, error) {
+	t.l1BlockID = l1BlockID
+
+	return struct {
+		Basefee   *big.Int
+		GasExcess uint64
+	}{Basefee: t.baseFee}, nil
+}
+
+func newHeader(number int64, baseFee int64) *types.Header {
+	return &types.Header{
+		Number:   big.NewInt(number),
+		GasLimit: 30_000_000,
+		GasUsed:  15_000_000,
+		BaseFee:  big.NewInt(baseFee),
+	}
+}
+
+func Test_NewForRoute(t *testing.T) {
+	_, err := NewForRoute(RouteOpts{})
+	assert.NotNil(t, err)
+
+	src := &stubBlockClient{header: newHeader(100, 5)}
+	hop := &stubBlockClient{header: newHeader(200, 10)}
+	dest := &stubDestClient{
+		stubEthClient: stubEthClient{gasTipCap: big.NewInt(1)},
+		header:        newHeader(300, 1000),
+	}
+
+	t.Run("notTaikoL2", func(t *testing.T) {
+		oracle, err := NewForRoute(RouteOpts{
+			SrcEthClient:  src,
+			DestEthClient: dest,
+			DestChainID:   big.NewInt(1),
+			HopEthClients: []BlockClient{hop},
+		})
+		assert.Nil(t, err)
+
+		estimate, err := oracle.Estimate(context.Background(), &Request{GasLimit: 200_000})
+		assert.Nil(t, err)
+
+		assert.Equal(t, big.NewInt(1000), estimate.BaseFee)
+		assert.Equal(t, big.NewInt(0), estimate.L1DataFee)
+	})
+
+	t.Run("taikoL2", func(t *testing.T) {
+		taikoL2 := &stubTaikoL2{baseFee: big.NewInt(7)}
+
+		oracle, err := NewForRoute(RouteOpts{
+			SrcEthClient:  src,
+			DestEthClient: dest,
+			DestChainID:   big.NewInt(167),
+			HopEthClients: []BlockClient{hop},
+			DestTaikoL2:   taikoL2,
+		})
+		assert.Nil(t, err)
+
+		estimate, err := oracle.Estimate(context.Background(), &Request{GasLimit: 200_000})
+		assert.Nil(t, err)
+
+		// the L1 of the destination chain is the last hop
+		assert.Equal(t, uint64(200), taikoL2.l1BlockID)
+		assert.Equal(t, big.NewInt(7), estimate.BaseFee)
+		assert.Equal(t, big.NewInt(10*16*(1024+2048*2)), estimate.L1DataFee)
+	})
+}
@@ -0,0 +1,59 @@
+package flags
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/dbqueue"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/rabbitmq"
+)
+
+// OpenQueueFromCli opens the queue backend selected by the command line flags, the db queue
+// reuses the database opened by the given function.
+func OpenQueueFromCli(c *cli.Context, openDBFunc func() (db.DB, error)) (queue.Queue, error) {
+	opts := queue.NewQueueOpts{
+		Username:      c.String(flags.QueueUsername.Name),
+		Password:      c.String(flags.QueuePassword.Name),
+		Host:          c.String(flags.QueueHost.Name),
+		Port:          c.String(flags.QueuePort.Name),
+		PrefetchCount: c.Uint64(flags.QueuePrefetchCount.Name),
+	}
+
+	switch queueType := c.String(flags.QueueType.Name); queueType {
+	case "", queue.TypeRabbitMQ:
+		for _, f := range []cli.Flag{flags.QueueUsername, flags.QueuePassword, flags.QueueHost, flags.QueuePort} {
+			if !c.IsSet(f.Names()[0]) {
+				return nil, fmt.Errorf("flag %v is required by the %v queue", f.Names()[0], queue.TypeRabbitMQ)
+			}
+		}
+
+		q, err := rabbitmq.NewQueue(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return q, nil
+	case queue.TypeMemory:
+		// every sub-command runs in its own process, so the messages published to a memory
+		// queue would never be consumed.
+		return nil, fmt.Errorf("the %v queue can only be used in process, not by a sub-command", queueType)
+	case queue.TypeDB:
+		dbHandler, err := openDBFunc()
+		if err != nil {
+			return nil, err
+		}
+
+		return dbqueue.NewQueue(dbHandler, dbqueue.Opts{
+			PrefetchCount:     opts.PrefetchCount,
+			PollInterval:      c.Duration(flags.QueuePollInterval.Name),
+			VisibilityTimeout: time.Duration(c.Uint64(flags.QueueVisibilityTimeout.Name)) * time.Second,
+		})
+	default:
+		return nil, fmt.Errorf("unknown queue type: %v", queueType)
+	}
+}
@@ -11,4 +11,8 @@ var (
 		"ERR_NO_REWARDER",
 		"Rewarder is required",
 	)
+	ErrMessageNotFound = errors.NotFound.NewWithKeyAndDetail(
+		"ERR_MESSAGE_NOT_FOUND",
+		"Message not found",
+	)
 )
@@ -1,7 +1,6 @@
 package http
 
 import (
-	"encoding/json"
 	"html"
 	"math/big"
 	"net/http"
@@ -126,48 +125,10 @@ func (srv *Server) GetEventsByAddress(c echo.Context) error {
 			continue
 		}
 
-		r := &JSONData{}
-
-		if err := json.Unmarshal(msgProcessedEvent.Data, r); err != nil {
-			return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
-		}
-
-		if r.Raw.TransactionIndex == "" || r.Raw.TransactionHash == "" {
-			continue
-		}
-
-		var ethClient ethClient
-
-		if new(big.Int).SetInt64(msgProcessedEvent.ChainID).Cmp(srv.srcChainID) == 0 {
-			ethClient = srv.srcEthClient
-		} else {
-			ethClient = srv.destEthClient
-		}
-
-		tx, _, err := ethClient.TransactionByHash(
-			c.Request().Context(),
-			common.HexToHash(r.Raw.TransactionHash),
-		)
-		if err != nil {
-			return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
-		}
-
-		txIndex, err := strconv.ParseInt(r.Raw.TransactionIndex[2:], 16, 64)
+		v.ProcessedTxHash, v.ClaimedBy, err = srv.processedTx(c.Request().Context(), msgProcessedEvent)
 		if err != nil {
 			return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
 		}
-
-		sender, err := ethClient.TransactionSender(
-			c.Request().Context(),
-			tx,
-			common.HexToHash(r.Raw.BlockHash),
-			uint(txIndex),
-		)
-		if err == nil {
-			v.ClaimedBy = sender.Hex()
-		}
-
-		v.ProcessedTxHash = r.Raw.TransactionHash
 	}
 
 	return c.JSON(http.StatusOK, page)
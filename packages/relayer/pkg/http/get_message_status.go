@@ -0,0 +1,269 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"html"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/labstack/echo/v4"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+// profitability is the last profitability evaluation of a message by the processor.
+type profitability struct {
+	Fee                     *uint64    `json:"fee"`
+	DestChainBaseFee        *uint64    `json:"destChainBaseFee"`
+	GasTipCap               *uint64    `json:"gasTipCap"`
+	GasLimit                *uint64    `json:"gasLimit"`
+	IsProfitable            *bool      `json:"isProfitable"`
+	EstimatedOnchainFee     *uint64    `json:"estimatedOnchainFee"`
+	IsProfitableEvaluatedAt *time.Time `json:"isProfitableEvaluatedAt"`
+}
+
+// timelineEntry is an indexed event of a message.
+type timelineEntry struct {
+	Event   string `json:"event"`
+	Status  string `json:"status,omitempty"`
+	ChainID int64  `json:"chainID"`
+	BlockID uint64 `json:"blockID"`
+	TxHash  string `json:"txHash"`
+}
+
+// messageStatus is the full timeline of a bridge message. ProcessingAttempts counts the status
+// changes on the destination chain, a reverted processMessage transaction emits no event so it
+// is not counted.
+type messageStatus struct {
+	MsgHash            string          `json:"msgHash"`
+	Status             string          `json:"status"`
+	SrcChainID         int64           `json:"srcChainID"`
+	DestChainID        int64           `json:"destChainID"`
+	SrcTxHash          string          `json:"srcTxHash"`
+	MessageSent        *relayer.Event  `json:"messageSent"`
+	Synced             bool            `json:"synced"`
+	SyncedInBlockID    uint64          `json:"syncedInBlockID"`
+	Profitability      profitability   `json:"profitability"`
+	ProcessingAttempts int             `json:"processingAttempts"`
+	ProcessedTxHash    string          `json:"processedTxHash"`
+	ClaimedBy          string          `json:"claimedBy"`
+	Timeline           []timelineEntry `json:"timeline"`
+}
+
+// GetMessageStatus
+//
+//	 returns the status and the timeline of a message by its msgHash
+//
+//			@Summary		Get message status
+//			@ID			   	get-message-status
+//		    @Param			msgHash	path		string		true	"msgHash to query"
+//			@Accept			json
+//			@Produce		json
+//			@Success		200	{object} messageStatus
+//			@Router			/messages/{msgHash} [get]
+func (srv *Server) GetMessageStatus(c echo.Context) error {
+	msgHash := html.EscapeString(c.Param("msgHash"))
+
+	msgSentEvent, err := srv.eventRepo.FirstByEventAndMsgHash(
+		c.Request().Context(),
+		relayer.EventNameMessageSent,
+		msgHash,
+	)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	if msgSentEvent == nil {
+		return webutils.LogAndRenderErrors(c, http.StatusNotFound, ErrMessageNotFound)
+	}
+
+	status, err := srv.messageStatus(c.Request().Context(), msgSentEvent)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	return c.JSON(http.StatusOK, status)
+}
+
+// GetMessageStatusesBySrcTx
+//
+//	 returns the status and the timeline of all the messages sent in a source chain transaction
+//
+//			@Summary		Get message statuses by source transaction
+//			@ID			   	get-message-statuses-by-src-tx
+//		    @Param			txHash	path		string		true	"source chain txHash to query"
+//			@Accept			json
+//			@Produce		json
+//			@Success		200	{object} []messageStatus
+//			@Router			/messages/bySrcTx/{txHash} [get]
+func (srv *Server) GetMessageStatusesBySrcTx(c echo.Context) error {
+	txHash := html.EscapeString(c.Param("txHash"))
+
+	msgSentEvents, err := srv.eventRepo.FindAllByEventAndTxHash(
+		c.Request().Context(),
+		relayer.EventNameMessageSent,
+		txHash,
+	)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	if len(msgSentEvents) == 0 {
+		return webutils.LogAndRenderErrors(c, http.StatusNotFound, ErrMessageNotFound)
+	}
+
+	statuses := make([]*messageStatus, 0, len(msgSentEvents))
+
+	for _, e := range msgSentEvents {
+		status, err := srv.messageStatus(c.Request().Context(), e)
+		if err != nil {
+			return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return c.JSON(http.StatusOK, statuses)
+}
+
+// messageStatus builds the timeline of the message sent in the given MessageSent event:
+// the header sync to the destination chain, and the status changes of the message.
+func (srv *Server) messageStatus(ctx context.Context, msgSentEvent *relayer.Event) (*messageStatus, error) {
+	status := &messageStatus{
+		MsgHash:     msgSentEvent.MsgHash,
+		Status:      msgSentEvent.Status.String(),
+		SrcChainID:  msgSentEvent.ChainID,
+		DestChainID: msgSentEvent.DestChainID,
+		SrcTxHash:   msgSentEvent.TxHash,
+		MessageSent: msgSentEvent,
+		Profitability: profitability{
+			Fee:                     msgSentEvent.Fee,
+			DestChainBaseFee:        msgSentEvent.DestChainBaseFee,
+			GasTipCap:               msgSentEvent.GasTipCap,
+			GasLimit:                msgSentEvent.GasLimit,
+			IsProfitable:            msgSentEvent.IsProfitable,
+			EstimatedOnchainFee:     msgSentEvent.EstimatedOnchainFee,
+			IsProfitableEvaluatedAt: msgSentEvent.IsProfitableEvaluatedAt,
+		},
+		Timeline: []timelineEntry{
+			{
+				Event:   relayer.EventNameMessageSent,
+				ChainID: msgSentEvent.ChainID,
+				BlockID: msgSentEvent.EmittedBlockID,
+				TxHash:  msgSentEvent.TxHash,
+			},
+		},
+	}
+
+	// the message can be processed once the source chain block it was sent in,
+	// or a later one, has been synced to the destination chain.
+	syncedEvent, err := srv.eventRepo.ChainDataSyncedEventByBlockNumberOrGreater(
+		ctx,
+		uint64(msgSentEvent.DestChainID),
+		uint64(msgSentEvent.ChainID),
+		msgSentEvent.EmittedBlockID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if syncedEvent != nil {
+		status.Synced = true
+		status.SyncedInBlockID = syncedEvent.SyncedInBlockID
+
+		status.Timeline = append(status.Timeline, timelineEntry{
+			Event:   relayer.EventNameChainDataSynced,
+			ChainID: syncedEvent.ChainID,
+			BlockID: syncedEvent.SyncedInBlockID,
+			TxHash:  syncedEvent.TxHash,
+		})
+	}
+
+	events, err := srv.eventRepo.FindAllByMsgHash(ctx, msgSentEvent.MsgHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastProcessedEvent *relayer.Event
+
+	for _, e := range events {
+		if e.Event != relayer.EventNameMessageStatusChanged {
+			continue
+		}
+
+		status.Status = e.Status.String()
+
+		status.Timeline = append(status.Timeline, timelineEntry{
+			Event:   e.Event,
+			Status:  e.Status.String(),
+			ChainID: e.ChainID,
+			BlockID: e.EmittedBlockID,
+			TxHash:  e.TxHash,
+		})
+
+		// every status change on the destination chain is the result of a processMessage
+		// or retryMessage transaction.
+		if e.ChainID == msgSentEvent.DestChainID {
+			status.ProcessingAttempts++
+
+			lastProcessedEvent = e
+		}
+	}
+
+	if lastProcessedEvent != nil {
+		txHash, claimedBy, err := srv.processedTx(ctx, lastProcessedEvent)
+		if err != nil {
+			return nil, err
+		}
+
+		status.ProcessedTxHash = txHash
+		status.ClaimedBy = claimedBy
+	}
+
+	return status, nil
+}
+
+// processedTx returns the hash and the sender of the transaction which emitted the given
+// event, they are empty if the event was not emitted by a transaction.
+func (srv *Server) processedTx(ctx context.Context, e *relayer.Event) (string, string, error) {
+	r := &JSONData{}
+
+	if err := json.Unmarshal(e.Data, r); err != nil {
+		return "", "", err
+	}
+
+	if r.Raw.TransactionIndex == "" || r.Raw.TransactionHash == "" {
+		return "", "", nil
+	}
+
+	var ethClient ethClient
+
+	if new(big.Int).SetInt64(e.ChainID).Cmp(srv.srcChainID) == 0 {
+		ethClient = srv.srcEthClient
+	} else {
+		ethClient = srv.destEthClient
+	}
+
+	tx, _, err := ethClient.TransactionByHash(ctx, common.HexToHash(r.Raw.TransactionHash))
+	if err != nil {
+		return "", "", err
+	}
+
+	txIndex, err := hexutil.DecodeUint64(r.Raw.TransactionIndex)
+	if err != nil {
+		return "", "", err
+	}
+
+	var claimedBy string
+
+	sender, err := ethClient.TransactionSender(ctx, tx, common.HexToHash(r.Raw.BlockHash), uint(txIndex))
+	if err == nil {
+		claimedBy = sender.Hex()
+	}
+
+	return r.Raw.TransactionHash, claimedBy, nil
+}
@@ -0,0 +1,131 @@
+package http
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+	"gorm.io/datatypes"
+)
+
+func Test_GetMessageStatus(t *testing.T) {
+	srv := newTestServer()
+
+	_, err := srv.eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+		Name:        relayer.EventNameMessageSent,
+		Event:       relayer.EventNameMessageSent,
+		Data:        "{}",
+		ChainID:     big.NewInt(167001),
+		DestChainID: big.NewInt(167002),
+		Status:      relayer.EventStatusNew,
+		MsgHash:     "0x1",
+		TxHash:      "0xa",
+	})
+	assert.Equal(t, nil, err)
+
+	_, err = srv.eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+		Name:        relayer.EventNameMessageStatusChanged,
+		Event:       relayer.EventNameMessageStatusChanged,
+		Data:        "{}",
+		ChainID:     big.NewInt(167002),
+		DestChainID: big.NewInt(167001),
+		Status:      relayer.EventStatusRetriable,
+		MsgHash:     "0x1",
+		TxHash:      "0xb",
+	})
+	assert.Equal(t, nil, err)
+
+	tests := []struct {
+		name                  string
+		url                   string
+		wantStatus            int
+		wantBodyRegexpMatches []string
+	}{
+		{
+			"success",
+			"/messages/0x1",
+			http.StatusOK,
+			[]string{
+				`"msgHash":"0x1","status":"retriable"`,
+				`"srcTxHash":"0xa"`,
+				`"synced":true`,
+				`"processingAttempts":1`,
+				`"timeline":\[{"event":"MessageSent"`,
+				`{"event":"MessageStatusChanged","status":"retriable","chainID":167002,"blockID":0,"txHash":"0xb"}\]`,
+			},
+		},
+		{
+			"notFound",
+			"/messages/0x2",
+			http.StatusNotFound,
+			[]string{`ERR_MESSAGE_NOT_FOUND`},
+		},
+		{
+			"successBySrcTx",
+			"/messages/bySrcTx/0xa",
+			http.StatusOK,
+			[]string{`^\[{"msgHash":"0x1","status":"retriable"`},
+		},
+		{
+			"notFoundBySrcTx",
+			"/messages/bySrcTx/0xb",
+			http.StatusNotFound,
+			[]string{`ERR_MESSAGE_NOT_FOUND`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testutils.NewUnauthenticatedRequest(
+				echo.GET,
+				tt.url,
+				nil,
+			)
+
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, req)
+
+			testutils.AssertStatusAndBody(t, rec, tt.wantStatus, tt.wantBodyRegexpMatches)
+		})
+	}
+}
+
+func Test_processedTx(t *testing.T) {
+	srv := newTestServer()
+	srv.srcChainID = mock.MockChainID
+	srv.srcEthClient = &mock.EthClient{}
+	srv.destEthClient = &mock.EthClient{}
+
+	tests := []struct {
+		name             string
+		transactionIndex string
+		wantTxHash       string
+		wantErr          bool
+	}{
+		{"success", "0x1", "0xb", false},
+		{"noPrefix", "1", "", true},
+		{"empty", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			txHash, _, err := srv.processedTx(context.Background(), &relayer.Event{
+				ChainID: mock.MockChainID.Int64(),
+				Data: datatypes.JSON(
+					`{"Raw":{"transactionHash":"0xb","transactionIndex":"` + tt.transactionIndex + `"}}`,
+				),
+			})
+
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantTxHash, txHash)
+		})
+	}
+}
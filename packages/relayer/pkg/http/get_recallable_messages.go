@@ -0,0 +1,53 @@
+package http
+
+import (
+	"html"
+	"net/http"
+	"strconv"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/labstack/echo/v4"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+// GetRecallableMessages
+//
+//	 returns the failed messages of a source owner, with the prebuilt proofs to recall them
+//
+//			@Summary		Get recallable messages by address
+//			@ID			   	get-recallable-messages
+//		    @Param			address	query		string		true	"source owner address to query"
+//		    @Param			srcChainID	query		string		false	"source chainID to query"
+//			@Accept			json
+//			@Produce		json
+//			@Success		200	{object} paginate.Page
+//			@Router			/recallableMessages [get]
+func (srv *Server) GetRecallableMessages(c echo.Context) error {
+	address := html.EscapeString(c.QueryParam("address"))
+
+	var srcChainID *uint64
+
+	if srcChainIDParam := html.EscapeString(c.QueryParam("srcChainID")); srcChainIDParam != "" {
+		id, err := strconv.ParseUint(srcChainIDParam, 10, 64)
+		if err != nil {
+			return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+		}
+
+		srcChainID = &id
+	}
+
+	page, err := srv.recallableMessageRepo.FindAllByAddress(
+		c.Request().Context(),
+		c.Request(),
+		relayer.FindAllRecallableMessagesOpts{
+			Address:    common.HexToAddress(address),
+			SrcChainID: srcChainID,
+		},
+	)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	return c.JSON(http.StatusOK, page)
+}
@@ -0,0 +1,92 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+func Test_GetRecallableMessages(t *testing.T) {
+	srv := newTestServer()
+
+	err := srv.recallableMessageRepo.Save(context.Background(), &relayer.SaveRecallableMessageOpts{
+		MsgHash:      "0x1",
+		SrcChainID:   167001,
+		DestChainID:  167002,
+		SrcOwner:     "0x0000000000000000000000000000000000000123",
+		Message:      `{"Id":1}`,
+		Proof:        "0x1234",
+		ProofBlockID: 5,
+	})
+
+	assert.Equal(t, nil, err)
+
+	tests := []struct {
+		name                  string
+		address               string
+		srcChainID            string
+		wantStatus            int
+		wantBodyRegexpMatches []string
+	}{
+		{
+			"successEmptyList",
+			"0x456",
+			"",
+			http.StatusOK,
+			[]string{`\[\]`},
+		},
+		{
+			"successOtherSrcChainID",
+			"0x0000000000000000000000000000000000000123",
+			"167002",
+			http.StatusOK,
+			[]string{`\[\]`},
+		},
+		{
+			"success",
+			"0x0000000000000000000000000000000000000123",
+			"167001",
+			http.StatusOK,
+			[]string{`"msgHash":"0x1"`, `"proof":"0x1234"`, `"proofBlockID":5`},
+		},
+		{
+			"successNoSrcChainID",
+			"0x0000000000000000000000000000000000000123",
+			"",
+			http.StatusOK,
+			[]string{`"msgHash":"0x1"`},
+		},
+		{
+			"invalidSrcChainID",
+			"0x0000000000000000000000000000000000000123",
+			"notanumber",
+			http.StatusUnprocessableEntity,
+			[]string{`ERR_UNEXPECTED`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testutils.NewUnauthenticatedRequest(
+				echo.GET,
+				fmt.Sprintf("/recallableMessages?address=%v&srcChainID=%v",
+					tt.address,
+					tt.srcChainID),
+				nil,
+			)
+
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, req)
+
+			testutils.AssertStatusAndBody(t, rec, tt.wantStatus, tt.wantBodyRegexpMatches)
+		})
+	}
+}
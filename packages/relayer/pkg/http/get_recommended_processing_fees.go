@@ -1,16 +1,13 @@
 package http
 
 import (
-	"context"
 	"math/big"
 	"net/http"
 	"strconv"
 
 	"github.com/cyberhorsey/webutils"
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
-	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
-	"github.com/ethereum/go-ethereum/params"
 	"github.com/labstack/echo/v4"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/feeoracle"
 )
 
 type getRecommendedProcessingFeesResponse struct {
@@ -99,37 +96,27 @@ func (srv *Server) GetRecommendedProcessingFees(c echo.Context) error {
 		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
 	}
 
-	srcGasTipCap, err := srv.srcEthClient.SuggestGasTipCap(c.Request().Context())
-	if err != nil {
-		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
-	}
-
-	srcBaseFee, err := srv.getDestChainBaseFee(c.Request().Context(), Layer1, srcChainID)
-	if err != nil {
-		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
-	}
-
-	destBaseFee, err := srv.getDestChainBaseFee(c.Request().Context(), Layer2, destChainID)
-	if err != nil {
-		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
-	}
+	for _, f := range feeTypes {
+		srcEstimate, err := srv.srcFeeOracle.Estimate(c.Request().Context(), &feeoracle.Request{GasLimit: uint64(f)})
+		if err != nil {
+			return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+		}
 
-	destGasTipCap, err := srv.destEthClient.SuggestGasTipCap(c.Request().Context())
-	if err != nil {
-		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
-	}
+		destEstimate, err := srv.destFeeOracle.Estimate(c.Request().Context(), &feeoracle.Request{GasLimit: uint64(f)})
+		if err != nil {
+			return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+		}
 
-	for _, f := range feeTypes {
 		fees = append(fees, fee{
 			Type:        f.String(),
-			Amount:      srv.getCost(uint64(f), destGasTipCap, destBaseFee, Layer1).String(),
+			Amount:      srv.getCost(srcEstimate, Layer1).String(),
 			DestChainID: srcChainID.Uint64(),
 			GasLimit:    strconv.Itoa(int(f)),
 		})
 
 		fees = append(fees, fee{
 			Type:        f.String(),
-			Amount:      srv.getCost(uint64(f), srcGasTipCap, srcBaseFee, Layer2).String(),
+			Amount:      srv.getCost(destEstimate, Layer2).String(),
 			DestChainID: destChainID.Uint64(),
 			GasLimit:    strconv.Itoa(int(f)),
 		})
@@ -142,55 +129,12 @@ func (srv *Server) GetRecommendedProcessingFees(c echo.Context) error {
 	return c.JSON(http.StatusOK, resp)
 }
 
-func (srv *Server) getCost(
-	gasLimit uint64,
-	gasTipCap *big.Int,
-	baseFee *big.Int,
-	destLayer layer,
-) *big.Int {
-	cost := new(big.Int).Mul(
-		new(big.Int).SetUint64(gasLimit),
-		new(big.Int).Add(gasTipCap, new(big.Int).Mul(baseFee, big.NewInt(2))))
-
-	if destLayer == Layer2 {
-		return cost
-	}
-
-	costRat := new(big.Rat).SetInt(cost)
-
-	multiplierRat := new(big.Rat).SetFloat64(srv.processingFeeMultiplier)
-
-	costRat.Mul(costRat, multiplierRat)
-
-	costAfterMultiplier := new(big.Int).Div(costRat.Num(), costRat.Denom())
-
-	return costAfterMultiplier
-}
-
-func (srv *Server) getDestChainBaseFee(ctx context.Context, destLayer layer, chainID *big.Int) (*big.Int, error) {
-	blk, err := srv.srcEthClient.BlockByNumber(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	var baseFee *big.Int
-
+// getCost returns the recommended processing fee of the given estimate, messages sent to
+// Layer1 are multiplied by the processing fee multiplier, to tolerate the L1 fee volatility.
+func (srv *Server) getCost(estimate *feeoracle.Estimate, destLayer layer) *big.Int {
 	if destLayer == Layer2 {
-		latestL2Block, err := srv.destEthClient.BlockByNumber(ctx, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		bf, err := srv.taikoL2.GetBasefee(&bind.CallOpts{Context: ctx}, blk.NumberU64(), uint32(latestL2Block.GasUsed()))
-		if err != nil {
-			return nil, err
-		}
-
-		baseFee = bf.Basefee
-	} else {
-		cfg := params.NetworkIDToChainConfigOrDefault(chainID)
-		baseFee = eip1559.CalcBaseFee(cfg, blk.Header())
+		return estimate.Cost
 	}
 
-	return baseFee, nil
+	return feeoracle.ApplyMultiplier(estimate.Cost, srv.processingFeeMultiplier)
 }
@@ -7,4 +7,10 @@ func (srv *Server) configureRoutes() {
 	srv.echo.GET("/events", srv.GetEventsByAddress)
 	srv.echo.GET("/blockInfo", srv.GetBlockInfo)
 	srv.echo.GET("/recommendedProcessingFees", srv.GetRecommendedProcessingFees)
+	srv.echo.GET("/messages/:msgHash", srv.GetMessageStatus)
+	srv.echo.GET("/messages/bySrcTx/:txHash", srv.GetMessageStatusesBySrcTx)
+
+	if srv.recallableMessageRepo != nil {
+		srv.echo.GET("/recallableMessages", srv.GetRecallableMessages)
+	}
 }
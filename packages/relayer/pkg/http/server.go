@@ -7,11 +7,12 @@ import (
 	"net/http"
 	"os"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/taikoxyz/taiko-mono/packages/relayer"
-	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/taikol2"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/feeoracle"
 
 	echo "github.com/labstack/echo/v4"
 )
@@ -20,6 +21,7 @@ type ethClient interface {
 	BlockNumber(ctx context.Context) (uint64, error)
 	ChainID(ctx context.Context) (*big.Int, error)
 	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
 	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
 	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
 	TransactionSender(ctx context.Context,
@@ -50,7 +52,9 @@ type Server struct {
 	destEthClient           ethClient
 	destChainID             *big.Int
 	processingFeeMultiplier float64
-	taikoL2                 *taikol2.TaikoL2
+	srcFeeOracle            feeoracle.FeeOracle
+	destFeeOracle           feeoracle.FeeOracle
+	recallableMessageRepo   relayer.RecallableMessageRepository
 }
 
 type NewServerOpts struct {
@@ -60,7 +64,20 @@ type NewServerOpts struct {
 	SrcEthClient            ethClient
 	DestEthClient           ethClient
 	ProcessingFeeMultiplier float64
-	TaikoL2                 *taikol2.TaikoL2
+	FeeOracleMargin         float64
+	// TaikoL2 is the TaikoL2 contract of the destination chain, it should only be set when the
+	// destination chain is a Taiko L2, and HopEthClients are the clients of the intermediary chains.
+	// They describe the route like the processor configuration does, so that the processor accepts
+	// the recommended processing fees.
+	TaikoL2       feeoracle.TaikoL2
+	HopEthClients []feeoracle.BlockClient
+	// SrcFeeOracle and DestFeeOracle estimate the processing cost of messages sent to the
+	// source and destination chains, default oracles will be created if they are nil.
+	SrcFeeOracle  feeoracle.FeeOracle
+	DestFeeOracle feeoracle.FeeOracle
+	// RecallableMessageRepo serves the failed messages with prebuilt recall proofs,
+	// the recallable messages endpoint is disabled if it is nil.
+	RecallableMessageRepo relayer.RecallableMessageRepository
 }
 
 func (opts NewServerOpts) Validate() error {
@@ -108,9 +125,37 @@ func NewServer(opts NewServerOpts) (*Server, error) {
 		srcEthClient:            opts.SrcEthClient,
 		destEthClient:           opts.DestEthClient,
 		processingFeeMultiplier: opts.ProcessingFeeMultiplier,
-		taikoL2:                 opts.TaikoL2,
 		srcChainID:              srcChainID,
 		destChainID:             destChainID,
+		srcFeeOracle:            opts.SrcFeeOracle,
+		destFeeOracle:           opts.DestFeeOracle,
+		recallableMessageRepo:   opts.RecallableMessageRepo,
+	}
+
+	// messages sent back to the source chain are estimated without L1 data fee, like the
+	// processor of the reverse route does.
+	if srv.srcFeeOracle == nil {
+		if srv.srcFeeOracle, err = feeoracle.NewForRoute(feeoracle.RouteOpts{
+			SrcEthClient:  opts.DestEthClient,
+			DestEthClient: opts.SrcEthClient,
+			DestChainID:   srcChainID,
+			Margin:        opts.FeeOracleMargin,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if srv.destFeeOracle == nil {
+		if srv.destFeeOracle, err = feeoracle.NewForRoute(feeoracle.RouteOpts{
+			SrcEthClient:  opts.SrcEthClient,
+			DestEthClient: opts.DestEthClient,
+			DestChainID:   destChainID,
+			HopEthClients: opts.HopEthClients,
+			DestTaikoL2:   opts.TaikoL2,
+			Margin:        opts.FeeOracleMargin,
+		}); err != nil {
+			return nil, err
+		}
 	}
 
 	corsOrigins := opts.CorsOrigins
@@ -18,8 +18,9 @@ func newTestServer() *Server {
 	_ = godotenv.Load("../.test.env")
 
 	srv := &Server{
-		echo:      echo.New(),
-		eventRepo: mock.NewEventRepository(),
+		echo:                  echo.New(),
+		eventRepo:             mock.NewEventRepository(),
+		recallableMessageRepo: mock.NewRecallableMessageRepository(),
 	}
 
 	srv.configureMiddleware([]string{"*"})
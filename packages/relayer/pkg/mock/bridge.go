@@ -98,3 +98,10 @@ func (b *Bridge) IsMessageReceived(opts *bind.CallOpts, _message bridge.IBridgeM
 func (b *Bridge) Paused(opts *bind.CallOpts) (bool, error) {
 	return false, nil
 }
+
+func (b *Bridge) SignalForFailedMessage(opts *bind.CallOpts, _msgHash [32]byte) ([32]byte, error) {
+	signal := _msgHash
+	signal[31] ^= byte(relayer.EventStatusFailed)
+
+	return signal, nil
+}
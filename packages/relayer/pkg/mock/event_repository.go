@@ -6,6 +6,7 @@ import (
 	"errors"
 	"math/rand"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/morkid/paginate"
@@ -29,15 +30,18 @@ func (r *EventRepository) Close() error {
 
 func (r *EventRepository) Save(ctx context.Context, opts *relayer.SaveEventOpts) (*relayer.Event, error) {
 	r.events = append(r.events, &relayer.Event{
-		ID:           rand.Int(), // nolint: gosec
-		Data:         datatypes.JSON(opts.Data),
-		Status:       opts.Status,
-		ChainID:      opts.ChainID.Int64(),
-		DestChainID:  opts.DestChainID.Int64(),
-		Name:         opts.Name,
-		MessageOwner: opts.MessageOwner,
-		MsgHash:      opts.MsgHash,
-		EventType:    opts.EventType,
+		ID:             rand.Int(), // nolint: gosec
+		Data:           datatypes.JSON(opts.Data),
+		Status:         opts.Status,
+		ChainID:        opts.ChainID.Int64(),
+		DestChainID:    opts.DestChainID.Int64(),
+		Name:           opts.Name,
+		MessageOwner:   opts.MessageOwner,
+		MsgHash:        opts.MsgHash,
+		EventType:      opts.EventType,
+		Event:          opts.Event,
+		TxHash:         opts.TxHash,
+		EmittedBlockID: opts.EmittedBlockID,
 	})
 
 	return nil, nil
@@ -170,6 +174,68 @@ func (r *EventRepository) FirstByEventAndMsgHash(
 	return nil, nil
 }
 
+func (r *EventRepository) FindAllByMsgHash(
+	ctx context.Context,
+	msgHash string,
+) ([]*relayer.Event, error) {
+	events := make([]*relayer.Event, 0)
+
+	for _, e := range r.events {
+		if e.MsgHash == msgHash {
+			events = append(events, e)
+		}
+	}
+
+	return events, nil
+}
+
+func (r *EventRepository) FindAllByEventAndTxHash(
+	ctx context.Context,
+	event string,
+	txHash string,
+) ([]*relayer.Event, error) {
+	events := make([]*relayer.Event, 0)
+
+	for _, e := range r.events {
+		if e.TxHash == txHash && e.Event == event {
+			events = append(events, e)
+		}
+	}
+
+	return events, nil
+}
+
+func (r *EventRepository) FindAllMessageSentByLatestStatus(
+	ctx context.Context,
+	srcChainID uint64,
+	destChainID uint64,
+	status relayer.EventStatus,
+) ([]*relayer.Event, error) {
+	latest := make(map[string]relayer.EventStatus)
+
+	for _, e := range r.events {
+		if e.Event == relayer.EventNameMessageStatusChanged {
+			latest[e.MsgHash] = e.Status
+		}
+	}
+
+	events := make([]*relayer.Event, 0)
+
+	for _, e := range r.events {
+		if e.Event != relayer.EventNameMessageSent ||
+			e.ChainID != int64(srcChainID) ||
+			e.DestChainID != int64(destChainID) {
+			continue
+		}
+
+		if s, ok := latest[e.MsgHash]; ok && s == status {
+			events = append(events, e)
+		}
+	}
+
+	return events, nil
+}
+
 func (r *EventRepository) Delete(
 	ctx context.Context,
 	id int,
@@ -204,7 +270,28 @@ func (r *EventRepository) LatestChainDataSyncedEvent(
 }
 
 // DeleteAllAfterBlockID is used when a reorg is detected
-func (r *EventRepository) DeleteAllAfterBlockID(blockID uint64, srcChainID uint64, destChainID uint64) error {
+func (r *EventRepository) DeleteAllAfterBlockID(
+	ctx context.Context,
+	blockID uint64,
+	srcChainID uint64,
+	destChainID uint64,
+	events []string,
+) error {
+	remaining := make([]*relayer.Event, 0, len(r.events))
+
+	for _, e := range r.events {
+		if e.EmittedBlockID > blockID &&
+			e.ChainID == int64(srcChainID) &&
+			e.DestChainID == int64(destChainID) &&
+			slices.Contains(events, e.Event) {
+			continue
+		}
+
+		remaining = append(remaining, e)
+	}
+
+	r.events = remaining
+
 	return nil
 }
 
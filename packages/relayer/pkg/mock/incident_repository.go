@@ -0,0 +1,109 @@
+package mock
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"gorm.io/datatypes"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+type IncidentRepository struct {
+	mu        sync.Mutex
+	incidents []*relayer.Incident
+}
+
+func NewIncidentRepository() *IncidentRepository {
+	return &IncidentRepository{
+		incidents: make([]*relayer.Incident, 0),
+	}
+}
+
+func (r *IncidentRepository) Save(ctx context.Context, opts *relayer.SaveIncidentOpts) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now().UTC()
+
+	r.incidents = append(r.incidents, &relayer.Incident{
+		ID:           len(r.incidents) + 1,
+		IncidentID:   opts.IncidentID,
+		SrcChainID:   opts.SrcChainID,
+		DestChainID:  opts.DestChainID,
+		Invariant:    opts.Invariant,
+		ViolationKey: opts.ViolationKey,
+		Report:       datatypes.JSON(opts.Report),
+		Approvals:    datatypes.JSON("[]"),
+		Rejections:   datatypes.JSON("[]"),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	})
+
+	return nil
+}
+
+func (r *IncidentRepository) FindAllOpen(
+	ctx context.Context,
+	srcChainID uint64,
+	destChainID uint64,
+) ([]*relayer.Incident, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	incidents := make([]*relayer.Incident, 0)
+
+	for _, i := range r.incidents {
+		if i.SrcChainID == srcChainID && i.DestChainID == destChainID && i.Outcome == "" {
+			incident := *i
+			incidents = append(incidents, &incident)
+		}
+	}
+
+	return incidents, nil
+}
+
+func (r *IncidentRepository) UpdateSignatures(
+	ctx context.Context,
+	incidentID string,
+	opts *relayer.UpdateIncidentSignaturesOpts,
+) error {
+	approvals, err := json.Marshal(opts.Approvals)
+	if err != nil {
+		return err
+	}
+
+	rejections, err := json.Marshal(opts.Rejections)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, i := range r.incidents {
+		if i.IncidentID == incidentID {
+			i.Approvals = datatypes.JSON(approvals)
+			i.Rejections = datatypes.JSON(rejections)
+			i.UpdatedAt = time.Now().UTC()
+		}
+	}
+
+	return nil
+}
+
+func (r *IncidentRepository) UpdateOutcome(ctx context.Context, incidentID string, outcome string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, i := range r.incidents {
+		if i.IncidentID == incidentID {
+			i.Outcome = outcome
+			i.UpdatedAt = time.Now().UTC()
+		}
+	}
+
+	return nil
+}
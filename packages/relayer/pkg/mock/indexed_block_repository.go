@@ -0,0 +1,124 @@
+package mock
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+type IndexedBlockRepository struct {
+	mu     sync.Mutex
+	blocks []*relayer.IndexedBlock
+}
+
+func NewIndexedBlockRepository() *IndexedBlockRepository {
+	return &IndexedBlockRepository{
+		blocks: make([]*relayer.IndexedBlock, 0),
+	}
+}
+
+func (r *IndexedBlockRepository) Save(ctx context.Context, opts *relayer.SaveIndexedBlockOpts) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, b := range r.blocks {
+		if r.matches(b, opts.ChainID, opts.DestChainID, opts.EventName) && b.BlockID == opts.BlockID {
+			b.BlockHash = opts.BlockHash
+			b.ParentHash = opts.ParentHash
+
+			return nil
+		}
+	}
+
+	r.blocks = append(r.blocks, &relayer.IndexedBlock{
+		ID:          len(r.blocks) + 1,
+		ChainID:     opts.ChainID,
+		DestChainID: opts.DestChainID,
+		EventName:   opts.EventName,
+		BlockID:     opts.BlockID,
+		BlockHash:   opts.BlockHash,
+		ParentHash:  opts.ParentHash,
+		CreatedAt:   time.Now().UTC(),
+	})
+
+	return nil
+}
+
+func (r *IndexedBlockRepository) FindAll(
+	ctx context.Context,
+	chainID uint64,
+	destChainID uint64,
+	eventName string,
+) ([]*relayer.IndexedBlock, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	blocks := make([]*relayer.IndexedBlock, 0)
+
+	for _, b := range r.blocks {
+		if r.matches(b, chainID, destChainID, eventName) {
+			blocks = append(blocks, b)
+		}
+	}
+
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].BlockID > blocks[j].BlockID
+	})
+
+	return blocks, nil
+}
+
+func (r *IndexedBlockRepository) DeleteAfterBlockID(
+	ctx context.Context,
+	chainID uint64,
+	destChainID uint64,
+	eventName string,
+	blockID uint64,
+) error {
+	r.delete(func(b *relayer.IndexedBlock) bool {
+		return r.matches(b, chainID, destChainID, eventName) && b.BlockID > blockID
+	})
+
+	return nil
+}
+
+func (r *IndexedBlockRepository) DeleteBeforeBlockID(
+	ctx context.Context,
+	chainID uint64,
+	destChainID uint64,
+	eventName string,
+	blockID uint64,
+) error {
+	r.delete(func(b *relayer.IndexedBlock) bool {
+		return r.matches(b, chainID, destChainID, eventName) && b.BlockID < blockID
+	})
+
+	return nil
+}
+
+func (r *IndexedBlockRepository) matches(
+	b *relayer.IndexedBlock,
+	chainID uint64,
+	destChainID uint64,
+	eventName string,
+) bool {
+	return b.ChainID == chainID && b.DestChainID == destChainID && b.EventName == eventName
+}
+
+func (r *IndexedBlockRepository) delete(f func(b *relayer.IndexedBlock) bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	blocks := make([]*relayer.IndexedBlock, 0, len(r.blocks))
+
+	for _, b := range r.blocks {
+		if !f(b) {
+			blocks = append(blocks, b)
+		}
+	}
+
+	r.blocks = blocks
+}
@@ -11,6 +11,8 @@ import (
 )
 
 type TxManager struct {
+	// Closes is the number of times Close was called.
+	Closes int
 }
 
 func (t *TxManager) Send(ctx context.Context, candidate txmgr.TxCandidate) (*types.Receipt, error) {
@@ -30,11 +32,11 @@ func (t *TxManager) BlockNumber(ctx context.Context) (uint64, error) {
 
 // Close the underlying connection
 func (t *TxManager) Close() {
-
+	t.Closes++
 }
 
 func (t *TxManager) IsClosed() bool {
-	return false
+	return t.Closes > 0
 }
 
 func (t *TxManager) SendAsync(ctx context.Context, candidate txmgr.TxCandidate, ch chan txmgr.SendResponse) {
@@ -0,0 +1,114 @@
+package mock
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/morkid/paginate"
+	"gorm.io/datatypes"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+type RecallableMessageRepository struct {
+	mu       sync.Mutex
+	messages []*relayer.RecallableMessage
+}
+
+func NewRecallableMessageRepository() *RecallableMessageRepository {
+	return &RecallableMessageRepository{
+		messages: make([]*relayer.RecallableMessage, 0),
+	}
+}
+
+func (r *RecallableMessageRepository) Save(ctx context.Context, opts *relayer.SaveRecallableMessageOpts) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, m := range r.messages {
+		if m.MsgHash == opts.MsgHash {
+			return nil
+		}
+	}
+
+	now := time.Now().UTC()
+
+	r.messages = append(r.messages, &relayer.RecallableMessage{
+		ID:           len(r.messages) + 1,
+		MsgHash:      opts.MsgHash,
+		SrcChainID:   opts.SrcChainID,
+		DestChainID:  opts.DestChainID,
+		SrcOwner:     strings.ToLower(opts.SrcOwner),
+		Message:      datatypes.JSON(opts.Message),
+		Proof:        opts.Proof,
+		ProofBlockID: opts.ProofBlockID,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	})
+
+	return nil
+}
+
+func (r *RecallableMessageRepository) FindAllByAddress(
+	ctx context.Context,
+	req *http.Request,
+	opts relayer.FindAllRecallableMessagesOpts,
+) (*paginate.Page, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	messages := &[]relayer.RecallableMessage{}
+
+	for _, m := range r.messages {
+		if m.SrcOwner != strings.ToLower(opts.Address.Hex()) {
+			continue
+		}
+
+		if opts.SrcChainID != nil && m.SrcChainID != *opts.SrcChainID {
+			continue
+		}
+
+		*messages = append(*messages, *m)
+	}
+
+	return &paginate.Page{
+		Items: messages,
+	}, nil
+}
+
+func (r *RecallableMessageRepository) FindAllByRoute(
+	ctx context.Context,
+	srcChainID uint64,
+	destChainID uint64,
+) ([]*relayer.RecallableMessage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	messages := make([]*relayer.RecallableMessage, 0)
+
+	for _, m := range r.messages {
+		if m.SrcChainID == srcChainID && m.DestChainID == destChainID {
+			messages = append(messages, m)
+		}
+	}
+
+	return messages, nil
+}
+
+func (r *RecallableMessageRepository) DeleteByMsgHash(ctx context.Context, msgHash string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, m := range r.messages {
+		if m.MsgHash == msgHash {
+			r.messages = append(r.messages[:i], r.messages[i+1:]...)
+
+			return nil
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package mock
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+type WebhookRepository struct {
+	mu         sync.Mutex
+	webhooks   []*relayer.Webhook
+	deliveries []*relayer.WebhookDelivery
+}
+
+func NewWebhookRepository(webhooks ...*relayer.Webhook) *WebhookRepository {
+	return &WebhookRepository{
+		webhooks:   webhooks,
+		deliveries: make([]*relayer.WebhookDelivery, 0),
+	}
+}
+
+func (r *WebhookRepository) Save(ctx context.Context, opts *relayer.SaveWebhookOpts) (*relayer.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	w := &relayer.Webhook{
+		ID:      len(r.webhooks) + 1,
+		URL:     opts.URL,
+		Secret:  opts.Secret,
+		Address: opts.Address,
+		Token:   opts.Token,
+		Events:  opts.Events,
+		Enabled: true,
+	}
+
+	r.webhooks = append(r.webhooks, w)
+
+	return w, nil
+}
+
+func (r *WebhookRepository) FindAll(ctx context.Context) ([]*relayer.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	webhooks := make([]*relayer.Webhook, 0, len(r.webhooks))
+
+	return append(webhooks, r.webhooks...), nil
+}
+
+func (r *WebhookRepository) FindAllEnabled(ctx context.Context) ([]*relayer.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	webhooks := make([]*relayer.Webhook, 0)
+
+	for _, w := range r.webhooks {
+		if w.Enabled {
+			webhooks = append(webhooks, w)
+		}
+	}
+
+	return webhooks, nil
+}
+
+func (r *WebhookRepository) FirstByID(ctx context.Context, id int) (*relayer.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, w := range r.webhooks {
+		if w.ID == id {
+			return w, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *WebhookRepository) UpdateEnabled(ctx context.Context, id int, enabled bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, w := range r.webhooks {
+		if w.ID == id {
+			w.Enabled = enabled
+			return nil
+		}
+	}
+
+	return errors.New("webhook not found")
+}
+
+func (r *WebhookRepository) SaveDelivery(ctx context.Context, opts *relayer.SaveWebhookDeliveryOpts) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, d := range r.deliveries {
+		if d.WebhookID == opts.WebhookID && d.Event == opts.Event && d.MsgHash == opts.MsgHash {
+			return nil
+		}
+	}
+
+	r.deliveries = append(r.deliveries, &relayer.WebhookDelivery{
+		ID:            len(r.deliveries) + 1,
+		WebhookID:     opts.WebhookID,
+		Event:         opts.Event,
+		MsgHash:       opts.MsgHash,
+		Payload:       opts.Payload,
+		Status:        relayer.WebhookDeliveryStatusPending,
+		NextAttemptAt: time.Now(),
+	})
+
+	return nil
+}
+
+func (r *WebhookRepository) ClaimDueDeliveries(
+	ctx context.Context,
+	limit int,
+	lease time.Duration,
+) ([]*relayer.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deliveries := make([]*relayer.WebhookDelivery, 0)
+
+	now := time.Now()
+
+	for _, d := range r.deliveries {
+		if len(deliveries) == limit {
+			break
+		}
+
+		if d.Status == relayer.WebhookDeliveryStatusPending && !d.NextAttemptAt.After(now) {
+			d.NextAttemptAt = now.Add(lease)
+
+			claimed := *d
+			deliveries = append(deliveries, &claimed)
+		}
+	}
+
+	return deliveries, nil
+}
+
+func (r *WebhookRepository) UpdateDelivery(
+	ctx context.Context,
+	id int,
+	opts *relayer.UpdateWebhookDeliveryOpts,
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, d := range r.deliveries {
+		if d.ID == id {
+			d.Status = opts.Status
+			d.Attempts = opts.Attempts
+			d.ResponseStatus = opts.ResponseStatus
+			d.Error = opts.Error
+			d.NextAttemptAt = opts.NextAttemptAt
+		}
+	}
+
+	return nil
+}
+
+// Deliveries returns a copy of the delivery log.
+func (r *WebhookRepository) Deliveries() []relayer.WebhookDelivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deliveries := make([]relayer.WebhookDelivery, 0, len(r.deliveries))
+
+	for _, d := range r.deliveries {
+		deliveries = append(deliveries, *d)
+	}
+
+	return deliveries
+}
@@ -0,0 +1,22 @@
+package dbqueue
+
+import (
+	"testing"
+
+	"gorm.io/gorm"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db/dbtest"
+)
+
+// forEachDatabase runs the given test against each of the supported databases.
+func forEachDatabase(t *testing.T, f func(t *testing.T, db db.DB)) {
+	opts := dbtest.Opts{
+		Name:          "relayer",
+		MigrationsDir: "../../../migrations",
+	}
+
+	dbtest.ForEachDatabase(t, opts, func(t *testing.T, gormDB *gorm.DB) {
+		f(t, db.New(gormDB))
+	})
+}
@@ -0,0 +1,514 @@
+package dbqueue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+var (
+	errUnknownMessage = errors.New("unknown queue message")
+)
+
+// Dead-letter reasons.
+const (
+	reasonRejected = "rejected"
+	reasonExpired  = "expired"
+)
+
+// maxFetchSize is the max number of messages fetched in one poll.
+const maxFetchSize = 100
+
+// message is a row of the queue_messages table. A message is in flight until its visible_at,
+// after which it will be delivered again if it has not been acknowledged.
+type message struct {
+	ID            uint64 `gorm:"primaryKey"`
+	MessageID     string
+	QueueName     string
+	Body          []byte
+	Headers       string
+	ExpiresAt     *time.Time
+	VisibleAt     time.Time
+	DeliveryCount uint64
+	CreatedAt     time.Time
+}
+
+func (message) TableName() string {
+	return "queue_messages"
+}
+
+// deadLetter is a row of the queue_dead_letters table.
+type deadLetter struct {
+	ID        uint64 `gorm:"primaryKey"`
+	MessageID string
+	QueueName string
+	Body      []byte
+	Headers   string
+	Reason    string
+	CreatedAt time.Time
+}
+
+func (deadLetter) TableName() string {
+	return "queue_dead_letters"
+}
+
+// delivery identifies a delivered message, a redelivered message has a different delivery count,
+// so an acknowledgement of a stale delivery will be rejected.
+type delivery struct {
+	id            uint64
+	deliveryCount uint64
+}
+
+// Opts are the options of a DBQueue.
+type Opts struct {
+	PrefetchCount     uint64
+	PollInterval      time.Duration
+	VisibilityTimeout time.Duration
+}
+
+// DBQueue is a durable queue.Queue implementation on top of the relayer database. Consumers poll
+// the messages with `SELECT ... FOR UPDATE SKIP LOCKED`, a delivered message is invisible to
+// other consumers until it is acknowledged or its visibility timeout elapsed. The visibility
+// timeout of the messages in flight is extended while the consumer is alive. Messages negatively
+// acknowledged without requeue, and expired messages, are moved to the dead-letter table, except
+// the expired unprofitable messages which are routed back to the queue, the same as RabbitMQ.
+type DBQueue struct {
+	db        db.DB
+	opts      Opts
+	queueName string
+
+	mu      sync.Mutex
+	unacked map[uint64]delivery
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+// NewQueue creates a new DBQueue, the database connection is owned by the caller.
+func NewQueue(dbHandler db.DB, opts Opts) (*DBQueue, error) {
+	if dbHandler == nil {
+		return nil, db.ErrNoDB
+	}
+
+	if opts.PollInterval == 0 {
+		opts.PollInterval = time.Second
+	}
+
+	if opts.VisibilityTimeout == 0 {
+		opts.VisibilityTimeout = 5 * time.Minute
+	}
+
+	relayer.QueueConnectionInstantiated.Inc()
+
+	return &DBQueue{
+		db:      dbHandler,
+		opts:    opts,
+		unacked: make(map[uint64]delivery),
+		closed:  make(chan struct{}),
+	}, nil
+}
+
+func (q *DBQueue) Start(ctx context.Context, queueName string) error {
+	q.queueName = queueName
+
+	return nil
+}
+
+// Close makes all the unacknowledged messages visible again, as RabbitMQ requeues them
+// when a consumer's channel is closed.
+func (q *DBQueue) Close(ctx context.Context) {
+	q.closeOnce.Do(func() {
+		close(q.closed)
+
+		q.mu.Lock()
+		unacked := q.unacked
+		q.unacked = make(map[uint64]delivery)
+		q.mu.Unlock()
+
+		for _, d := range unacked {
+			if err := q.requeue(ctx, d); err != nil {
+				slog.Error("error requeueing db queue message", "id", d.id, "err", err.Error())
+			}
+		}
+	})
+}
+
+func (q *DBQueue) Publish(
+	ctx context.Context,
+	queueName string,
+	msg []byte,
+	headers map[string]interface{},
+	expiration *string,
+) error {
+	ttl, err := queue.ParseExpiration(expiration)
+	if err != nil {
+		relayer.QueueMessagePublishedErrors.Inc()
+
+		return err
+	}
+
+	// the messages of a delay queue expire after its delay, as with a queue-level TTL.
+	if bucket, ok := queue.DelayQueues(q.queueName)[queueName]; ok && ttl == 0 {
+		ttl = bucket
+	}
+
+	encodedHeaders, err := json.Marshal(headers)
+	if err != nil {
+		relayer.QueueMessagePublishedErrors.Inc()
+
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	m := &message{
+		MessageID: uuid.New().String(),
+		QueueName: queueName,
+		Body:      msg,
+		Headers:   string(encodedHeaders),
+		VisibleAt: now,
+		CreatedAt: now,
+	}
+
+	if ttl != 0 {
+		expiresAt := now.Add(ttl)
+		m.ExpiresAt = &expiresAt
+	}
+
+	if err := q.db.GormDB().WithContext(ctx).Create(m).Error; err != nil {
+		relayer.QueueMessagePublishedErrors.Inc()
+
+		return err
+	}
+
+	relayer.QueueMessagePublished.Inc()
+
+	return nil
+}
+
+func (q *DBQueue) Ack(ctx context.Context, msg queue.Message) error {
+	d, err := q.settle(msg)
+	if err != nil {
+		return err
+	}
+
+	res := q.db.GormDB().WithContext(ctx).
+		Where("id = ? AND delivery_count = ?", d.id, d.deliveryCount).
+		Delete(&message{})
+	if res.Error != nil {
+		slog.Error("error acknowledging db queue message", "err", res.Error.Error())
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return errUnknownMessage
+	}
+
+	relayer.QueueMessageAcknowledged.Inc()
+
+	return nil
+}
+
+func (q *DBQueue) Nack(ctx context.Context, msg queue.Message, requeue bool) error {
+	d, err := q.settle(msg)
+	if err != nil {
+		return err
+	}
+
+	if requeue {
+		err = q.requeue(ctx, d)
+	} else {
+		err = q.db.GormDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			var m message
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("id = ? AND delivery_count = ?", d.id, d.deliveryCount).
+				First(&m).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return errUnknownMessage
+				}
+
+				return err
+			}
+
+			return moveToDeadLetters(tx, &m, reasonRejected)
+		})
+	}
+
+	if err != nil {
+		slog.Error("error negatively acknowledging db queue message", "err", err.Error())
+		return err
+	}
+
+	relayer.QueueMessageNegativelyAcknowledged.Inc()
+
+	return nil
+}
+
+// Notify blocks until the given context is done, since there is no connection to be notified of.
+func (q *DBQueue) Notify(ctx context.Context, wg *sync.WaitGroup) error {
+	wg.Add(1)
+	defer wg.Done()
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-q.closed:
+		return queue.ErrClosed
+	}
+}
+
+// Subscribe should be called by consumers.
+func (q *DBQueue) Subscribe(ctx context.Context, msgChan chan<- queue.Message, wg *sync.WaitGroup) error {
+	wg.Add(1)
+	defer wg.Done()
+
+	slog.Info("subscribing to db queue messages", "queue", q.queueName)
+
+	ticker := time.NewTicker(q.opts.PollInterval)
+	defer ticker.Stop()
+
+	heartbeat := time.NewTicker(q.opts.VisibilityTimeout / 3)
+	defer heartbeat.Stop()
+
+	go q.heartbeatLoop(ctx, heartbeat.C)
+
+	for {
+		if err := q.expire(ctx); err != nil {
+			slog.Error("error expiring db queue messages", "err", err.Error())
+		}
+
+		msgs, err := q.fetch(ctx)
+		if err != nil {
+			slog.Error("error fetching db queue messages", "err", err.Error())
+		}
+
+		for _, m := range msgs {
+			select {
+			case msgChan <- queue.Message{
+				Body:     m.Body,
+				Internal: delivery{id: m.ID, deliveryCount: m.DeliveryCount},
+			}:
+			case <-ctx.Done():
+				return nil
+			case <-q.closed:
+				return queue.ErrClosed
+			}
+		}
+
+		// poll again immediately if there may be more messages.
+		if len(msgs) != 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-q.closed:
+			return queue.ErrClosed
+		case <-ticker.C:
+		}
+	}
+}
+
+// heartbeatLoop extends the visibility timeout of the messages in flight on every tick, so a
+// message taking longer than the visibility timeout to be processed is not delivered again.
+func (q *DBQueue) heartbeatLoop(ctx context.Context, ticks <-chan time.Time) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.closed:
+			return
+		case <-ticks:
+			if err := q.extend(ctx); err != nil {
+				slog.Error("error extending db queue messages visibility", "err", err.Error())
+			}
+		}
+	}
+}
+
+// extend makes the unacknowledged messages invisible until the visibility timeout from now. A
+// message whose visibility timeout already elapsed has been, or will be, delivered again, and
+// can not be extended since its delivery count changes on redelivery.
+func (q *DBQueue) extend(ctx context.Context) error {
+	q.mu.Lock()
+	unacked := make([]delivery, 0, len(q.unacked))
+
+	for _, d := range q.unacked {
+		unacked = append(unacked, d)
+	}
+	q.mu.Unlock()
+
+	now := time.Now().UTC()
+
+	for _, d := range unacked {
+		res := q.db.GormDB().WithContext(ctx).Model(&message{}).
+			Where("id = ? AND delivery_count = ? AND visible_at > ?", d.id, d.deliveryCount, now).
+			Update("visible_at", now.Add(q.opts.VisibilityTimeout))
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			slog.Warn("db queue message visibility timeout elapsed before its acknowledgement",
+				"id", d.id,
+				"deliveryCount", d.deliveryCount,
+			)
+		}
+	}
+
+	return nil
+}
+
+// fetch locks the next visible messages, and makes them invisible to other consumers until
+// the visibility timeout.
+func (q *DBQueue) fetch(ctx context.Context) ([]message, error) {
+	limit := maxFetchSize
+
+	q.mu.Lock()
+	if q.opts.PrefetchCount != 0 {
+		limit = int(q.opts.PrefetchCount) - len(q.unacked)
+	}
+	q.mu.Unlock()
+
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	var msgs []message
+
+	now := time.Now().UTC()
+
+	err := q.db.GormDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("queue_name = ? AND visible_at <= ?", q.queueName, now).
+			Where("expires_at IS NULL OR expires_at > ?", now).
+			Order("id ASC").
+			Limit(limit).
+			Find(&msgs).Error; err != nil {
+			return err
+		}
+
+		for i := range msgs {
+			msgs[i].VisibleAt = now.Add(q.opts.VisibilityTimeout)
+			msgs[i].DeliveryCount++
+
+			if err := tx.Model(&message{}).Where("id = ?", msgs[i].ID).Updates(map[string]interface{}{
+				"visible_at":     msgs[i].VisibleAt,
+				"delivery_count": msgs[i].DeliveryCount,
+			}).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	q.mu.Lock()
+	for _, m := range msgs {
+		q.unacked[m.ID] = delivery{id: m.ID, deliveryCount: m.DeliveryCount}
+	}
+	q.mu.Unlock()
+
+	return msgs, nil
+}
+
+// expire routes the expired messages which are not in flight: expired unprofitable and delayed
+// messages are routed back to the queue, and other expired messages are moved to the dead-letter
+// table.
+func (q *DBQueue) expire(ctx context.Context) error {
+	queueNames := []string{q.queueName, queue.UnprofitableQueueName(q.queueName)}
+
+	for delayQueueName := range queue.DelayQueues(q.queueName) {
+		queueNames = append(queueNames, delayQueueName)
+	}
+
+	now := time.Now().UTC()
+
+	return q.db.GormDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var msgs []message
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("queue_name IN ? AND visible_at <= ?", queueNames, now).
+			Where("expires_at <= ?", now).
+			Order("id ASC").
+			Limit(maxFetchSize).
+			Find(&msgs).Error; err != nil {
+			return err
+		}
+
+		for i := range msgs {
+			if msgs[i].QueueName == q.queueName {
+				if err := moveToDeadLetters(tx, &msgs[i], reasonExpired); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			if err := tx.Model(&message{}).Where("id = ?", msgs[i].ID).Updates(map[string]interface{}{
+				"queue_name": q.queueName,
+				"expires_at": nil,
+			}).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// settle removes the given message from the unacknowledged messages.
+func (q *DBQueue) settle(msg queue.Message) (delivery, error) {
+	d, ok := msg.Internal.(delivery)
+	if !ok {
+		return delivery{}, errUnknownMessage
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if _, ok := q.unacked[d.id]; !ok {
+		return delivery{}, errUnknownMessage
+	}
+
+	delete(q.unacked, d.id)
+
+	return d, nil
+}
+
+// requeue makes the given delivered message visible again.
+func (q *DBQueue) requeue(ctx context.Context, d delivery) error {
+	return q.db.GormDB().WithContext(ctx).Model(&message{}).
+		Where("id = ? AND delivery_count = ?", d.id, d.deliveryCount).
+		Update("visible_at", time.Now().UTC()).Error
+}
+
+// moveToDeadLetters moves the given message to the dead-letter table.
+func moveToDeadLetters(tx *gorm.DB, m *message, reason string) error {
+	if err := tx.Create(&deadLetter{
+		MessageID: m.MessageID,
+		QueueName: m.QueueName,
+		Body:      m.Body,
+		Headers:   m.Headers,
+		Reason:    reason,
+		CreatedAt: time.Now().UTC(),
+	}).Error; err != nil {
+		return err
+	}
+
+	return tx.Delete(&message{}, m.ID).Error
+}
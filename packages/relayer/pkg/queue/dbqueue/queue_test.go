@@ -0,0 +1,167 @@
+package dbqueue
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+var testQueueName = "messages"
+
+func newTestQueue(t *testing.T, db db.DB, prefetch uint64) *DBQueue {
+	q, err := NewQueue(db, Opts{
+		PrefetchCount:     prefetch,
+		PollInterval:      50 * time.Millisecond,
+		VisibilityTimeout: time.Minute,
+	})
+	assert.Nil(t, err)
+
+	assert.Nil(t, q.Start(context.Background(), testQueueName))
+
+	return q
+}
+
+func subscribe(t *testing.T, q *DBQueue) (chan queue.Message, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	msgs := make(chan queue.Message)
+
+	go func() {
+		_ = q.Subscribe(ctx, msgs, &sync.WaitGroup{})
+	}()
+
+	return msgs, cancel
+}
+
+func receive(t *testing.T, msgs <-chan queue.Message) queue.Message {
+	select {
+	case msg := <-msgs:
+		return msg
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for message")
+	}
+
+	return queue.Message{}
+}
+
+func countRows(t *testing.T, q *DBQueue, model interface{}) int64 {
+	var count int64
+
+	assert.Nil(t, q.db.GormDB().Model(model).Count(&count).Error)
+
+	return count
+}
+
+func Test_DBQueue(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		q := newTestQueue(t, db, 1)
+
+		ctx := context.Background()
+
+		msgs, cancel := subscribe(t, q)
+		defer cancel()
+
+		t.Run("publishAck", func(t *testing.T) {
+			assert.Nil(t, q.Publish(ctx, testQueueName, []byte("hello"), nil, nil))
+
+			msg := receive(t, msgs)
+			assert.Equal(t, []byte("hello"), msg.Body)
+
+			assert.Nil(t, q.Ack(ctx, msg))
+			assert.NotNil(t, q.Ack(ctx, msg))
+			assert.Equal(t, int64(0), countRows(t, q, &message{}))
+		})
+
+		t.Run("nackRequeue", func(t *testing.T) {
+			assert.Nil(t, q.Publish(ctx, testQueueName, []byte("retry"), nil, nil))
+
+			msg := receive(t, msgs)
+			assert.Nil(t, q.Nack(ctx, msg, true))
+
+			msg = receive(t, msgs)
+			assert.Equal(t, []byte("retry"), msg.Body)
+			assert.Equal(t, uint64(2), msg.Internal.(delivery).deliveryCount)
+
+			assert.Nil(t, q.Ack(ctx, msg))
+		})
+
+		t.Run("nackDeadLetter", func(t *testing.T) {
+			assert.Nil(t, q.Publish(ctx, testQueueName, []byte("rejected"), nil, nil))
+
+			msg := receive(t, msgs)
+			assert.Nil(t, q.Nack(ctx, msg, false))
+
+			var dl deadLetter
+
+			assert.Nil(t, q.db.GormDB().Where("reason = ?", reasonRejected).First(&dl).Error)
+			assert.Equal(t, []byte("rejected"), dl.Body)
+			assert.Equal(t, testQueueName, dl.QueueName)
+			assert.Equal(t, int64(0), countRows(t, q, &message{}))
+		})
+
+		t.Run("unprofitableExpiration", func(t *testing.T) {
+			expiration := "100"
+
+			assert.Nil(t, q.Publish(
+				ctx,
+				queue.UnprofitableQueueName(testQueueName),
+				[]byte("unprofitable"),
+				nil,
+				&expiration,
+			))
+
+			msg := receive(t, msgs)
+			assert.Equal(t, []byte("unprofitable"), msg.Body)
+
+			assert.Nil(t, q.Ack(ctx, msg))
+		})
+
+		t.Run("close", func(t *testing.T) {
+			assert.Nil(t, q.Publish(ctx, testQueueName, []byte("unacked"), nil, nil))
+
+			receive(t, msgs)
+			cancel()
+			q.Close(ctx)
+
+			var m message
+
+			assert.Nil(t, q.db.GormDB().First(&m).Error)
+			assert.False(t, m.VisibleAt.After(time.Now().UTC()))
+		})
+	})
+}
+
+func Test_DBQueue_heartbeat(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		q, err := NewQueue(db, Opts{
+			PollInterval:      50 * time.Millisecond,
+			VisibilityTimeout: 300 * time.Millisecond,
+		})
+		assert.Nil(t, err)
+
+		ctx := context.Background()
+
+		assert.Nil(t, q.Start(ctx, testQueueName))
+
+		msgs, cancel := subscribe(t, q)
+		defer cancel()
+
+		assert.Nil(t, q.Publish(ctx, testQueueName, []byte("slow"), nil, nil))
+
+		msg := receive(t, msgs)
+
+		// the message is processed for longer than the visibility timeout.
+		select {
+		case <-msgs:
+			t.Fatal("message delivered again while in flight")
+		case <-time.After(time.Second):
+		}
+
+		assert.Nil(t, q.Ack(ctx, msg))
+	})
+}
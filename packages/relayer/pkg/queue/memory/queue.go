@@ -0,0 +1,391 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+var (
+	errUnknownMessage = errors.New("unknown queue message")
+)
+
+// expirationCheckInterval is how often the expired messages are routed to their dead-letter queues.
+var expirationCheckInterval = 100 * time.Millisecond
+
+// defaultBroker is shared by all the in-process queues, so the indexers and processors running
+// in the same process can publish to and consume from the same queues.
+var defaultBroker = newBroker()
+
+// delivery is a message in an in-process queue.
+type delivery struct {
+	id        string
+	queueName string
+	body      []byte
+	headers   map[string]interface{}
+	expiresAt time.Time
+}
+
+// memQueue is a named in-process queue, with the queue where its expired and
+// negatively acknowledged messages are routed to, and the TTL of its messages if any.
+type memQueue struct {
+	deadLetter string
+	ttl        time.Duration
+	messages   []*delivery
+}
+
+// broker holds all the in-process queues.
+type broker struct {
+	mu      sync.Mutex
+	queues  map[string]*memQueue
+	changed chan struct{}
+}
+
+func newBroker() *broker {
+	return &broker{
+		queues:  make(map[string]*memQueue),
+		changed: make(chan struct{}),
+	}
+}
+
+// getQueue returns the queue with the given name, creating it if it does not exist. Must be called
+// with the lock held.
+func (b *broker) getQueue(name string) *memQueue {
+	q, ok := b.queues[name]
+	if !ok {
+		q = &memQueue{}
+		b.queues[name] = q
+	}
+
+	return q
+}
+
+// notify wakes up all the subscribers, must be called with the lock held.
+func (b *broker) notify() {
+	close(b.changed)
+	b.changed = make(chan struct{})
+}
+
+// declare declares the given queue with the same routing as the RabbitMQ queues: expired and
+// negatively acknowledged messages are routed to the dead-letter queue, and expired unprofitable
+// and delayed messages are routed back to the given queue.
+func (b *broker) declare(queueName string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.getQueue(queueName).deadLetter = queue.DeadLetterQueueName(queueName)
+	b.getQueue(queue.UnprofitableQueueName(queueName)).deadLetter = queueName
+	b.getQueue(queue.DeadLetterQueueName(queueName)).deadLetter = queueName
+
+	for delayQueueName, bucket := range queue.DelayQueues(queueName) {
+		q := b.getQueue(delayQueueName)
+		q.deadLetter = queueName
+		q.ttl = bucket
+	}
+}
+
+// push appends the given message to its queue, or prepends it if front is true.
+func (b *broker) push(d *delivery, front bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	q := b.getQueue(d.queueName)
+
+	if q.ttl != 0 && d.expiresAt.IsZero() {
+		d.expiresAt = time.Now().Add(q.ttl)
+	}
+
+	if front {
+		q.messages = append([]*delivery{d}, q.messages...)
+	} else {
+		q.messages = append(q.messages, d)
+	}
+
+	b.notify()
+}
+
+// deadLetter routes the given message to the dead-letter queue of its queue, the message
+// is dropped if its queue has no dead-letter queue.
+func (b *broker) deadLetter(d *delivery) {
+	b.mu.Lock()
+	deadLetter := b.getQueue(d.queueName).deadLetter
+	b.mu.Unlock()
+
+	if deadLetter == "" {
+		slog.Warn("dropping in-process queue message without dead-letter queue",
+			"queue", d.queueName,
+			"msgId", d.id,
+		)
+		return
+	}
+
+	b.push(&delivery{id: d.id, queueName: deadLetter, body: d.body, headers: d.headers}, false)
+}
+
+// expire routes all the expired messages to their dead-letter queues.
+func (b *broker) expire(now time.Time) {
+	var expired []*delivery
+
+	b.mu.Lock()
+
+	for _, q := range b.queues {
+		remaining := q.messages[:0]
+
+		for _, d := range q.messages {
+			if !d.expiresAt.IsZero() && !now.Before(d.expiresAt) {
+				expired = append(expired, d)
+			} else {
+				remaining = append(remaining, d)
+			}
+		}
+
+		q.messages = remaining
+	}
+
+	b.mu.Unlock()
+
+	for _, d := range expired {
+		b.deadLetter(d)
+	}
+}
+
+// pop removes and returns the first message of the given queue, and a channel which will be
+// closed once the queues are changed.
+func (b *broker) pop(queueName string) (*delivery, <-chan struct{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	q := b.getQueue(queueName)
+	if len(q.messages) == 0 {
+		return nil, b.changed
+	}
+
+	d := q.messages[0]
+	q.messages = q.messages[1:]
+
+	return d, b.changed
+}
+
+// Memory is an in-process queue.Queue implementation, which is useful for all-in-one deployments
+// and tests, all messages will be lost once the process exits.
+type Memory struct {
+	broker    *broker
+	queueName string
+	prefetch  uint64
+
+	mu        sync.Mutex
+	unacked   map[string]*delivery
+	unackedCh chan struct{}
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+// NewQueue creates a new in-process queue, all in-process queues of the same
+// process share their messages.
+func NewQueue(opts queue.NewQueueOpts) *Memory {
+	return newQueue(defaultBroker, opts)
+}
+
+func newQueue(b *broker, opts queue.NewQueueOpts) *Memory {
+	relayer.QueueConnectionInstantiated.Inc()
+
+	return &Memory{
+		broker:    b,
+		prefetch:  opts.PrefetchCount,
+		unacked:   make(map[string]*delivery),
+		unackedCh: make(chan struct{}, 1),
+		closed:    make(chan struct{}),
+	}
+}
+
+func (m *Memory) Start(ctx context.Context, queueName string) error {
+	m.broker.declare(queueName)
+	m.queueName = queueName
+
+	return nil
+}
+
+func (m *Memory) Close(ctx context.Context) {
+	m.closeOnce.Do(func() {
+		close(m.closed)
+		m.requeueUnacked()
+	})
+}
+
+func (m *Memory) Publish(
+	ctx context.Context,
+	queueName string,
+	msg []byte,
+	headers map[string]interface{},
+	expiration *string,
+) error {
+	ttl, err := queue.ParseExpiration(expiration)
+	if err != nil {
+		relayer.QueueMessagePublishedErrors.Inc()
+
+		return err
+	}
+
+	d := &delivery{
+		id:        uuid.New().String(),
+		queueName: queueName,
+		body:      msg,
+		headers:   headers,
+	}
+
+	if ttl != 0 {
+		d.expiresAt = time.Now().Add(ttl)
+	}
+
+	m.broker.push(d, false)
+
+	relayer.QueueMessagePublished.Inc()
+
+	return nil
+}
+
+func (m *Memory) Ack(ctx context.Context, msg queue.Message) error {
+	if _, err := m.settle(msg); err != nil {
+		return err
+	}
+
+	relayer.QueueMessageAcknowledged.Inc()
+
+	return nil
+}
+
+func (m *Memory) Nack(ctx context.Context, msg queue.Message, requeue bool) error {
+	d, err := m.settle(msg)
+	if err != nil {
+		return err
+	}
+
+	// like RabbitMQ, requeued messages keep their position at the head of the queue, others
+	// are routed to the dead-letter queue.
+	if requeue {
+		m.broker.push(d, true)
+	} else {
+		m.broker.deadLetter(d)
+	}
+
+	relayer.QueueMessageNegativelyAcknowledged.Inc()
+
+	return nil
+}
+
+// settle removes the given message from the unacknowledged messages.
+func (m *Memory) settle(msg queue.Message) (*delivery, error) {
+	d, ok := msg.Internal.(*delivery)
+	if !ok {
+		return nil, errUnknownMessage
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.unacked[d.id]; !ok {
+		return nil, errUnknownMessage
+	}
+
+	delete(m.unacked, d.id)
+
+	select {
+	case m.unackedCh <- struct{}{}:
+	default:
+	}
+
+	return d, nil
+}
+
+// Notify blocks until the given context is done, since there is no connection to be notified of.
+func (m *Memory) Notify(ctx context.Context, wg *sync.WaitGroup) error {
+	wg.Add(1)
+	defer wg.Done()
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-m.closed:
+		return queue.ErrClosed
+	}
+}
+
+// Subscribe should be called by consumers.
+func (m *Memory) Subscribe(ctx context.Context, msgChan chan<- queue.Message, wg *sync.WaitGroup) error {
+	wg.Add(1)
+	defer wg.Done()
+
+	slog.Info("subscribing to in-process queue messages", "queue", m.queueName)
+
+	ticker := time.NewTicker(expirationCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		m.broker.expire(time.Now())
+
+		var (
+			d       *delivery
+			changed <-chan struct{}
+		)
+
+		if m.canDeliver() {
+			d, changed = m.broker.pop(m.queueName)
+		}
+
+		if d == nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-m.closed:
+				return queue.ErrClosed
+			case <-changed:
+			case <-m.unackedCh:
+			case <-ticker.C:
+			}
+
+			continue
+		}
+
+		m.mu.Lock()
+		m.unacked[d.id] = d
+		m.mu.Unlock()
+
+		select {
+		case msgChan <- queue.Message{Body: d.body, Internal: d}:
+		case <-ctx.Done():
+			m.requeueUnacked()
+			return nil
+		case <-m.closed:
+			m.requeueUnacked()
+			return queue.ErrClosed
+		}
+	}
+}
+
+// requeueUnacked requeues all the unacknowledged messages, as RabbitMQ does when a consumer's
+// channel is closed.
+func (m *Memory) requeueUnacked() {
+	m.mu.Lock()
+	unacked := m.unacked
+	m.unacked = make(map[string]*delivery)
+	m.mu.Unlock()
+
+	for _, d := range unacked {
+		m.broker.push(d, true)
+	}
+}
+
+// canDeliver checks whether the number of unacknowledged messages is below the prefetch count.
+func (m *Memory) canDeliver() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.prefetch == 0 || uint64(len(m.unacked)) < m.prefetch
+}
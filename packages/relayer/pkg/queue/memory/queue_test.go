@@ -0,0 +1,151 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+func newTestQueue(t *testing.T, prefetch uint64) (*Memory, chan queue.Message) {
+	q := newQueue(newBroker(), queue.NewQueueOpts{PrefetchCount: prefetch})
+	assert.Nil(t, q.Start(context.Background(), "test"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var wg sync.WaitGroup
+
+	msgs := make(chan queue.Message)
+
+	go func() {
+		_ = q.Subscribe(ctx, msgs, &wg)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		wg.Wait()
+	})
+
+	return q, msgs
+}
+
+func receive(t *testing.T, msgs <-chan queue.Message) queue.Message {
+	select {
+	case msg := <-msgs:
+		return msg
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for queue message")
+	}
+
+	return queue.Message{}
+}
+
+func assertNoMessage(t *testing.T, msgs <-chan queue.Message) {
+	select {
+	case msg := <-msgs:
+		t.Fatalf("unexpected queue message: %s", msg.Body)
+	case <-time.After(300 * time.Millisecond):
+	}
+}
+
+func Test_PublishAck(t *testing.T) {
+	q, msgs := newTestQueue(t, 0)
+
+	assert.Nil(t, q.Publish(context.Background(), "test", []byte("1"), nil, nil))
+	assert.Nil(t, q.Publish(context.Background(), "test", []byte("2"), nil, nil))
+
+	msg := receive(t, msgs)
+	assert.Equal(t, []byte("1"), msg.Body)
+	assert.Nil(t, q.Ack(context.Background(), msg))
+	assert.Equal(t, errUnknownMessage, q.Ack(context.Background(), msg))
+
+	assert.Equal(t, []byte("2"), receive(t, msgs).Body)
+}
+
+func Test_Prefetch(t *testing.T) {
+	q, msgs := newTestQueue(t, 1)
+
+	assert.Nil(t, q.Publish(context.Background(), "test", []byte("1"), nil, nil))
+	assert.Nil(t, q.Publish(context.Background(), "test", []byte("2"), nil, nil))
+
+	msg := receive(t, msgs)
+	assertNoMessage(t, msgs)
+
+	assert.Nil(t, q.Ack(context.Background(), msg))
+	assert.Equal(t, []byte("2"), receive(t, msgs).Body)
+}
+
+func Test_Nack(t *testing.T) {
+	q, msgs := newTestQueue(t, 0)
+
+	assert.Nil(t, q.Publish(context.Background(), "test", []byte("1"), nil, nil))
+
+	// requeued messages are delivered again.
+	msg := receive(t, msgs)
+	assert.Nil(t, q.Nack(context.Background(), msg, true))
+
+	msg = receive(t, msgs)
+	assert.Equal(t, []byte("1"), msg.Body)
+
+	// others are routed to the dead-letter queue.
+	assert.Nil(t, q.Nack(context.Background(), msg, false))
+	assertNoMessage(t, msgs)
+
+	q.broker.mu.Lock()
+	defer q.broker.mu.Unlock()
+
+	assert.Len(t, q.broker.queues[queue.DeadLetterQueueName("test")].messages, 1)
+}
+
+func Test_UnprofitableExpiration(t *testing.T) {
+	q, msgs := newTestQueue(t, 0)
+
+	expiration := "200"
+	assert.Nil(t, q.Publish(
+		context.Background(),
+		queue.UnprofitableQueueName("test"),
+		[]byte("1"),
+		map[string]interface{}{"retries": int64(1)},
+		&expiration,
+	))
+
+	// expired unprofitable messages are routed back to the queue.
+	msg := receive(t, msgs)
+	assert.Equal(t, []byte("1"), msg.Body)
+	assert.True(t, msg.Internal.(*delivery).expiresAt.IsZero())
+}
+
+func Test_DelayQueue(t *testing.T) {
+	q, _ := newTestQueue(t, 0)
+
+	delayQueueName := queue.DelayQueueName("test", 5*time.Minute)
+
+	assert.Nil(t, q.Publish(context.Background(), delayQueueName, []byte("1"), nil, nil))
+
+	q.broker.mu.Lock()
+	defer q.broker.mu.Unlock()
+
+	// delayed messages expire after the delay of their queue, and are routed back to the queue.
+	delayQueue := q.broker.queues[delayQueueName]
+	assert.Equal(t, "test", delayQueue.deadLetter)
+	assert.Len(t, delayQueue.messages, 1)
+	assert.WithinDuration(t, time.Now().Add(5*time.Minute), delayQueue.messages[0].expiresAt, time.Second)
+}
+
+func Test_Close(t *testing.T) {
+	q, msgs := newTestQueue(t, 0)
+
+	assert.Nil(t, q.Publish(context.Background(), "test", []byte("1"), nil, nil))
+	receive(t, msgs)
+
+	// unacknowledged messages are requeued once the queue is closed.
+	q.Close(context.Background())
+
+	q.broker.mu.Lock()
+	defer q.broker.mu.Unlock()
+
+	assert.Len(t, q.broker.queues["test"].messages, 1)
+}
@@ -3,7 +3,10 @@ package queue
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
 )
@@ -12,6 +15,13 @@ var (
 	ErrClosed = errors.New("queue connection closed")
 )
 
+// Queue backends.
+const (
+	TypeRabbitMQ = "rabbitmq"
+	TypeMemory   = "memory"
+	TypeDB       = "db"
+)
+
 type Queue interface {
 	Start(ctx context.Context, queueName string) error
 	Close(ctx context.Context)
@@ -51,3 +61,73 @@ type NewQueueOpts struct {
 	Port          string
 	PrefetchCount uint64
 }
+
+// UnprofitableQueueName returns the name of the queue where unprofitable messages of the given
+// queue wait for their expiration, after which they are routed back to the given queue.
+func UnprofitableQueueName(queueName string) string {
+	return fmt.Sprintf("%v-unprofitable", queueName)
+}
+
+// DelayBuckets are the delays of the delay queues of a queue, in increasing order. Each delay
+// queue has a queue-level TTL, so its messages expire in order, unlike per-message expirations
+// which RabbitMQ only applies at the head of a queue.
+var DelayBuckets = []time.Duration{
+	time.Minute,
+	5 * time.Minute,
+	15 * time.Minute,
+	30 * time.Minute,
+	time.Hour,
+	2 * time.Hour,
+	4 * time.Hour,
+	8 * time.Hour,
+	24 * time.Hour,
+}
+
+// DelayQueueName returns the name of the delay queue of the given queue and delay bucket, where
+// delayed messages wait for the bucket delay, after which they are routed back to the given queue.
+func DelayQueueName(queueName string, bucket time.Duration) string {
+	return fmt.Sprintf("%v-delay-%vs", queueName, int64(bucket.Seconds()))
+}
+
+// DelayBucket returns the shortest delay bucket which is at least the given delay, or the longest
+// bucket, the message is delayed again once it is routed back if it is still not ready.
+func DelayBucket(delay time.Duration) time.Duration {
+	for _, bucket := range DelayBuckets {
+		if bucket >= delay {
+			return bucket
+		}
+	}
+
+	return DelayBuckets[len(DelayBuckets)-1]
+}
+
+// DelayQueues returns the delay buckets of the given queue by the name of their delay queue.
+func DelayQueues(queueName string) map[string]time.Duration {
+	queues := make(map[string]time.Duration, len(DelayBuckets))
+
+	for _, bucket := range DelayBuckets {
+		queues[DelayQueueName(queueName, bucket)] = bucket
+	}
+
+	return queues
+}
+
+// DeadLetterQueueName returns the name of the queue where the negatively acknowledged messages
+// of the given queue without requeue, and its expired messages, are routed to.
+func DeadLetterQueueName(queueName string) string {
+	return fmt.Sprintf("dlx-%v", queueName)
+}
+
+// ParseExpiration parses a per-message expiration, which is in milliseconds as RabbitMQ does.
+func ParseExpiration(expiration *string) (time.Duration, error) {
+	if expiration == nil || *expiration == "" {
+		return 0, nil
+	}
+
+	ms, err := strconv.ParseUint(*expiration, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid message expiration %v: %w", *expiration, err)
+	}
+
+	return time.Duration(ms) * time.Millisecond, nil
+}
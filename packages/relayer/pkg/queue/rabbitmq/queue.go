@@ -98,7 +98,7 @@ func (r *RabbitMQ) connect() error {
 }
 
 func (r *RabbitMQ) Start(ctx context.Context, queueName string) error {
-	dlxQueue := fmt.Sprintf("dlx-%v", queueName)
+	dlxQueue := queue.DeadLetterQueueName(queueName)
 
 	exchange := "messages"
 
@@ -106,7 +106,7 @@ func (r *RabbitMQ) Start(ctx context.Context, queueName string) error {
 
 	routingKey := fmt.Sprintf("%v-process", queueName)
 
-	routingKeyUnprofitable := fmt.Sprintf("%v-unprofitable", queueName)
+	routingKeyUnprofitable := queue.UnprofitableQueueName(queueName)
 
 	slog.Info("declaring rabbitmq dlx exchange", "exchange", dlxExchange)
 
@@ -217,6 +217,27 @@ func (r *RabbitMQ) Start(ctx context.Context, queueName string) error {
 		return err
 	}
 
+	// the delay queues are declared the same way, with a queue-level TTL so their messages
+	// expire in the order they were published.
+	for delayQueueName, bucket := range queue.DelayQueues(queueName) {
+		slog.Info("declaring rabbitmq delay queue", "queue", delayQueueName, "delay", bucket)
+
+		if _, err := r.ch.QueueDeclare(
+			delayQueueName,
+			true,
+			false,
+			false,
+			false,
+			amqp.Table{
+				"x-dead-letter-exchange":    exchange,
+				"x-dead-letter-routing-key": routingKey,
+				"x-message-ttl":             bucket.Milliseconds(),
+			},
+		); err != nil {
+			return err
+		}
+	}
+
 	r.queue = q
 
 	r.unprofitableQueue = unprofitableQueue
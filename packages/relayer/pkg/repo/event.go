@@ -60,6 +60,7 @@ func (r *EventRepository) Save(ctx context.Context, opts *relayer.SaveEventOpts)
 		SyncedInBlockID:        opts.SyncedInBlockID,
 		BlockID:                opts.BlockID,
 		EmittedBlockID:         opts.EmittedBlockID,
+		TxHash:                 opts.TxHash,
 	}
 
 	if err := r.db.GormDB().WithContext(ctx).Create(e).Error; err != nil {
@@ -165,6 +166,72 @@ func (r *EventRepository) FirstByEventAndMsgHash(
 	return e, nil
 }
 
+// FindAllByMsgHash returns all the events of the given msgHash, in the order they were indexed.
+func (r *EventRepository) FindAllByMsgHash(
+	ctx context.Context,
+	msgHash string,
+) ([]*relayer.Event, error) {
+	events := make([]*relayer.Event, 0)
+
+	if err := r.db.GormDB().WithContext(ctx).Where("msg_hash = ?", msgHash).
+		Order("id ASC").
+		Find(&events).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return events, nil
+}
+
+// FindAllByEventAndTxHash returns all the events with the given name emitted in the given transaction.
+func (r *EventRepository) FindAllByEventAndTxHash(
+	ctx context.Context,
+	event string,
+	txHash string,
+) ([]*relayer.Event, error) {
+	events := make([]*relayer.Event, 0)
+
+	if err := r.db.GormDB().WithContext(ctx).Where("tx_hash = ?", txHash).
+		Where("event = ?", event).
+		Order("id ASC").
+		Find(&events).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return events, nil
+}
+
+// FindAllMessageSentByLatestStatus returns the MessageSent events of the given route, whose latest
+// indexed MessageStatusChanged event has the given status.
+func (r *EventRepository) FindAllMessageSentByLatestStatus(
+	ctx context.Context,
+	srcChainID uint64,
+	destChainID uint64,
+	status relayer.EventStatus,
+) ([]*relayer.Event, error) {
+	latest := r.db.GormDB().Model(&relayer.Event{}).
+		Select("MAX(id)").
+		Where("event = ?", relayer.EventNameMessageStatusChanged).
+		Group("msg_hash")
+
+	msgHashes := r.db.GormDB().Model(&relayer.Event{}).
+		Select("msg_hash").
+		Where("id IN (?)", latest).
+		Where("status = ?", status)
+
+	events := make([]*relayer.Event, 0)
+
+	if err := r.db.GormDB().WithContext(ctx).
+		Where("event = ?", relayer.EventNameMessageSent).
+		Where("chain_id = ? AND dest_chain_id = ?", srcChainID, destChainID).
+		Where("msg_hash IN (?)", msgHashes).
+		Order("id ASC").
+		Find(&events).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return events, nil
+}
+
 func (r *EventRepository) FindAllByAddress(
 	ctx context.Context,
 	req *http.Request,
@@ -262,13 +329,25 @@ func (r *EventRepository) LatestChainDataSyncedEvent(
 	return uint64(blockID), nil
 }
 
-// DeleteAllAfterBlockID is used when a reorg is detected
-func (r *EventRepository) DeleteAllAfterBlockID(blockID uint64, srcChainID uint64, destChainID uint64) error {
-	query := `
-DELETE FROM events
-WHERE block_id >= ? AND chain_id = ? AND dest_chain_id = ?`
+// DeleteAllAfterBlockID is used when a reorg is detected, it deletes the given events
+// emitted after the common ancestor block of the reorg.
+func (r *EventRepository) DeleteAllAfterBlockID(
+	ctx context.Context,
+	blockID uint64,
+	srcChainID uint64,
+	destChainID uint64,
+	events []string,
+) error {
+	if err := r.db.GormDB().WithContext(ctx).
+		Where("emitted_block_id > ?", blockID).
+		Where("chain_id = ?", srcChainID).
+		Where("dest_chain_id = ?", destChainID).
+		Where("event IN ?", events).
+		Delete(&relayer.Event{}).Error; err != nil {
+		return errors.Wrap(err, "r.db.Delete")
+	}
 
-	return r.db.GormDB().Table("events").Exec(query, blockID, srcChainID, destChainID).Error
+	return nil
 }
 
 // GetLatestBlockID get latest block id
@@ -278,13 +357,14 @@ func (r *EventRepository) FindLatestBlockID(
 	srcChainID uint64,
 	destChainID uint64,
 ) (uint64, error) {
-	q := `SELECT COALESCE(MAX(emitted_block_id), 0)
-	FROM events WHERE chain_id = ? AND dest_chain_id = ? AND event = ?`
-
 	var b uint64
 
 	if err := r.db.GormDB().WithContext(ctx).Table("events").
-		Raw(q, srcChainID, destChainID, event).Scan(&b).Error; err != nil {
+		Where("chain_id = ?", srcChainID).
+		Where("dest_chain_id = ?", destChainID).
+		Where("event = ?", event).
+		Select("COALESCE(MAX(emitted_block_id), 0)").
+		Scan(&b).Error; err != nil {
 		return 0, err
 	}
 
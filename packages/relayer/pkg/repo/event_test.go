@@ -110,399 +110,585 @@ func Test_NewEventRepo(t *testing.T) {
 }
 
 func TestIntegration_Event_Save(t *testing.T) {
-	db, close, err := testMysql(t)
-	assert.Equal(t, nil, err)
-
-	defer close()
-
-	eventRepo, err := NewEventRepository(db)
-	assert.Equal(t, nil, err)
-	tests := []struct {
-		name    string
-		opts    *relayer.SaveEventOpts
-		wantErr error
-	}{
-		{
-			"success",
-			&relayer.SaveEventOpts{
-				Name:                   "test",
-				ChainID:                big.NewInt(1),
-				DestChainID:            big.NewInt(2),
-				Data:                   "{\"data\":\"something\"}",
-				EventType:              relayer.EventType(relayer.EventTypeSendETH),
-				CanonicalTokenAddress:  "0x1",
-				CanonicalTokenSymbol:   "ETH",
-				CanonicalTokenName:     "Ethereum",
-				CanonicalTokenDecimals: 18,
-				Amount:                 "1",
-				MsgHash:                "0x1",
-				MessageOwner:           "0x1",
-				Event:                  relayer.EventNameMessageSent,
-				EmittedBlockID:         1,
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		eventRepo, err := NewEventRepository(db)
+		assert.Equal(t, nil, err)
+		tests := []struct {
+			name    string
+			opts    *relayer.SaveEventOpts
+			wantErr error
+		}{
+			{
+				"success",
+				&relayer.SaveEventOpts{
+					Name:                   "test",
+					ChainID:                big.NewInt(1),
+					DestChainID:            big.NewInt(2),
+					Data:                   "{\"data\":\"something\"}",
+					EventType:              relayer.EventType(relayer.EventTypeSendETH),
+					CanonicalTokenAddress:  "0x1",
+					CanonicalTokenSymbol:   "ETH",
+					CanonicalTokenName:     "Ethereum",
+					CanonicalTokenDecimals: 18,
+					Amount:                 "1",
+					MsgHash:                "0x1",
+					MessageOwner:           "0x1",
+					Event:                  relayer.EventNameMessageSent,
+					EmittedBlockID:         1,
+				},
+				nil,
 			},
-			nil,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			_, err = eventRepo.Save(context.Background(), tt.opts)
-			assert.Equal(t, tt.wantErr, err)
-		})
-	}
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err = eventRepo.Save(context.Background(), tt.opts)
+				assert.Equal(t, tt.wantErr, err)
+			})
+		}
+	})
 }
 
 func TestIntegration_Event_UpdateStatus(t *testing.T) {
-	db, close, err := testMysql(t)
-	assert.Equal(t, nil, err)
-
-	defer close()
-
-	eventRepo, err := NewEventRepository(db)
-	assert.Equal(t, nil, err)
-
-	tests := []struct {
-		name    string
-		id      int
-		status  relayer.EventStatus
-		wantErr bool
-	}{
-		{
-			"success",
-			1,
-			relayer.EventStatusDone,
-			false,
-		},
-		{
-			"errNotFound",
-			123,
-			relayer.EventStatusDone,
-			true,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if tt.name == "success" {
-				_, err := eventRepo.Save(context.Background(),
-					&relayer.SaveEventOpts{
-						Name:                   "test",
-						ChainID:                big.NewInt(1),
-						DestChainID:            big.NewInt(2),
-						Data:                   "{\"data\":\"something\"}",
-						EventType:              relayer.EventTypeSendETH,
-						CanonicalTokenAddress:  "0x1",
-						CanonicalTokenSymbol:   "ETH",
-						CanonicalTokenName:     "Ethereum",
-						CanonicalTokenDecimals: 18,
-						Amount:                 "1",
-						MsgHash:                "0x1",
-						MessageOwner:           "0x1",
-						Event:                  relayer.EventNameMessageSent,
-						EmittedBlockID:         1,
-					},
-				)
-				assert.Equal(t, nil, err)
-			}
-
-			err := eventRepo.UpdateStatus(context.Background(), tt.id, tt.status)
-
-			assert.Equal(t, tt.wantErr, err != nil)
-		})
-	}
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		eventRepo, err := NewEventRepository(db)
+		assert.Equal(t, nil, err)
+
+		tests := []struct {
+			name    string
+			id      int
+			status  relayer.EventStatus
+			wantErr bool
+		}{
+			{
+				"success",
+				1,
+				relayer.EventStatusDone,
+				false,
+			},
+			{
+				"errNotFound",
+				123,
+				relayer.EventStatusDone,
+				true,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if tt.name == "success" {
+					_, err := eventRepo.Save(context.Background(),
+						&relayer.SaveEventOpts{
+							Name:                   "test",
+							ChainID:                big.NewInt(1),
+							DestChainID:            big.NewInt(2),
+							Data:                   "{\"data\":\"something\"}",
+							EventType:              relayer.EventTypeSendETH,
+							CanonicalTokenAddress:  "0x1",
+							CanonicalTokenSymbol:   "ETH",
+							CanonicalTokenName:     "Ethereum",
+							CanonicalTokenDecimals: 18,
+							Amount:                 "1",
+							MsgHash:                "0x1",
+							MessageOwner:           "0x1",
+							Event:                  relayer.EventNameMessageSent,
+							EmittedBlockID:         1,
+						},
+					)
+					assert.Equal(t, nil, err)
+				}
+
+				err := eventRepo.UpdateStatus(context.Background(), tt.id, tt.status)
+
+				assert.Equal(t, tt.wantErr, err != nil)
+			})
+		}
+	})
 }
 
 func TestIntegration_Event_FindAllByAddress(t *testing.T) {
-	db, close, err := testMysql(t)
-	assert.Equal(t, nil, err)
-
-	defer close()
-
-	eventRepo, err := NewEventRepository(db)
-
-	assert.Equal(t, nil, err)
-
-	addr := common.HexToAddress("0x71C7656EC7ab88b098defB751B7401B5f6d8976F")
-
-	_, err = eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
-		Name:                   "name",
-		Data:                   fmt.Sprintf(`{"Message": {"Owner": "%s"}}`, strings.ToLower(addr.Hex())),
-		ChainID:                big.NewInt(1),
-		DestChainID:            big.NewInt(2),
-		Status:                 relayer.EventStatusDone,
-		EventType:              relayer.EventTypeSendETH,
-		CanonicalTokenAddress:  "0x1",
-		CanonicalTokenSymbol:   "ETH",
-		CanonicalTokenName:     "Ethereum",
-		CanonicalTokenDecimals: 18,
-		Amount:                 "1",
-		MsgHash:                "0x1",
-		MessageOwner:           addr.Hex(),
-		Event:                  relayer.EventNameMessageSent,
-		EmittedBlockID:         1,
-	})
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		eventRepo, err := NewEventRepository(db)
+
+		assert.Equal(t, nil, err)
+
+		addr := common.HexToAddress("0x71C7656EC7ab88b098defB751B7401B5f6d8976F")
+
+		_, err = eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+			Name:                   "name",
+			Data:                   fmt.Sprintf(`{"Message": {"Owner": "%s"}}`, strings.ToLower(addr.Hex())),
+			ChainID:                big.NewInt(1),
+			DestChainID:            big.NewInt(2),
+			Status:                 relayer.EventStatusDone,
+			EventType:              relayer.EventTypeSendETH,
+			CanonicalTokenAddress:  "0x1",
+			CanonicalTokenSymbol:   "ETH",
+			CanonicalTokenName:     "Ethereum",
+			CanonicalTokenDecimals: 18,
+			Amount:                 "1",
+			MsgHash:                "0x1",
+			MessageOwner:           addr.Hex(),
+			Event:                  relayer.EventNameMessageSent,
+			EmittedBlockID:         1,
+		})
 
-	assert.Equal(t, nil, err)
+		assert.Equal(t, nil, err)
+
+		_, err = eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+			Name:                   "name",
+			Data:                   fmt.Sprintf(`{"Message": {"Owner": "%s"}}`, strings.ToLower(addr.Hex())),
+			ChainID:                big.NewInt(1),
+			DestChainID:            big.NewInt(2),
+			Status:                 relayer.EventStatusDone,
+			EventType:              relayer.EventTypeSendERC20,
+			CanonicalTokenAddress:  "0x1",
+			CanonicalTokenSymbol:   "FAKE",
+			CanonicalTokenName:     "Fake Token",
+			CanonicalTokenDecimals: 18,
+			Amount:                 "1",
+			MsgHash:                "0x1",
+			MessageOwner:           addr.Hex(),
+			Event:                  relayer.EventNameMessageSent,
+			EmittedBlockID:         1,
+		})
+		assert.Equal(t, nil, err)
+
+		_, err = eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+			Name:                   "name",
+			Data:                   fmt.Sprintf(`{"Message": {"Owner": "%s"}}`, strings.ToLower(addr.Hex())),
+			ChainID:                big.NewInt(1),
+			DestChainID:            big.NewInt(2),
+			Status:                 relayer.EventStatusDone,
+			EventType:              relayer.EventTypeSendERC20,
+			CanonicalTokenAddress:  "0x2",
+			CanonicalTokenSymbol:   "FAKE",
+			CanonicalTokenName:     "Fake Token",
+			CanonicalTokenDecimals: 18,
+			Amount:                 "1",
+			MsgHash:                "0x2",
+			MessageOwner:           addr.Hex(),
+			Event:                  relayer.EventNameMessageStatusChanged,
+			EmittedBlockID:         1,
+		})
+		assert.Equal(t, nil, err)
+
+		tests := []struct {
+			name     string
+			opts     relayer.FindAllByAddressOpts
+			wantResp paginate.Page
+			wantErr  error
+		}{
+			{
+				"successJustAddress",
+				relayer.FindAllByAddressOpts{
+					Address: addr,
+				},
+				paginate.Page{
+					Items:      testEvents,
+					Page:       0,
+					Size:       100,
+					MaxPage:    1,
+					TotalPages: 1,
+					Total:      1,
+					Last:       false,
+					First:      true,
+					Visible:    1,
+				},
+				nil,
+			},
+			{
+				"successJustAddressAndEvent",
+				relayer.FindAllByAddressOpts{
+					Address: addr,
+					Event:   &relayer.EventNameMessageSent,
+				},
+				paginate.Page{
+					Items:      testEvents[:2],
+					Page:       0,
+					Size:       100,
+					MaxPage:    1,
+					TotalPages: 1,
+					Total:      1,
+					Last:       false,
+					First:      true,
+					Visible:    1,
+				},
+				nil,
+			},
+			{
+				"successAddressAndMsgHash",
+				relayer.FindAllByAddressOpts{
+					Address: addr,
+					MsgHash: &testMsgHash,
+				},
+				paginate.Page{
+					Items:      testEvents[:2],
+					Page:       0,
+					Size:       100,
+					MaxPage:    1,
+					TotalPages: 1,
+					Total:      1,
+					Last:       false,
+					First:      true,
+					Visible:    1,
+				},
+				nil,
+			},
+			{
+				"successAddressAndEventType",
+				relayer.FindAllByAddressOpts{
+					Address:   addr,
+					EventType: &testEventTypeSendERC20,
+				},
+				paginate.Page{
+					Items:      testEvents[1:3],
+					Page:       0,
+					Size:       100,
+					MaxPage:    1,
+					TotalPages: 1,
+					Total:      1,
+					Last:       false,
+					First:      true,
+					Visible:    1,
+				},
+				nil,
+			},
+			{
+				"successAddressMsgHashAndEventType",
+				relayer.FindAllByAddressOpts{
+					Address:   addr,
+					EventType: &testEventTypeSendERC20,
+					MsgHash:   &testSecondMsgHash,
+				},
+				paginate.Page{
+					Items:      testEvents[2:],
+					Page:       0,
+					Size:       100,
+					MaxPage:    1,
+					TotalPages: 1,
+					Total:      1,
+					Last:       false,
+					First:      true,
+					Visible:    1,
+				},
+				nil,
+			},
+			{
+				"successAddressMsgHashAndEvent",
+				relayer.FindAllByAddressOpts{
+					Address: addr,
+					MsgHash: &testSecondMsgHash,
+					Event:   &relayer.EventNameMessageStatusChanged,
+				},
+				paginate.Page{
+					Items:      testEvents[2:],
+					Page:       0,
+					Size:       100,
+					MaxPage:    1,
+					TotalPages: 1,
+					Total:      1,
+					Last:       false,
+					First:      true,
+					Visible:    1,
+				},
+				nil,
+			},
+			{
+				"noneByAddr",
+				relayer.FindAllByAddressOpts{
+					Address: common.HexToAddress("0x165CD37b4C644C2921454429E7F9358d18A45e14"),
+				},
+				paginate.Page{
+					Items:      []relayer.Event{},
+					Page:       0,
+					Size:       100,
+					MaxPage:    1,
+					TotalPages: 1,
+					Total:      1,
+					Last:       true,
+					First:      true,
+					Visible:    1,
+				},
+				nil,
+			},
+		}
 
-	_, err = eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
-		Name:                   "name",
-		Data:                   fmt.Sprintf(`{"Message": {"Owner": "%s"}}`, strings.ToLower(addr.Hex())),
-		ChainID:                big.NewInt(1),
-		DestChainID:            big.NewInt(2),
-		Status:                 relayer.EventStatusDone,
-		EventType:              relayer.EventTypeSendERC20,
-		CanonicalTokenAddress:  "0x1",
-		CanonicalTokenSymbol:   "FAKE",
-		CanonicalTokenName:     "Fake Token",
-		CanonicalTokenDecimals: 18,
-		Amount:                 "1",
-		MsgHash:                "0x1",
-		MessageOwner:           addr.Hex(),
-		Event:                  relayer.EventNameMessageSent,
-		EmittedBlockID:         1,
-	})
-	assert.Equal(t, nil, err)
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				req, err := http.NewRequest(http.MethodGet, "/events", nil)
+				assert.Equal(t, nil, err)
 
-	_, err = eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
-		Name:                   "name",
-		Data:                   fmt.Sprintf(`{"Message": {"Owner": "%s"}}`, strings.ToLower(addr.Hex())),
-		ChainID:                big.NewInt(1),
-		DestChainID:            big.NewInt(2),
-		Status:                 relayer.EventStatusDone,
-		EventType:              relayer.EventTypeSendERC20,
-		CanonicalTokenAddress:  "0x2",
-		CanonicalTokenSymbol:   "FAKE",
-		CanonicalTokenName:     "Fake Token",
-		CanonicalTokenDecimals: 18,
-		Amount:                 "1",
-		MsgHash:                "0x2",
-		MessageOwner:           addr.Hex(),
-		Event:                  relayer.EventNameMessageStatusChanged,
-		EmittedBlockID:         1,
+				resp, err := eventRepo.FindAllByAddress(context.Background(), req, tt.opts)
+				assert.Equal(t, tt.wantResp.Items, resp.Items)
+				assert.Equal(t, tt.wantErr, err)
+			})
+		}
 	})
-	assert.Equal(t, nil, err)
+}
 
-	tests := []struct {
-		name     string
-		opts     relayer.FindAllByAddressOpts
-		wantResp paginate.Page
-		wantErr  error
-	}{
-		{
-			"successJustAddress",
-			relayer.FindAllByAddressOpts{
-				Address: addr,
+func TestIntegration_Event_FirstByMsgHash(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		eventRepo, err := NewEventRepository(db)
+		assert.Equal(t, nil, err)
+
+		_, err = eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+			Name:                   "name",
+			Data:                   fmt.Sprintf(`{"Message": {"Owner": "%s"}}`, strings.ToLower(addr.Hex())),
+			ChainID:                big.NewInt(1),
+			DestChainID:            big.NewInt(2),
+			Status:                 relayer.EventStatusDone,
+			EventType:              relayer.EventTypeSendETH,
+			CanonicalTokenAddress:  "0x1",
+			CanonicalTokenSymbol:   "ETH",
+			CanonicalTokenName:     "Ethereum",
+			CanonicalTokenDecimals: 18,
+			Amount:                 "1",
+			MsgHash:                "0x1",
+			MessageOwner:           addr.Hex(),
+			EmittedBlockID:         1,
+		})
+		assert.Equal(t, nil, err)
+		tests := []struct {
+			name     string
+			msgHash  string
+			wantResp *relayer.Event
+			wantErr  error
+		}{
+			{
+				"success",
+				"0x1",
+				&relayer.Event{
+					ID:   1,
+					Name: "name",
+					// nolint lll
+					Data:                   datatypes.JSON([]byte(fmt.Sprintf(`{"Message": {"Owner": "%s"}}`, strings.ToLower(addr.Hex())))),
+					ChainID:                1,
+					DestChainID:            2,
+					Status:                 relayer.EventStatusDone,
+					EventType:              relayer.EventTypeSendETH,
+					CanonicalTokenAddress:  "0x1",
+					CanonicalTokenSymbol:   "ETH",
+					CanonicalTokenName:     "Ethereum",
+					CanonicalTokenDecimals: 18,
+					Amount:                 "1",
+					MsgHash:                "0x1",
+					MessageOwner:           addr.Hex(),
+					EmittedBlockID:         1,
+				},
+				nil,
 			},
-			paginate.Page{
-				Items:      testEvents,
-				Page:       0,
-				Size:       100,
-				MaxPage:    1,
-				TotalPages: 1,
-				Total:      1,
-				Last:       false,
-				First:      true,
-				Visible:    1,
+			{
+				"noneByMgHash",
+				"0xfake",
+				nil,
+				nil,
 			},
-			nil,
-		},
-		{
-			"successJustAddressAndEvent",
-			relayer.FindAllByAddressOpts{
-				Address: addr,
-				Event:   &relayer.EventNameMessageSent,
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				resp, err := eventRepo.FirstByMsgHash(context.Background(), tt.msgHash)
+				assert.Equal(t, tt.wantResp, resp)
+				assert.Equal(t, tt.wantErr, err)
+			})
+		}
+	})
+}
+
+func TestIntegration_Event_FindAllByMsgHashAndTxHash(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		eventRepo, err := NewEventRepository(db)
+		assert.Equal(t, nil, err)
+
+		for _, opts := range []*relayer.SaveEventOpts{
+			{
+				Name:           relayer.EventNameMessageSent,
+				Event:          relayer.EventNameMessageSent,
+				Data:           "{}",
+				ChainID:        big.NewInt(1),
+				DestChainID:    big.NewInt(2),
+				MsgHash:        "0x1",
+				EmittedBlockID: 1,
+				TxHash:         "0xa",
 			},
-			paginate.Page{
-				Items:      testEvents[:2],
-				Page:       0,
-				Size:       100,
-				MaxPage:    1,
-				TotalPages: 1,
-				Total:      1,
-				Last:       false,
-				First:      true,
-				Visible:    1,
+			{
+				Name:           relayer.EventNameMessageSent,
+				Event:          relayer.EventNameMessageSent,
+				Data:           "{}",
+				ChainID:        big.NewInt(1),
+				DestChainID:    big.NewInt(2),
+				MsgHash:        "0x2",
+				EmittedBlockID: 1,
+				TxHash:         "0xa",
 			},
-			nil,
-		},
-		{
-			"successAddressAndMsgHash",
-			relayer.FindAllByAddressOpts{
-				Address: addr,
-				MsgHash: &testMsgHash,
+			{
+				Name:           relayer.EventNameMessageStatusChanged,
+				Event:          relayer.EventNameMessageStatusChanged,
+				Data:           "{}",
+				ChainID:        big.NewInt(2),
+				DestChainID:    big.NewInt(1),
+				Status:         relayer.EventStatusDone,
+				MsgHash:        "0x1",
+				EmittedBlockID: 5,
+				TxHash:         "0xb",
 			},
-			paginate.Page{
-				Items:      testEvents[:2],
-				Page:       0,
-				Size:       100,
-				MaxPage:    1,
-				TotalPages: 1,
-				Total:      1,
-				Last:       false,
-				First:      true,
-				Visible:    1,
+		} {
+			_, err = eventRepo.Save(context.Background(), opts)
+			assert.Equal(t, nil, err)
+		}
+
+		events, err := eventRepo.FindAllByMsgHash(context.Background(), "0x1")
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 2, len(events))
+		assert.Equal(t, relayer.EventNameMessageSent, events[0].Event)
+		assert.Equal(t, relayer.EventNameMessageStatusChanged, events[1].Event)
+		assert.Equal(t, "0xb", events[1].TxHash)
+
+		events, err = eventRepo.FindAllByEventAndTxHash(context.Background(), relayer.EventNameMessageSent, "0xA")
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 2, len(events))
+		assert.Equal(t, "0x1", events[0].MsgHash)
+		assert.Equal(t, "0x2", events[1].MsgHash)
+
+		events, err = eventRepo.FindAllByEventAndTxHash(context.Background(), relayer.EventNameMessageSent, "0xb")
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 0, len(events))
+	})
+}
+
+func TestIntegration_Event_FindAllMessageSentByLatestStatus(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		eventRepo, err := NewEventRepository(db)
+		assert.Equal(t, nil, err)
+
+		for _, opts := range []*relayer.SaveEventOpts{
+			{
+				Name:        relayer.EventNameMessageSent,
+				Event:       relayer.EventNameMessageSent,
+				Data:        "{}",
+				ChainID:     big.NewInt(1),
+				DestChainID: big.NewInt(2),
+				MsgHash:     "0x1",
 			},
-			nil,
-		},
-		{
-			"successAddressAndEventType",
-			relayer.FindAllByAddressOpts{
-				Address:   addr,
-				EventType: &testEventTypeSendERC20,
+			{
+				Name:        relayer.EventNameMessageSent,
+				Event:       relayer.EventNameMessageSent,
+				Data:        "{}",
+				ChainID:     big.NewInt(1),
+				DestChainID: big.NewInt(2),
+				MsgHash:     "0x2",
 			},
-			paginate.Page{
-				Items:      testEvents[1:3],
-				Page:       0,
-				Size:       100,
-				MaxPage:    1,
-				TotalPages: 1,
-				Total:      1,
-				Last:       false,
-				First:      true,
-				Visible:    1,
+			{
+				Name:        relayer.EventNameMessageStatusChanged,
+				Event:       relayer.EventNameMessageStatusChanged,
+				Data:        "{}",
+				ChainID:     big.NewInt(2),
+				DestChainID: big.NewInt(1),
+				Status:      relayer.EventStatusRetriable,
+				MsgHash:     "0x1",
 			},
-			nil,
-		},
-		{
-			"successAddressMsgHashAndEventType",
-			relayer.FindAllByAddressOpts{
-				Address:   addr,
-				EventType: &testEventTypeSendERC20,
-				MsgHash:   &testSecondMsgHash,
+			{
+				Name:        relayer.EventNameMessageStatusChanged,
+				Event:       relayer.EventNameMessageStatusChanged,
+				Data:        "{}",
+				ChainID:     big.NewInt(2),
+				DestChainID: big.NewInt(1),
+				Status:      relayer.EventStatusRetriable,
+				MsgHash:     "0x2",
 			},
-			paginate.Page{
-				Items:      testEvents[2:],
-				Page:       0,
-				Size:       100,
-				MaxPage:    1,
-				TotalPages: 1,
-				Total:      1,
-				Last:       false,
-				First:      true,
-				Visible:    1,
+			// the latest status of 0x2 is failed.
+			{
+				Name:        relayer.EventNameMessageStatusChanged,
+				Event:       relayer.EventNameMessageStatusChanged,
+				Data:        "{}",
+				ChainID:     big.NewInt(2),
+				DestChainID: big.NewInt(1),
+				Status:      relayer.EventStatusFailed,
+				MsgHash:     "0x2",
 			},
-			nil,
-		},
-		{
-			"successAddressMsgHashAndEvent",
-			relayer.FindAllByAddressOpts{
-				Address: addr,
-				MsgHash: &testSecondMsgHash,
-				Event:   &relayer.EventNameMessageStatusChanged,
+		} {
+			_, err = eventRepo.Save(context.Background(), opts)
+			assert.Equal(t, nil, err)
+		}
+
+		events, err := eventRepo.FindAllMessageSentByLatestStatus(context.Background(), 1, 2, relayer.EventStatusRetriable)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 1, len(events))
+		assert.Equal(t, "0x1", events[0].MsgHash)
+		assert.Equal(t, relayer.EventNameMessageSent, events[0].Event)
+
+		events, err = eventRepo.FindAllMessageSentByLatestStatus(context.Background(), 1, 2, relayer.EventStatusFailed)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 1, len(events))
+		assert.Equal(t, "0x2", events[0].MsgHash)
+
+		events, err = eventRepo.FindAllMessageSentByLatestStatus(context.Background(), 2, 1, relayer.EventStatusFailed)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 0, len(events))
+	})
+}
+
+func TestIntegration_Event_DeleteAllAfterBlockID(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		eventRepo, err := NewEventRepository(db)
+		assert.Equal(t, nil, err)
+
+		for _, opts := range []*relayer.SaveEventOpts{
+			{
+				Name:           relayer.EventNameMessageSent,
+				Event:          relayer.EventNameMessageSent,
+				Data:           "{}",
+				ChainID:        big.NewInt(1),
+				DestChainID:    big.NewInt(2),
+				MsgHash:        "0x1",
+				EmittedBlockID: 5,
 			},
-			paginate.Page{
-				Items:      testEvents[2:],
-				Page:       0,
-				Size:       100,
-				MaxPage:    1,
-				TotalPages: 1,
-				Total:      1,
-				Last:       false,
-				First:      true,
-				Visible:    1,
+			{
+				Name:           relayer.EventNameMessageSent,
+				Event:          relayer.EventNameMessageSent,
+				Data:           "{}",
+				ChainID:        big.NewInt(1),
+				DestChainID:    big.NewInt(2),
+				MsgHash:        "0x2",
+				EmittedBlockID: 15,
 			},
-			nil,
-		},
-		{
-			"noneByAddr",
-			relayer.FindAllByAddressOpts{
-				Address: common.HexToAddress("0x165CD37b4C644C2921454429E7F9358d18A45e14"),
+			// events of another route.
+			{
+				Name:           relayer.EventNameMessageSent,
+				Event:          relayer.EventNameMessageSent,
+				Data:           "{}",
+				ChainID:        big.NewInt(2),
+				DestChainID:    big.NewInt(1),
+				MsgHash:        "0x3",
+				EmittedBlockID: 15,
 			},
-			paginate.Page{
-				Items:      []relayer.Event{},
-				Page:       0,
-				Size:       100,
-				MaxPage:    1,
-				TotalPages: 1,
-				Total:      1,
-				Last:       true,
-				First:      true,
-				Visible:    1,
+			// events not given to delete.
+			{
+				Name:           relayer.EventNameMessageProcessed,
+				Event:          relayer.EventNameMessageProcessed,
+				Data:           "{}",
+				ChainID:        big.NewInt(1),
+				DestChainID:    big.NewInt(2),
+				MsgHash:        "0x4",
+				EmittedBlockID: 15,
 			},
-			nil,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			req, err := http.NewRequest(http.MethodGet, "/events", nil)
+		} {
+			_, err = eventRepo.Save(context.Background(), opts)
 			assert.Equal(t, nil, err)
+		}
 
-			resp, err := eventRepo.FindAllByAddress(context.Background(), req, tt.opts)
-			assert.Equal(t, tt.wantResp.Items, resp.Items)
-			assert.Equal(t, tt.wantErr, err)
-		})
-	}
-}
-
-func TestIntegration_Event_FirstByMsgHash(t *testing.T) {
-	db, close, err := testMysql(t)
-	assert.Equal(t, nil, err)
-
-	defer close()
-
-	eventRepo, err := NewEventRepository(db)
-	assert.Equal(t, nil, err)
+		assert.Equal(t, nil, eventRepo.DeleteAllAfterBlockID(
+			context.Background(),
+			10,
+			1,
+			2,
+			[]string{relayer.EventNameMessageSent, relayer.EventNameMessageStatusChanged},
+		))
 
-	_, err = eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
-		Name:                   "name",
-		Data:                   fmt.Sprintf(`{"Message": {"Owner": "%s"}}`, strings.ToLower(addr.Hex())),
-		ChainID:                big.NewInt(1),
-		DestChainID:            big.NewInt(2),
-		Status:                 relayer.EventStatusDone,
-		EventType:              relayer.EventTypeSendETH,
-		CanonicalTokenAddress:  "0x1",
-		CanonicalTokenSymbol:   "ETH",
-		CanonicalTokenName:     "Ethereum",
-		CanonicalTokenDecimals: 18,
-		Amount:                 "1",
-		MsgHash:                "0x1",
-		MessageOwner:           addr.Hex(),
-		EmittedBlockID:         1,
+		for msgHash, want := range map[string]int{"0x1": 1, "0x2": 0, "0x3": 1, "0x4": 1} {
+			events, err := eventRepo.FindAllByMsgHash(context.Background(), msgHash)
+			assert.Equal(t, nil, err)
+			assert.Equal(t, want, len(events))
+		}
 	})
-	assert.Equal(t, nil, err)
-	tests := []struct {
-		name     string
-		msgHash  string
-		wantResp *relayer.Event
-		wantErr  error
-	}{
-		{
-			"success",
-			"0x1",
-			&relayer.Event{
-				ID:   1,
-				Name: "name",
-				// nolint lll
-				Data:                   datatypes.JSON([]byte(fmt.Sprintf(`{"Message": {"Owner": "%s"}}`, strings.ToLower(addr.Hex())))),
-				ChainID:                1,
-				DestChainID:            2,
-				Status:                 relayer.EventStatusDone,
-				EventType:              relayer.EventTypeSendETH,
-				CanonicalTokenAddress:  "0x1",
-				CanonicalTokenSymbol:   "ETH",
-				CanonicalTokenName:     "Ethereum",
-				CanonicalTokenDecimals: 18,
-				Amount:                 "1",
-				MsgHash:                "0x1",
-				MessageOwner:           addr.Hex(),
-				EmittedBlockID:         1,
-			},
-			nil,
-		},
-		{
-			"noneByMgHash",
-			"0xfake",
-			nil,
-			nil,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			resp, err := eventRepo.FirstByMsgHash(context.Background(), tt.msgHash)
-			assert.Equal(t, tt.wantResp, resp)
-			assert.Equal(t, tt.wantErr, err)
-		})
-	}
 }
@@ -0,0 +1,110 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/datatypes"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+)
+
+type IncidentRepository struct {
+	db db.DB
+}
+
+func NewIncidentRepository(dbHandler db.DB) (*IncidentRepository, error) {
+	if dbHandler == nil {
+		return nil, db.ErrNoDB
+	}
+
+	return &IncidentRepository{
+		db: dbHandler,
+	}, nil
+}
+
+func (r *IncidentRepository) Save(ctx context.Context, opts *relayer.SaveIncidentOpts) error {
+	now := time.Now().UTC()
+
+	i := &relayer.Incident{
+		IncidentID:   opts.IncidentID,
+		SrcChainID:   opts.SrcChainID,
+		DestChainID:  opts.DestChainID,
+		Invariant:    opts.Invariant,
+		ViolationKey: opts.ViolationKey,
+		Report:       datatypes.JSON(opts.Report),
+		Approvals:    datatypes.JSON("[]"),
+		Rejections:   datatypes.JSON("[]"),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if err := r.db.GormDB().WithContext(ctx).Create(i).Error; err != nil {
+		return errors.Wrap(err, "r.db.Create")
+	}
+
+	return nil
+}
+
+func (r *IncidentRepository) FindAllOpen(
+	ctx context.Context,
+	srcChainID uint64,
+	destChainID uint64,
+) ([]*relayer.Incident, error) {
+	incidents := make([]*relayer.Incident, 0)
+
+	if err := r.db.GormDB().WithContext(ctx).
+		Where("src_chain_id = ? AND dest_chain_id = ? AND outcome = ?", srcChainID, destChainID, "").
+		Order("id ASC").
+		Find(&incidents).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return incidents, nil
+}
+
+func (r *IncidentRepository) UpdateSignatures(
+	ctx context.Context,
+	incidentID string,
+	opts *relayer.UpdateIncidentSignaturesOpts,
+) error {
+	approvals, err := json.Marshal(opts.Approvals)
+	if err != nil {
+		return errors.Wrap(err, "json.Marshal")
+	}
+
+	rejections, err := json.Marshal(opts.Rejections)
+	if err != nil {
+		return errors.Wrap(err, "json.Marshal")
+	}
+
+	if err := r.db.GormDB().WithContext(ctx).
+		Model(&relayer.Incident{}).
+		Where("incident_id = ?", incidentID).
+		Updates(map[string]interface{}{
+			"approvals":  datatypes.JSON(approvals),
+			"rejections": datatypes.JSON(rejections),
+			"updated_at": time.Now().UTC(),
+		}).Error; err != nil {
+		return errors.Wrap(err, "r.db.Updates")
+	}
+
+	return nil
+}
+
+func (r *IncidentRepository) UpdateOutcome(ctx context.Context, incidentID string, outcome string) error {
+	if err := r.db.GormDB().WithContext(ctx).
+		Model(&relayer.Incident{}).
+		Where("incident_id = ?", incidentID).
+		Updates(map[string]interface{}{
+			"outcome":    outcome,
+			"updated_at": time.Now().UTC(),
+		}).Error; err != nil {
+		return errors.Wrap(err, "r.db.Updates")
+	}
+
+	return nil
+}
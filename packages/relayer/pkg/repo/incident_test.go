@@ -0,0 +1,73 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"gopkg.in/go-playground/assert.v1"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+)
+
+func Test_NewIncidentRepo(t *testing.T) {
+	tests := []struct {
+		name    string
+		db      db.DB
+		wantErr error
+	}{
+		{
+			"success",
+			&db.Database{},
+			nil,
+		},
+		{
+			"noDb",
+			nil,
+			db.ErrNoDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewIncidentRepository(tt.db)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestIntegration_Incident(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		incidentRepo, err := NewIncidentRepository(db)
+		assert.Equal(t, nil, err)
+
+		for _, id := range []string{"0x1", "0x2"} {
+			assert.Equal(t, nil, incidentRepo.Save(context.Background(), &relayer.SaveIncidentOpts{
+				IncidentID:   id,
+				SrcChainID:   1,
+				DestChainID:  2,
+				Invariant:    "messageSent",
+				ViolationKey: "messageSent:1:" + id,
+				Report:       `{"id":"` + id + `"}`,
+			}))
+		}
+
+		assert.Equal(t, nil, incidentRepo.UpdateSignatures(context.Background(), "0x1",
+			&relayer.UpdateIncidentSignaturesOpts{
+				Approvals:  []string{"0xa"},
+				Rejections: []string{},
+			}))
+
+		assert.Equal(t, nil, incidentRepo.UpdateOutcome(context.Background(), "0x2", "approved"))
+
+		incidents, err := incidentRepo.FindAllOpen(context.Background(), 1, 2)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 1, len(incidents))
+		assert.Equal(t, "0x1", incidents[0].IncidentID)
+		assert.Equal(t, `["0xa"]`, string(incidents[0].Approvals))
+
+		incidents, err = incidentRepo.FindAllOpen(context.Background(), 2, 1)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 0, len(incidents))
+	})
+}
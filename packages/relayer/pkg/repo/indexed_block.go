@@ -0,0 +1,107 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm/clause"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+)
+
+type IndexedBlockRepository struct {
+	db db.DB
+}
+
+func NewIndexedBlockRepository(dbHandler db.DB) (*IndexedBlockRepository, error) {
+	if dbHandler == nil {
+		return nil, db.ErrNoDB
+	}
+
+	return &IndexedBlockRepository{
+		db: dbHandler,
+	}, nil
+}
+
+func (r *IndexedBlockRepository) Save(ctx context.Context, opts *relayer.SaveIndexedBlockOpts) error {
+	b := &relayer.IndexedBlock{
+		ChainID:     opts.ChainID,
+		DestChainID: opts.DestChainID,
+		EventName:   opts.EventName,
+		BlockID:     opts.BlockID,
+		BlockHash:   opts.BlockHash,
+		ParentHash:  opts.ParentHash,
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	// a block range is indexed again after a restart, the latest hash of its last block wins.
+	if err := r.db.GormDB().WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{
+				{Name: "chain_id"},
+				{Name: "dest_chain_id"},
+				{Name: "event_name"},
+				{Name: "block_id"},
+			},
+			DoUpdates: clause.AssignmentColumns([]string{"block_hash", "parent_hash", "created_at"}),
+		}).
+		Create(b).Error; err != nil {
+		return errors.Wrap(err, "r.db.Create")
+	}
+
+	return nil
+}
+
+func (r *IndexedBlockRepository) FindAll(
+	ctx context.Context,
+	chainID uint64,
+	destChainID uint64,
+	eventName string,
+) ([]*relayer.IndexedBlock, error) {
+	blocks := make([]*relayer.IndexedBlock, 0)
+
+	if err := r.db.GormDB().WithContext(ctx).
+		Where("chain_id = ? AND dest_chain_id = ? AND event_name = ?", chainID, destChainID, eventName).
+		Order("block_id DESC").
+		Find(&blocks).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return blocks, nil
+}
+
+func (r *IndexedBlockRepository) DeleteAfterBlockID(
+	ctx context.Context,
+	chainID uint64,
+	destChainID uint64,
+	eventName string,
+	blockID uint64,
+) error {
+	if err := r.db.GormDB().WithContext(ctx).
+		Where("chain_id = ? AND dest_chain_id = ? AND event_name = ?", chainID, destChainID, eventName).
+		Where("block_id > ?", blockID).
+		Delete(&relayer.IndexedBlock{}).Error; err != nil {
+		return errors.Wrap(err, "r.db.Delete")
+	}
+
+	return nil
+}
+
+func (r *IndexedBlockRepository) DeleteBeforeBlockID(
+	ctx context.Context,
+	chainID uint64,
+	destChainID uint64,
+	eventName string,
+	blockID uint64,
+) error {
+	if err := r.db.GormDB().WithContext(ctx).
+		Where("chain_id = ? AND dest_chain_id = ? AND event_name = ?", chainID, destChainID, eventName).
+		Where("block_id < ?", blockID).
+		Delete(&relayer.IndexedBlock{}).Error; err != nil {
+		return errors.Wrap(err, "r.db.Delete")
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package repo
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/morkid/paginate"
+	"github.com/pkg/errors"
+	"gorm.io/datatypes"
+	"gorm.io/gorm/clause"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+)
+
+type RecallableMessageRepository struct {
+	db db.DB
+}
+
+func NewRecallableMessageRepository(dbHandler db.DB) (*RecallableMessageRepository, error) {
+	if dbHandler == nil {
+		return nil, db.ErrNoDB
+	}
+
+	return &RecallableMessageRepository{
+		db: dbHandler,
+	}, nil
+}
+
+func (r *RecallableMessageRepository) Save(ctx context.Context, opts *relayer.SaveRecallableMessageOpts) error {
+	now := time.Now().UTC()
+
+	m := &relayer.RecallableMessage{
+		MsgHash:      opts.MsgHash,
+		SrcChainID:   opts.SrcChainID,
+		DestChainID:  opts.DestChainID,
+		SrcOwner:     strings.ToLower(opts.SrcOwner),
+		Message:      datatypes.JSON(opts.Message),
+		Proof:        opts.Proof,
+		ProofBlockID: opts.ProofBlockID,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	// the unique key on the msgHash makes building the proof of a message idempotent.
+	if err := r.db.GormDB().WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(m).Error; err != nil {
+		return errors.Wrap(err, "r.db.Create")
+	}
+
+	return nil
+}
+
+func (r *RecallableMessageRepository) FindAllByAddress(
+	ctx context.Context,
+	req *http.Request,
+	opts relayer.FindAllRecallableMessagesOpts,
+) (*paginate.Page, error) {
+	pg := paginate.New(&paginate.Config{
+		DefaultSize: 100,
+	})
+
+	q := r.db.GormDB().WithContext(ctx).
+		Model(&relayer.RecallableMessage{}).
+		Where("src_owner = ?", strings.ToLower(opts.Address.Hex()))
+
+	if opts.SrcChainID != nil {
+		q = q.Where("src_chain_id = ?", *opts.SrcChainID)
+	}
+
+	reqCtx := pg.With(q)
+
+	page := reqCtx.Request(req).Response(&[]relayer.RecallableMessage{})
+	if page.Error {
+		return nil, page.RawError
+	}
+
+	return &page, nil
+}
+
+func (r *RecallableMessageRepository) FindAllByRoute(
+	ctx context.Context,
+	srcChainID uint64,
+	destChainID uint64,
+) ([]*relayer.RecallableMessage, error) {
+	messages := make([]*relayer.RecallableMessage, 0)
+
+	if err := r.db.GormDB().WithContext(ctx).
+		Where("src_chain_id = ? AND dest_chain_id = ?", srcChainID, destChainID).
+		Order("id ASC").
+		Find(&messages).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return messages, nil
+}
+
+func (r *RecallableMessageRepository) DeleteByMsgHash(ctx context.Context, msgHash string) error {
+	if err := r.db.GormDB().WithContext(ctx).
+		Where("msg_hash = ?", msgHash).
+		Delete(&relayer.RecallableMessage{}).Error; err != nil {
+		return errors.Wrap(err, "r.db.Delete")
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package repo
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"gopkg.in/go-playground/assert.v1"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+)
+
+func Test_NewRecallableMessageRepo(t *testing.T) {
+	tests := []struct {
+		name    string
+		db      db.DB
+		wantErr error
+	}{
+		{
+			"success",
+			&db.Database{},
+			nil,
+		},
+		{
+			"noDb",
+			nil,
+			db.ErrNoDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRecallableMessageRepository(tt.db)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestIntegration_RecallableMessage(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		recallableMessageRepo, err := NewRecallableMessageRepository(db)
+		assert.Equal(t, nil, err)
+
+		opts := &relayer.SaveRecallableMessageOpts{
+			MsgHash:      testMsgHash,
+			SrcChainID:   1,
+			DestChainID:  2,
+			SrcOwner:     addr.Hex(),
+			Message:      `{"Id": 1}`,
+			Proof:        "0x1234",
+			ProofBlockID: 5,
+		}
+
+		assert.Equal(t, nil, recallableMessageRepo.Save(context.Background(), opts))
+		// the proof of a message is only saved once.
+		assert.Equal(t, nil, recallableMessageRepo.Save(context.Background(), opts))
+
+		assert.Equal(t, nil, recallableMessageRepo.Save(context.Background(), &relayer.SaveRecallableMessageOpts{
+			MsgHash:      testSecondMsgHash,
+			SrcChainID:   2,
+			DestChainID:  1,
+			SrcOwner:     addr.Hex(),
+			Message:      `{"Id": 2}`,
+			Proof:        "0x5678",
+			ProofBlockID: 6,
+		}))
+
+		messages, err := recallableMessageRepo.FindAllByRoute(context.Background(), 1, 2)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 1, len(messages))
+		assert.Equal(t, testMsgHash, messages[0].MsgHash)
+		assert.Equal(t, "0x1234", messages[0].Proof)
+
+		req, err := http.NewRequest(http.MethodGet, "", nil)
+		assert.Equal(t, nil, err)
+
+		page, err := recallableMessageRepo.FindAllByAddress(
+			context.Background(),
+			req,
+			relayer.FindAllRecallableMessagesOpts{Address: addr},
+		)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, int64(2), page.Total)
+
+		srcChainID := uint64(2)
+
+		page, err = recallableMessageRepo.FindAllByAddress(
+			context.Background(),
+			req,
+			relayer.FindAllRecallableMessagesOpts{Address: addr, SrcChainID: &srcChainID},
+		)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, int64(1), page.Total)
+
+		assert.Equal(t, nil, recallableMessageRepo.DeleteByMsgHash(context.Background(), testMsgHash))
+
+		messages, err = recallableMessageRepo.FindAllByRoute(context.Background(), 1, 2)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 0, len(messages))
+
+		page, err = recallableMessageRepo.FindAllByAddress(
+			context.Background(),
+			req,
+			relayer.FindAllRecallableMessagesOpts{Address: addr},
+		)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, int64(1), page.Total)
+	})
+}
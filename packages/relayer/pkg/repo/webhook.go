@@ -0,0 +1,185 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+)
+
+type WebhookRepository struct {
+	db db.DB
+}
+
+func NewWebhookRepository(dbHandler db.DB) (*WebhookRepository, error) {
+	if dbHandler == nil {
+		return nil, db.ErrNoDB
+	}
+
+	return &WebhookRepository{
+		db: dbHandler,
+	}, nil
+}
+
+func (r *WebhookRepository) Save(ctx context.Context, opts *relayer.SaveWebhookOpts) (*relayer.Webhook, error) {
+	now := time.Now().UTC()
+
+	w := &relayer.Webhook{
+		URL:       opts.URL,
+		Secret:    opts.Secret,
+		Address:   opts.Address,
+		Token:     opts.Token,
+		Events:    opts.Events,
+		Enabled:   true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := r.db.GormDB().WithContext(ctx).Create(w).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Create")
+	}
+
+	return w, nil
+}
+
+func (r *WebhookRepository) FindAll(ctx context.Context) ([]*relayer.Webhook, error) {
+	webhooks := make([]*relayer.Webhook, 0)
+
+	if err := r.db.GormDB().WithContext(ctx).Order("id ASC").Find(&webhooks).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return webhooks, nil
+}
+
+func (r *WebhookRepository) FindAllEnabled(ctx context.Context) ([]*relayer.Webhook, error) {
+	webhooks := make([]*relayer.Webhook, 0)
+
+	if err := r.db.GormDB().WithContext(ctx).Where("enabled = ?", true).
+		Order("id ASC").
+		Find(&webhooks).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return webhooks, nil
+}
+
+func (r *WebhookRepository) FirstByID(ctx context.Context, id int) (*relayer.Webhook, error) {
+	w := &relayer.Webhook{}
+
+	if err := r.db.GormDB().WithContext(ctx).Where("id = ?", id).First(w).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+
+		return nil, errors.Wrap(err, "r.db.First")
+	}
+
+	return w, nil
+}
+
+func (r *WebhookRepository) UpdateEnabled(ctx context.Context, id int, enabled bool) error {
+	result := r.db.GormDB().WithContext(ctx).Model(&relayer.Webhook{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"enabled":    enabled,
+			"updated_at": time.Now().UTC(),
+		})
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "r.db.Updates")
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) SaveDelivery(ctx context.Context, opts *relayer.SaveWebhookDeliveryOpts) error {
+	now := time.Now().UTC()
+
+	d := &relayer.WebhookDelivery{
+		WebhookID:     opts.WebhookID,
+		Event:         opts.Event,
+		MsgHash:       opts.MsgHash,
+		Payload:       opts.Payload,
+		Status:        relayer.WebhookDeliveryStatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	// the unique key on the webhook, event and msgHash makes re-indexing a message idempotent.
+	if err := r.db.GormDB().WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(d).Error; err != nil {
+		return errors.Wrap(err, "r.db.Create")
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) ClaimDueDeliveries(
+	ctx context.Context,
+	limit int,
+	lease time.Duration,
+) ([]*relayer.WebhookDelivery, error) {
+	deliveries := make([]*relayer.WebhookDelivery, 0)
+
+	now := time.Now().UTC()
+
+	err := r.db.GormDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", relayer.WebhookDeliveryStatusPending, now).
+			Order("id ASC").
+			Limit(limit).
+			Find(&deliveries).Error; err != nil {
+			return err
+		}
+
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		ids := make([]int, 0, len(deliveries))
+		for _, d := range deliveries {
+			ids = append(ids, d.ID)
+		}
+
+		return tx.Model(&relayer.WebhookDelivery{}).Where("id IN ?", ids).
+			Update("next_attempt_at", now.Add(lease)).Error
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "r.db.Transaction")
+	}
+
+	return deliveries, nil
+}
+
+func (r *WebhookRepository) UpdateDelivery(
+	ctx context.Context,
+	id int,
+	opts *relayer.UpdateWebhookDeliveryOpts,
+) error {
+	err := r.db.GormDB().WithContext(ctx).Model(&relayer.WebhookDelivery{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":          opts.Status,
+			"attempts":        opts.Attempts,
+			"response_status": opts.ResponseStatus,
+			"error":           opts.Error,
+			"next_attempt_at": opts.NextAttemptAt,
+			"updated_at":      time.Now().UTC(),
+		}).Error
+	if err != nil {
+		return errors.Wrap(err, "r.db.Updates")
+	}
+
+	return nil
+}
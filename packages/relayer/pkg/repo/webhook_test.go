@@ -0,0 +1,111 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gopkg.in/go-playground/assert.v1"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+)
+
+func Test_NewWebhookRepo(t *testing.T) {
+	tests := []struct {
+		name    string
+		db      db.DB
+		wantErr error
+	}{
+		{
+			"success",
+			&db.Database{},
+			nil,
+		},
+		{
+			"noDb",
+			nil,
+			db.ErrNoDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWebhookRepository(tt.db)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestIntegration_Webhook_Deliveries(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db db.DB) {
+		webhookRepo, err := NewWebhookRepository(db)
+		assert.Equal(t, nil, err)
+
+		enabled, err := webhookRepo.Save(context.Background(), &relayer.SaveWebhookOpts{
+			URL:    "http://localhost",
+			Secret: "secret",
+			Events: "message.processed",
+		})
+		assert.Equal(t, nil, err)
+
+		disabled, err := webhookRepo.Save(context.Background(), &relayer.SaveWebhookOpts{
+			URL:    "http://localhost",
+			Secret: "secret",
+		})
+		assert.Equal(t, nil, err)
+
+		assert.Equal(t, nil, webhookRepo.UpdateEnabled(context.Background(), disabled.ID, false))
+		assert.NotEqual(t, nil, webhookRepo.UpdateEnabled(context.Background(), disabled.ID+1, false))
+
+		webhooks, err := webhookRepo.FindAll(context.Background())
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 2, len(webhooks))
+
+		webhooks, err = webhookRepo.FindAllEnabled(context.Background())
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 1, len(webhooks))
+		assert.Equal(t, enabled.ID, webhooks[0].ID)
+
+		w, err := webhookRepo.FirstByID(context.Background(), disabled.ID)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, false, w.Enabled)
+
+		opts := &relayer.SaveWebhookDeliveryOpts{
+			WebhookID: enabled.ID,
+			Event:     relayer.WebhookEventMessageSent,
+			MsgHash:   "0x1",
+			Payload:   "{}",
+		}
+
+		assert.Equal(t, nil, webhookRepo.SaveDelivery(context.Background(), opts))
+		// the same delivery is only saved once.
+		assert.Equal(t, nil, webhookRepo.SaveDelivery(context.Background(), opts))
+
+		deliveries, err := webhookRepo.ClaimDueDeliveries(context.Background(), 10, time.Minute)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 1, len(deliveries))
+		assert.Equal(t, relayer.WebhookDeliveryStatusPending, deliveries[0].Status)
+
+		// claimed deliveries are leased.
+		claimed, err := webhookRepo.ClaimDueDeliveries(context.Background(), 10, time.Minute)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 0, len(claimed))
+
+		assert.Equal(t, nil, webhookRepo.UpdateDelivery(context.Background(), deliveries[0].ID,
+			&relayer.UpdateWebhookDeliveryOpts{
+				Status:         relayer.WebhookDeliveryStatusPending,
+				Attempts:       1,
+				ResponseStatus: 500,
+				Error:          "unexpected response status 500",
+				NextAttemptAt:  time.Now().UTC().Add(-time.Second),
+			},
+		))
+
+		deliveries, err = webhookRepo.ClaimDueDeliveries(context.Background(), 10, time.Minute)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 1, len(deliveries))
+		assert.Equal(t, uint64(1), deliveries[0].Attempts)
+		assert.Equal(t, 500, deliveries[0].ResponseStatus)
+	})
+}
@@ -0,0 +1,362 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+)
+
+var (
+	ErrNoWebhookRepository = errors.New("webhook repository is required")
+	errWebhookDisabled     = errors.New("webhook not found or disabled")
+)
+
+// Headers of a webhook delivery request.
+const (
+	HeaderEvent     = "X-Relayer-Event"
+	HeaderDelivery  = "X-Relayer-Delivery"
+	HeaderTimestamp = "X-Relayer-Timestamp"
+	HeaderSignature = "X-Relayer-Signature"
+)
+
+// maxClaimSize is the max number of deliveries attempted concurrently.
+const maxClaimSize = 10
+
+// maxErrorLength is the max length of an error saved to the delivery log.
+const maxErrorLength = 1024
+
+// Opts are the options of a Notifier.
+type Opts struct {
+	Repo relayer.WebhookRepository
+	// HTTPClient sends the webhook requests, a client with the Timeout is created if it is nil.
+	HTTPClient      *http.Client
+	Timeout         time.Duration
+	MaxAttempts     uint64
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+	PollInterval    time.Duration
+}
+
+// Notifier notifies the registered webhooks of the lifecycle transitions of bridge messages.
+// Notifications are saved to the delivery log, then delivered by the delivery loop, which
+// retries failed deliveries with an exponential backoff until the max attempts.
+type Notifier struct {
+	repo relayer.WebhookRepository
+	opts Opts
+}
+
+// New creates a new Notifier.
+func New(opts Opts) (*Notifier, error) {
+	if opts.Repo == nil {
+		return nil, ErrNoWebhookRepository
+	}
+
+	if opts.Timeout == 0 {
+		opts.Timeout = 10 * time.Second
+	}
+
+	if opts.MaxAttempts == 0 {
+		opts.MaxAttempts = 1
+	}
+
+	if opts.PollInterval == 0 {
+		opts.PollInterval = time.Second
+	}
+
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = &http.Client{Timeout: opts.Timeout}
+	}
+
+	return &Notifier{
+		repo: opts.Repo,
+		opts: opts,
+	}, nil
+}
+
+// NewMessageNotification builds the notification of a lifecycle transition of the given message,
+// which was emitted on the given chain by the raw log.
+func NewMessageNotification(
+	webhookEvent relayer.WebhookEvent,
+	msgHash string,
+	status relayer.EventStatus,
+	message bridge.IBridgeMessage,
+	chainID uint64,
+	raw types.Log,
+) (*relayer.WebhookNotification, error) {
+	notification := &relayer.WebhookNotification{
+		Event:       webhookEvent,
+		MsgHash:     msgHash,
+		Status:      status.String(),
+		SrcChainID:  message.SrcChainId,
+		DestChainID: message.DestChainId,
+		SrcOwner:    message.SrcOwner.Hex(),
+		DestOwner:   message.DestOwner.Hex(),
+		ChainID:     chainID,
+		BlockID:     raw.BlockNumber,
+		TxHash:      raw.TxHash.Hex(),
+	}
+
+	eventType, canonicalToken, amount, err := relayer.DecodeMessageData(message.Data, message.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	if eventType == relayer.EventTypeSendETH {
+		amount = message.Value
+	} else if canonicalToken != nil {
+		notification.CanonicalTokenAddress = canonicalToken.Address().Hex()
+	}
+
+	if amount != nil {
+		notification.Amount = amount.String()
+	}
+
+	return notification, nil
+}
+
+// Notify saves a delivery of the notification for every webhook matching it.
+func (n *Notifier) Notify(ctx context.Context, notification *relayer.WebhookNotification) error {
+	webhooks, err := n.repo.FindAllEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	for _, w := range webhooks {
+		if !Matches(w, notification) {
+			continue
+		}
+
+		if err := n.repo.SaveDelivery(ctx, &relayer.SaveWebhookDeliveryOpts{
+			WebhookID: w.ID,
+			Event:     notification.Event,
+			MsgHash:   notification.MsgHash,
+			Payload:   string(payload),
+		}); err != nil {
+			return err
+		}
+
+		relayer.WebhookNotificationsQueued.Inc()
+	}
+
+	return nil
+}
+
+// Start runs the delivery loop until the context is done.
+func (n *Notifier) Start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	defer wg.Done()
+
+	ticker := time.NewTicker(n.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		claimed, err := n.deliverDue(ctx)
+		if err != nil {
+			slog.Error("error delivering webhooks", "err", err.Error())
+		}
+
+		// poll again immediately if there may be more due deliveries.
+		if claimed == maxClaimSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliverDue claims the due deliveries, and attempts them concurrently.
+func (n *Notifier) deliverDue(ctx context.Context) (int, error) {
+	// the lease covers the delivery requests, plus the delivery log updates.
+	deliveries, err := n.repo.ClaimDueDeliveries(ctx, maxClaimSize, 2*n.opts.Timeout)
+	if err != nil {
+		return 0, err
+	}
+
+	var wg sync.WaitGroup
+
+	for _, d := range deliveries {
+		wg.Add(1)
+
+		go func(d *relayer.WebhookDelivery) {
+			defer wg.Done()
+
+			if err := n.deliver(ctx, d); err != nil {
+				slog.Error("error updating webhook delivery", "id", d.ID, "err", err.Error())
+			}
+		}(d)
+	}
+
+	wg.Wait()
+
+	return len(deliveries), nil
+}
+
+// deliver attempts the given delivery, and updates the delivery log with the outcome.
+func (n *Notifier) deliver(ctx context.Context, d *relayer.WebhookDelivery) error {
+	responseStatus, err := n.send(ctx, d)
+
+	opts := &relayer.UpdateWebhookDeliveryOpts{
+		Status:         relayer.WebhookDeliveryStatusDelivered,
+		Attempts:       d.Attempts + 1,
+		ResponseStatus: responseStatus,
+		NextAttemptAt:  time.Now().UTC(),
+	}
+
+	if err == nil {
+		relayer.WebhookDeliveries.Inc()
+
+		return n.repo.UpdateDelivery(ctx, d.ID, opts)
+	}
+
+	relayer.WebhookDeliveryAttemptErrors.Inc()
+
+	slog.Warn("webhook delivery attempt failed",
+		"id", d.ID,
+		"webhookID", d.WebhookID,
+		"attempts", opts.Attempts,
+		"err", err.Error(),
+	)
+
+	opts.Error = err.Error()
+	if len(opts.Error) > maxErrorLength {
+		opts.Error = opts.Error[:maxErrorLength]
+	}
+
+	if opts.Attempts >= n.opts.MaxAttempts || errors.Is(err, errWebhookDisabled) {
+		relayer.WebhookDeliveriesFailed.Inc()
+
+		opts.Status = relayer.WebhookDeliveryStatusFailed
+	} else {
+		opts.Status = relayer.WebhookDeliveryStatusPending
+		opts.NextAttemptAt = opts.NextAttemptAt.Add(n.backoff(opts.Attempts))
+	}
+
+	return n.repo.UpdateDelivery(ctx, d.ID, opts)
+}
+
+// send posts the signed payload of the given delivery to its webhook, and returns the
+// response status code.
+func (n *Notifier) send(ctx context.Context, d *relayer.WebhookDelivery) (int, error) {
+	w, err := n.repo.FirstByID(ctx, d.WebhookID)
+	if err != nil {
+		return 0, err
+	}
+
+	if w == nil || !w.Enabled {
+		return 0, errWebhookDisabled
+	}
+
+	body := []byte(d.Payload)
+
+	timestamp := time.Now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, string(d.Event))
+	req.Header.Set(HeaderDelivery, strconv.Itoa(d.ID))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(w.Secret, timestamp, body))
+
+	resp, err := n.opts.HTTPClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	defer resp.Body.Close()
+
+	// drain the body, so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status %v", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// backoff returns the delay before the next attempt, which doubles after every attempt.
+func (n *Notifier) backoff(attempts uint64) time.Duration {
+	delay := n.opts.RetryBackoff
+
+	for i := uint64(1); i < attempts; i++ {
+		delay *= 2
+
+		if n.opts.MaxRetryBackoff != 0 && delay >= n.opts.MaxRetryBackoff {
+			return n.opts.MaxRetryBackoff
+		}
+	}
+
+	return delay
+}
+
+// Sign returns the signature of a webhook payload, the hex encoded HMAC-SHA256 of
+// `<timestamp>.<body>`, keyed by the webhook secret.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Matches returns whether the given notification matches the event, address and token
+// filters of the webhook.
+func Matches(w *relayer.Webhook, notification *relayer.WebhookNotification) bool {
+	if w.Events != "" {
+		var found bool
+
+		for _, e := range strings.Split(w.Events, ",") {
+			if strings.TrimSpace(e) == string(notification.Event) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	if w.Address != "" &&
+		!strings.EqualFold(w.Address, notification.SrcOwner) &&
+		!strings.EqualFold(w.Address, notification.DestOwner) {
+		return false
+	}
+
+	if w.Token != "" && !strings.EqualFold(w.Token, notification.CanonicalTokenAddress) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,196 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+var testNotification = &relayer.WebhookNotification{
+	Event:                 relayer.WebhookEventMessageSent,
+	MsgHash:               "0x1",
+	Status:                relayer.EventStatusNew.String(),
+	SrcChainID:            1,
+	DestChainID:           2,
+	SrcOwner:              "0x0000000000000000000000000000000000000123",
+	DestOwner:             "0x0000000000000000000000000000000000000456",
+	CanonicalTokenAddress: "0x0000000000000000000000000000000000000789",
+	Amount:                "1",
+}
+
+func Test_New(t *testing.T) {
+	_, err := New(Opts{})
+	assert.Equal(t, ErrNoWebhookRepository, err)
+
+	n, err := New(Opts{Repo: mock.NewWebhookRepository()})
+	assert.Nil(t, err)
+	assert.NotNil(t, n.opts.HTTPClient)
+}
+
+func Test_Matches(t *testing.T) {
+	tests := []struct {
+		name    string
+		webhook *relayer.Webhook
+		want    bool
+	}{
+		{
+			"noFilters",
+			&relayer.Webhook{},
+			true,
+		},
+		{
+			"event",
+			&relayer.Webhook{Events: "message.processed, message.sent"},
+			true,
+		},
+		{
+			"otherEvent",
+			&relayer.Webhook{Events: "message.processed,message.failed"},
+			false,
+		},
+		{
+			"srcOwner",
+			&relayer.Webhook{Address: "0x0000000000000000000000000000000000000123"},
+			true,
+		},
+		{
+			"destOwnerCaseInsensitive",
+			&relayer.Webhook{Address: "0x0000000000000000000000000000000000000456"},
+			true,
+		},
+		{
+			"otherAddress",
+			&relayer.Webhook{Address: "0x0000000000000000000000000000000000000999"},
+			false,
+		},
+		{
+			"token",
+			&relayer.Webhook{Token: "0x0000000000000000000000000000000000000789"},
+			true,
+		},
+		{
+			"otherToken",
+			&relayer.Webhook{
+				Address: "0x0000000000000000000000000000000000000123",
+				Token:   "0x0000000000000000000000000000000000000999",
+			},
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Matches(tt.webhook, testNotification))
+		})
+	}
+}
+
+func Test_Notify_Deliver(t *testing.T) {
+	var received atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+
+		timestamp, err := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+		assert.Nil(t, err)
+
+		assert.Equal(t, Sign("secret", timestamp, body), r.Header.Get(HeaderSignature))
+		assert.Equal(t, string(relayer.WebhookEventMessageSent), r.Header.Get(HeaderEvent))
+
+		var notification relayer.WebhookNotification
+		assert.Nil(t, json.Unmarshal(body, &notification))
+		assert.Equal(t, *testNotification, notification)
+
+		received.Add(1)
+	}))
+	defer srv.Close()
+
+	repo := mock.NewWebhookRepository(
+		&relayer.Webhook{ID: 1, URL: srv.URL, Secret: "secret", Enabled: true},
+		&relayer.Webhook{ID: 2, URL: srv.URL, Secret: "secret", Enabled: false},
+		&relayer.Webhook{ID: 3, URL: srv.URL, Secret: "secret", Enabled: true, Events: "message.failed"},
+	)
+
+	n, err := New(Opts{Repo: repo, MaxAttempts: 3})
+	assert.Nil(t, err)
+
+	assert.Nil(t, n.Notify(context.Background(), testNotification))
+	// notifying twice of the same transition is a no-op.
+	assert.Nil(t, n.Notify(context.Background(), testNotification))
+
+	assert.Equal(t, 1, len(repo.Deliveries()))
+
+	claimed, err := n.deliverDue(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, claimed)
+	assert.Equal(t, int32(1), received.Load())
+
+	d := repo.Deliveries()[0]
+	assert.Equal(t, relayer.WebhookDeliveryStatusDelivered, d.Status)
+	assert.Equal(t, uint64(1), d.Attempts)
+	assert.Equal(t, http.StatusOK, d.ResponseStatus)
+}
+
+func Test_Deliver_Retries(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	repo := mock.NewWebhookRepository(&relayer.Webhook{ID: 1, URL: srv.URL, Secret: "secret", Enabled: true})
+
+	n, err := New(Opts{Repo: repo, MaxAttempts: 2, RetryBackoff: time.Hour})
+	assert.Nil(t, err)
+
+	assert.Nil(t, n.Notify(context.Background(), testNotification))
+
+	_, err = n.deliverDue(context.Background())
+	assert.Nil(t, err)
+
+	d := repo.Deliveries()[0]
+	assert.Equal(t, relayer.WebhookDeliveryStatusPending, d.Status)
+	assert.Equal(t, uint64(1), d.Attempts)
+	assert.Equal(t, http.StatusInternalServerError, d.ResponseStatus)
+	assert.True(t, d.NextAttemptAt.After(time.Now().Add(59*time.Minute)))
+
+	// the next attempt is not due yet.
+	claimed, err := n.deliverDue(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, claimed)
+
+	assert.Nil(t, repo.UpdateDelivery(context.Background(), d.ID, &relayer.UpdateWebhookDeliveryOpts{
+		Status:        d.Status,
+		Attempts:      d.Attempts,
+		NextAttemptAt: time.Now(),
+	}))
+
+	_, err = n.deliverDue(context.Background())
+	assert.Nil(t, err)
+
+	d = repo.Deliveries()[0]
+	assert.Equal(t, relayer.WebhookDeliveryStatusFailed, d.Status)
+	assert.Equal(t, uint64(2), d.Attempts)
+	assert.Equal(t, "unexpected response status 500", d.Error)
+}
+
+func Test_backoff(t *testing.T) {
+	n := &Notifier{opts: Opts{RetryBackoff: time.Second, MaxRetryBackoff: 10 * time.Second}}
+
+	assert.Equal(t, time.Second, n.backoff(1))
+	assert.Equal(t, 2*time.Second, n.backoff(2))
+	assert.Equal(t, 8*time.Second, n.backoff(4))
+	assert.Equal(t, 10*time.Second, n.backoff(5))
+	assert.Equal(t, 10*time.Second, n.backoff(100))
+}
@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+// NewSaveWebhookOpts validates the webhook to register, and returns its options with the
+// addresses checksummed and the events trimmed, so they are matched as notified.
+func NewSaveWebhookOpts(webhookURL, secret, address, token, events string) (*relayer.SaveWebhookOpts, error) {
+	u, err := url.Parse(webhookURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid url %v", webhookURL)
+	}
+
+	// the secret keys the signatures of the deliveries, which are not verifiable without it.
+	if secret == "" {
+		return nil, fmt.Errorf("missing secret")
+	}
+
+	opts := &relayer.SaveWebhookOpts{
+		URL:    webhookURL,
+		Secret: secret,
+	}
+
+	if opts.Address, err = optionalAddress(address); err != nil {
+		return nil, err
+	}
+
+	if opts.Token, err = optionalAddress(token); err != nil {
+		return nil, err
+	}
+
+	if events == "" {
+		return opts, nil
+	}
+
+	names := strings.Split(events, ",")
+
+	for i, name := range names {
+		names[i] = strings.TrimSpace(name)
+
+		if !slices.Contains(relayer.WebhookEvents, relayer.WebhookEvent(names[i])) {
+			return nil, fmt.Errorf("unknown event %v", names[i])
+		}
+	}
+
+	opts.Events = strings.Join(names, ",")
+
+	return opts, nil
+}
+
+func optionalAddress(address string) (string, error) {
+	if address == "" {
+		return "", nil
+	}
+
+	if !common.IsHexAddress(address) {
+		return "", fmt.Errorf("invalid address %v", address)
+	}
+
+	return common.HexToAddress(address).Hex(), nil
+}
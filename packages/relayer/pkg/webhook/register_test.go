@@ -0,0 +1,86 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+func Test_NewSaveWebhookOpts(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		secret   string
+		address  string
+		token    string
+		events   string
+		wantOpts *relayer.SaveWebhookOpts
+		wantErr  bool
+	}{
+		{
+			"success",
+			"https://example.com/hook",
+			"secret",
+			"0x79b9f64744c98cd8cc20adb79b6a297e964254cc",
+			"",
+			"message.processed, message.failed",
+			&relayer.SaveWebhookOpts{
+				URL:     "https://example.com/hook",
+				Secret:  "secret",
+				Address: "0x79B9F64744C98Cd8cc20ADb79B6a297E964254cc",
+				Events:  "message.processed,message.failed",
+			},
+			false,
+		},
+		{
+			"invalidURL",
+			"example.com/hook",
+			"secret",
+			"",
+			"",
+			"",
+			nil,
+			true,
+		},
+		{
+			"missingSecret",
+			"https://example.com/hook",
+			"",
+			"",
+			"",
+			"",
+			nil,
+			true,
+		},
+		{
+			"invalidToken",
+			"https://example.com/hook",
+			"secret",
+			"",
+			"0x1",
+			"",
+			nil,
+			true,
+		},
+		{
+			"unknownEvent",
+			"https://example.com/hook",
+			"secret",
+			"",
+			"",
+			"message.processed,message.unknown",
+			nil,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := NewSaveWebhookOpts(tt.url, tt.secret, tt.address, tt.token, tt.events)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantOpts, opts)
+		})
+	}
+}
@@ -3,12 +3,14 @@ package processor
 import (
 	"crypto/ecdsa"
 	"fmt"
+	"math/big"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/urfave/cli/v2"
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
@@ -16,7 +18,6 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
 	pkgFlags "github.com/taikoxyz/taiko-mono/packages/relayer/pkg/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
-	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/rabbitmq"
 )
 
 // hopConfig is a config struct that must be provided for an individual
@@ -45,6 +46,12 @@ type Config struct {
 	// private key
 	ProcessorPrivateKey *ecdsa.PrivateKey
 
+	// key pool configs, messages are dispatched to the processor key and the KeyPoolPrivateKeys.
+	KeyPoolPrivateKeys          []*ecdsa.PrivateKey
+	KeyPoolStrategy             string
+	KeyPoolMinBalance           *big.Int
+	KeyPoolBalanceCheckInterval time.Duration
+
 	TargetTxHash *common.Hash
 
 	// processing configs
@@ -54,6 +61,10 @@ type Config struct {
 	ProfitableOnly       bool
 	EnableTaikoL2        bool
 
+	// WebhooksEnabled saves the processable notifications of the registered webhooks, which
+	// are delivered by the indexers.
+	WebhooksEnabled bool
+
 	// backoff configs
 	BackoffRetryInterval uint64
 	BackOffMaxRetrys     uint64
@@ -63,6 +74,7 @@ type Config struct {
 	DatabasePassword        string
 	DatabaseName            string
 	DatabaseHost            string
+	DatabaseDialect         string
 	DatabaseMaxIdleConns    uint64
 	DatabaseMaxOpenConns    uint64
 	DatabaseMaxConnLifetime uint64
@@ -86,8 +98,18 @@ type Config struct {
 
 	TxmgrConfigs *txmgr.CLIConfig
 
+	// keyPool is the key pool shared by all routes with the same destination chain,
+	// a new one will be created from TxmgrConfigs if it's nil.
+	keyPool *keyPool
+
 	MaxMessageRetries uint64
 	MinFeeToProcess   uint64
+	// FeeOracleMargin is added on top of the estimated processing cost, e.g. 0.1 means 10%.
+	FeeOracleMargin float64
+
+	// RouteName is used as the metrics label of this processor, defaults to "<srcChainID>-<destChainID>".
+	RouteName   string
+	Concurrency uint64
 }
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
@@ -99,6 +121,22 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, fmt.Errorf("invalid processorPrivateKey: %w", err)
 	}
 
+	keyPoolPrivateKeys := []*ecdsa.PrivateKey{}
+
+	for i, k := range c.StringSlice(flags.KeyPoolPrivateKeys.Name) {
+		privateKey, err := crypto.ToECDSA(common.Hex2Bytes(k))
+		if err != nil {
+			return nil, fmt.Errorf("invalid keyPool.privateKeys[%v]: %w", i, err)
+		}
+
+		keyPoolPrivateKeys = append(keyPoolPrivateKeys, privateKey)
+	}
+
+	keyPoolMinBalance, _ := new(big.Float).Mul(
+		big.NewFloat(c.Float64(flags.KeyPoolMinBalance.Name)),
+		big.NewFloat(params.Ether),
+	).Int(nil)
+
 	hopSignalServiceAddresses := c.StringSlice(flags.HopSignalServiceAddresses.Name)
 	hopTaikoAddresses := c.StringSlice(flags.HopTaikoAddresses.Name)
 	hopRPCUrls := c.StringSlice(flags.HopRPCUrls.Name)
@@ -137,9 +175,13 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		destQuotaManagerAddress = common.HexToAddress(c.String(flags.DestQuotaManagerAddress.Name))
 	}
 
-	return &Config{
+	cfg := &Config{
 		hopConfigs:                         hopConfigs,
 		ProcessorPrivateKey:                processorPrivateKey,
+		KeyPoolPrivateKeys:                 keyPoolPrivateKeys,
+		KeyPoolStrategy:                    c.String(flags.KeyPoolStrategy.Name),
+		KeyPoolMinBalance:                  keyPoolMinBalance,
+		KeyPoolBalanceCheckInterval:        c.Duration(flags.KeyPoolBalanceCheckInterval.Name),
 		SrcSignalServiceAddress:            common.HexToAddress(c.String(flags.SrcSignalServiceAddress.Name)),
 		DestTaikoAddress:                   common.HexToAddress(c.String(flags.DestTaikoAddress.Name)),
 		DestBridgeAddress:                  common.HexToAddress(c.String(flags.DestBridgeAddress.Name)),
@@ -151,6 +193,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		DatabasePassword:                   c.String(flags.DatabasePassword.Name),
 		DatabaseName:                       c.String(flags.DatabaseName.Name),
 		DatabaseHost:                       c.String(flags.DatabaseHost.Name),
+		DatabaseDialect:                    c.String(flags.DatabaseDialect.Name),
 		DatabaseMaxIdleConns:               c.Uint64(flags.DatabaseMaxIdleConns.Name),
 		DatabaseMaxOpenConns:               c.Uint64(flags.DatabaseMaxOpenConns.Name),
 		DatabaseMaxConnLifetime:            c.Uint64(flags.DatabaseConnMaxLifetime.Name),
@@ -166,6 +209,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		ConfirmationsTimeout:               c.Uint64(flags.ConfirmationTimeout.Name),
 		EnableTaikoL2:                      c.Bool(flags.EnableTaikoL2.Name),
 		ProfitableOnly:                     c.Bool(flags.ProfitableOnly.Name),
+		WebhooksEnabled:                    c.Bool(flags.WebhooksEnabled.Name),
 		BackoffRetryInterval:               c.Uint64(flags.BackOffRetryInterval.Name),
 		BackOffMaxRetrys:                   c.Uint64(flags.BackOffMaxRetrys.Name),
 		ETHClientTimeout:                   c.Uint64(flags.ETHClientTimeout.Name),
@@ -179,17 +223,20 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		),
 		MaxMessageRetries: c.Uint64(flags.MaxMessageRetries.Name),
 		MinFeeToProcess:   c.Uint64(flags.MinFeeToProcess.Name),
+		FeeOracleMargin:   c.Float64(flags.FeeOracleMargin.Name),
+		Concurrency:       c.Uint64(flags.Concurrency.Name),
 		OpenDBFunc: func() (db.DB, error) {
 			return db.OpenDBConnection(db.DBConnectionOpts{
 				Name:            c.String(flags.DatabaseUsername.Name),
 				Password:        c.String(flags.DatabasePassword.Name),
 				Database:        c.String(flags.DatabaseName.Name),
 				Host:            c.String(flags.DatabaseHost.Name),
+				Dialect:         c.String(flags.DatabaseDialect.Name),
 				MaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
 				MaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
 				MaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
-				OpenFunc: func(dsn string) (db.DB, error) {
-					gormDB, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+				OpenFunc: func(dialector gorm.Dialector) (db.DB, error) {
+					gormDB, err := gorm.Open(dialector, &gorm.Config{
 						Logger: logger.Default.LogMode(logger.Silent),
 					})
 					if err != nil {
@@ -200,21 +247,11 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 				},
 			})
 		},
-		OpenQueueFunc: func() (queue.Queue, error) {
-			opts := queue.NewQueueOpts{
-				Username:      c.String(flags.QueueUsername.Name),
-				Password:      c.String(flags.QueuePassword.Name),
-				Host:          c.String(flags.QueueHost.Name),
-				Port:          c.String(flags.QueuePort.Name),
-				PrefetchCount: c.Uint64(flags.QueuePrefetchCount.Name),
-			}
-
-			q, err := rabbitmq.NewQueue(opts)
-			if err != nil {
-				return nil, err
-			}
-
-			return q, nil
-		},
-	}, nil
+	}
+
+	cfg.OpenQueueFunc = func() (queue.Queue, error) {
+		return pkgFlags.OpenQueueFromCli(c, cfg.OpenDBFunc)
+	}
+
+	return cfg, nil
 }
@@ -1,6 +1,7 @@
 package processor
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -72,6 +73,9 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		assert.Equal(t, true, c.ProfitableOnly)
 		assert.Equal(t, uint64(100), c.QueuePrefetch)
 		assert.Equal(t, true, c.EnableTaikoL2)
+		assert.Equal(t, 1, len(c.KeyPoolPrivateKeys))
+		assert.Equal(t, KeyPoolStrategyLeastPending, c.KeyPoolStrategy)
+		assert.Equal(t, big.NewInt(500_000_000_000_000_000), c.KeyPoolMinBalance)
 
 		c.OpenDBFunc = func() (db.DB, error) {
 			return &mock.DB{}, nil
@@ -118,6 +122,9 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		"--" + flags.ProfitableOnly.Name,
 		"--" + flags.EnableTaikoL2.Name,
 		"--" + flags.DestQuotaManagerAddress.Name, destQuotaManagerAddr,
+		"--" + flags.KeyPoolPrivateKeys.Name, dummyEcdsaKey,
+		"--" + flags.KeyPoolStrategy.Name, KeyPoolStrategyLeastPending,
+		"--" + flags.KeyPoolMinBalance.Name, "0.5",
 	}))
 }
 
@@ -0,0 +1,31 @@
+package processor
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+// isOrphaned checks whether the block the message was sent in was reorged out of the source
+// chain since the message was queued. If the message was included again in another block,
+// the indexer queues it again with the new block.
+func (p *Processor) isOrphaned(ctx context.Context, log types.Log) (bool, error) {
+	// the block hash is unknown for messages queued without it.
+	if log.BlockHash == relayer.ZeroHash {
+		return false, nil
+	}
+
+	receipt, err := p.srcEthClient.TransactionReceipt(ctx, log.TxHash)
+	if err != nil {
+		if err == ethereum.NotFound {
+			return true, nil
+		}
+
+		return false, errors.Wrap(err, "p.srcEthClient.TransactionReceipt")
+	}
+
+	return receipt.BlockHash != log.BlockHash, nil
+}
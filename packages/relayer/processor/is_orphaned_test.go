@@ -0,0 +1,46 @@
+package processor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+func Test_isOrphaned(t *testing.T) {
+	p := newTestProcessor(true)
+
+	tests := []struct {
+		name string
+		log  types.Log
+		want bool
+	}{
+		{
+			"noBlockHash",
+			types.Log{TxHash: mock.SucceedTxHash},
+			false,
+		},
+		{
+			// the mock receipts are not in this block.
+			"differentBlockHash",
+			types.Log{TxHash: mock.SucceedTxHash, BlockHash: common.HexToHash("0x1")},
+			true,
+		},
+		{
+			"receiptNotFound",
+			types.Log{TxHash: mock.NotFoundTxHash, BlockHash: common.HexToHash("0x1")},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orphaned, err := p.isOrphaned(context.Background(), tt.log)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, orphaned)
+		})
+	}
+}
@@ -3,10 +3,13 @@ package processor
 import (
 	"context"
 	"log/slog"
+	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/pkg/errors"
 	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/feeoracle"
 )
 
 var (
@@ -15,15 +18,15 @@ var (
 
 // isProfitable determines whether a message is profitable or not. It should
 // check the processing fee, if one does not exist at all, it is definitely not
-// profitable. Otherwise, we compare it to the estimated cost.
+// profitable. Otherwise, we compare it to the cost estimated by the fee oracle,
+// which is the same one the API uses to recommend processing fees.
 func (p *Processor) isProfitable(
 	ctx context.Context,
 	id int,
 	fee uint64,
 	gasLimit uint64,
-	destChainBaseFee uint64,
-	gasTipCap uint64,
-) (bool, error) {
+	call *ethereum.CallMsg,
+) (bool, *feeoracle.Estimate, error) {
 	var shouldProcess bool = false
 
 	if fee == 0 || gasLimit == 0 {
@@ -32,32 +35,48 @@ func (p *Processor) isProfitable(
 			"gasLimit", gasLimit,
 		)
 
-		return shouldProcess, errImpossible
+		return shouldProcess, nil, errImpossible
 	}
 
-	// if processing fee is higher than baseFee * 2 +gasTipCap +  gasLimit,
-	// we should process.
-	estimatedOnchainFee := ((destChainBaseFee * 2) + gasTipCap) * uint64(gasLimit)
-	if fee > estimatedOnchainFee {
+	estimate, err := p.feeOracle.Estimate(ctx, &feeoracle.Request{
+		GasLimit: gasLimit,
+		Call:     call,
+	})
+	if err != nil {
+		if errors.Is(err, feeoracle.ErrGasLimitExceeded) {
+			slog.Info("unprofitable: estimated gas exceeds gasLimit", "gasLimit", gasLimit)
+
+			relayer.UnprofitableMessagesDetected.Inc()
+
+			return false, nil, nil
+		}
+
+		return false, nil, err
+	}
+
+	// if processing fee is higher than the estimated cost, we should process.
+	if new(big.Int).SetUint64(fee).Cmp(estimate.Cost) > 0 {
 		shouldProcess = true
 	}
 
 	slog.Info("isProfitable",
 		"processingFee", fee,
-		"destChainBaseFee", destChainBaseFee,
-		"gasTipCap", gasTipCap,
+		"destChainBaseFee", estimate.BaseFee,
+		"gasTipCap", estimate.GasTipCap,
 		"gasLimit", gasLimit,
+		"gasUsed", estimate.GasUsed,
+		"l1DataFee", estimate.L1DataFee,
 		"shouldProcess", shouldProcess,
-		"estimatedOnchainFee", estimatedOnchainFee,
+		"estimatedOnchainFee", estimate.Cost,
 	)
 
 	opts := relayer.UpdateFeesAndProfitabilityOpts{
 		Fee:                     fee,
-		DestChainBaseFee:        destChainBaseFee,
-		GasTipCap:               gasTipCap,
+		DestChainBaseFee:        estimate.BaseFee.Uint64(),
+		GasTipCap:               estimate.GasTipCap.Uint64(),
 		GasLimit:                gasLimit,
 		IsProfitable:            shouldProcess,
-		EstimatedOnchainFee:     estimatedOnchainFee,
+		EstimatedOnchainFee:     estimate.Cost.Uint64(),
 		IsProfitableEvaluatedAt: time.Now().UTC(),
 	}
 
@@ -68,8 +87,8 @@ func (p *Processor) isProfitable(
 	if !shouldProcess {
 		relayer.UnprofitableMessagesDetected.Inc()
 
-		return false, nil
+		return false, estimate, nil
 	}
 
-	return true, nil
+	return true, estimate, nil
 }
@@ -1,12 +1,41 @@
 package processor
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/feeoracle"
+	relayerhttp "github.com/taikoxyz/taiko-mono/packages/relayer/pkg/http"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
 )
 
+// stubFeeOracle estimates the cost with the given base fee and gas tip cap, using the full gas limit.
+type stubFeeOracle struct {
+	baseFee   uint64
+	gasTipCap uint64
+}
+
+func (o *stubFeeOracle) Estimate(_ context.Context, req *feeoracle.Request) (*feeoracle.Estimate, error) {
+	return &feeoracle.Estimate{
+		GasUsed:   req.GasLimit,
+		BaseFee:   new(big.Int).SetUint64(o.baseFee),
+		GasTipCap: new(big.Int).SetUint64(o.gasTipCap),
+		L1DataFee: new(big.Int),
+		Cost:      new(big.Int).SetUint64(((o.baseFee * 2) + o.gasTipCap) * req.GasLimit),
+	}, nil
+}
+
 func Test_isProfitable(t *testing.T) {
 	p := newTestProcessor(true)
 
@@ -54,13 +83,14 @@ func Test_isProfitable(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			profitable, err := p.isProfitable(
+			p.feeOracle = &stubFeeOracle{baseFee: tt.baseFee, gasTipCap: tt.gasTipCap}
+
+			profitable, _, err := p.isProfitable(
 				context.Background(),
 				tt.id,
 				tt.fee,
 				tt.gasLimit,
-				tt.baseFee,
-				tt.gasTipCap,
+				nil,
 			)
 
 			assert.Equal(t, tt.wantProfitable, profitable)
@@ -68,3 +98,109 @@ func Test_isProfitable(t *testing.T) {
 		})
 	}
 }
+
+// stubDestEthClient is a Taiko L2 destination chain on which messages use their whole gas limit.
+type stubDestEthClient struct {
+	mock.EthClient
+	gasUsed uint64
+}
+
+func (c *stubDestEthClient) ChainID(_ context.Context) (*big.Int, error) {
+	return big.NewInt(167), nil
+}
+
+func (c *stubDestEthClient) EstimateGas(_ context.Context, _ ethereum.CallMsg) (uint64, error) {
+	return c.gasUsed, nil
+}
+
+type stubTaikoL2 struct {
+	baseFee *big.Int
+}
+
+func (t *stubTaikoL2) GetBasefee(_ *bind.CallOpts, _ uint64, _ uint32) (struct {
+	Basefee   *big.Int
+	GasExcess uint64
+}, error) {
+	return struct {
+		Basefee   *big.Int
+		GasExcess uint64
+	}{Basefee: t.baseFee}, nil
+}
+
+func Test_isProfitable_RecommendedProcessingFee(t *testing.T) {
+	p := newTestProcessor(true)
+
+	gasLimit := uint64(relayerhttp.Eth)
+
+	src := &mock.EthClient{}
+	dest := &stubDestEthClient{gasUsed: gasLimit}
+	hops := []feeoracle.BlockClient{&mock.EthClient{}}
+	taikoL2 := &stubTaikoL2{baseFee: big.NewInt(1_000_000_000)}
+
+	var err error
+
+	p.feeOracle, err = feeoracle.NewForRoute(feeoracle.RouteOpts{
+		SrcEthClient:  src,
+		DestEthClient: dest,
+		DestChainID:   big.NewInt(167),
+		HopEthClients: hops,
+		DestTaikoL2:   taikoL2,
+		Simulate:      true,
+	})
+	assert.Nil(t, err)
+
+	srv, err := relayerhttp.NewServer(relayerhttp.NewServerOpts{
+		Echo:          echo.New(),
+		EventRepo:     mock.NewEventRepository(),
+		CorsOrigins:   []string{"*"},
+		SrcEthClient:  src,
+		DestEthClient: dest,
+		TaikoL2:       taikoL2,
+		HopEthClients: hops,
+	})
+	assert.Nil(t, err)
+
+	req, _ := http.NewRequest(echo.GET, "/recommendedProcessingFees", nil)
+	rec := httptest.NewRecorder()
+
+	srv.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var resp struct {
+		Fees []struct {
+			Type        string `json:"type"`
+			Amount      string `json:"amount"`
+			DestChainID uint64 `json:"destChainID"`
+		} `json:"fees"`
+	}
+
+	assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+
+	var recommended *big.Int
+
+	for _, f := range resp.Fees {
+		if f.Type == relayerhttp.Eth.String() && f.DestChainID == 167 {
+			recommended, _ = new(big.Int).SetString(f.Amount, 10)
+		}
+	}
+
+	assert.NotNil(t, recommended)
+
+	// an ETH transfer with a single hop signal proof
+	data, err := encoding.BridgeABI.Pack("processMessage", bridge.IBridgeMessage{
+		Fee:      recommended.Uint64(),
+		GasLimit: uint32(gasLimit),
+		Value:    big.NewInt(1),
+	}, bytes.Repeat([]byte{0xff}, 2048))
+	assert.Nil(t, err)
+
+	profitable, _, err := p.isProfitable(
+		context.Background(),
+		1,
+		recommended.Uint64(),
+		uint64(float64(gasLimit)*1.05),
+		&ethereum.CallMsg{To: &p.cfg.DestBridgeAddress, Data: data},
+	)
+	assert.Nil(t, err)
+	assert.True(t, profitable)
+}
@@ -0,0 +1,284 @@
+package processor
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	txmgrMetrics "github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+// Strategies to dispatch messages to the keys of a key pool.
+const (
+	KeyPoolStrategyRoundRobin   = "round-robin"
+	KeyPoolStrategyLeastPending = "least-pending"
+)
+
+var (
+	errNoActiveKeys       = errors.New("no active processor keys")
+	errKeyNotInPool       = errors.New("key not in processor key pool")
+	errInvalidKeyStrategy = errors.New("invalid key pool strategy")
+)
+
+// poolKey is a signing key of a key pool, with its own transaction manager, so a stuck
+// transaction only blocks the messages sent with the same key.
+type poolKey struct {
+	address common.Address
+	txmgr   txmgr.TxManager
+	// pending is the number of transactions being sent with this key.
+	pending atomic.Int64
+	// retired keys are not dispatched new messages, their pending transactions are drained.
+	retired atomic.Bool
+}
+
+// keyPool dispatches the processMessage transactions to a pool of signing keys.
+type keyPool struct {
+	keys     []*poolKey
+	strategy string
+	next     atomic.Uint64
+	// minBalance is the balance below which keys are retired, nil disables it.
+	minBalance  *big.Int
+	monitorOnce sync.Once
+}
+
+// newKeyPool creates a new key pool with the given keys.
+func newKeyPool(strategy string, minBalance *big.Int, keys ...*poolKey) (*keyPool, error) {
+	if strategy == "" {
+		strategy = KeyPoolStrategyRoundRobin
+	}
+
+	if strategy != KeyPoolStrategyRoundRobin && strategy != KeyPoolStrategyLeastPending {
+		return nil, fmt.Errorf("%w: %v", errInvalidKeyStrategy, strategy)
+	}
+
+	if minBalance != nil && minBalance.Sign() == 0 {
+		minBalance = nil
+	}
+
+	return &keyPool{
+		keys:       keys,
+		strategy:   strategy,
+		minBalance: minBalance,
+	}, nil
+}
+
+// newKeyPoolFromConfig creates a key pool of the processor key and the additional pool keys
+// of the given config, each with a transaction manager created from the TxmgrConfigs.
+func newKeyPoolFromConfig(name string, cfg *Config) (*keyPool, error) {
+	privateKeys := append([]*ecdsa.PrivateKey{cfg.ProcessorPrivateKey}, cfg.KeyPoolPrivateKeys...)
+
+	keys := make([]*poolKey, 0, len(privateKeys))
+	seen := make(map[common.Address]bool, len(privateKeys))
+
+	for i, privateKey := range privateKeys {
+		address := crypto.PubkeyToAddress(privateKey.PublicKey)
+		if seen[address] {
+			continue
+		}
+
+		seen[address] = true
+
+		txmgrConfigs := *cfg.TxmgrConfigs
+		txmgrConfigs.PrivateKey = common.Bytes2Hex(crypto.FromECDSA(privateKey))
+
+		txmgrName := name
+		if i != 0 {
+			txmgrName = fmt.Sprintf("%v-%v", name, i)
+		}
+
+		txMgr, err := txmgr.NewSimpleTxManager(
+			txmgrName,
+			log.Root(),
+			new(txmgrMetrics.NoopTxMetrics),
+			txmgrConfigs,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, &poolKey{address: address, txmgr: txMgr})
+	}
+
+	return newKeyPool(cfg.KeyPoolStrategy, cfg.KeyPoolMinBalance, keys...)
+}
+
+// acquire returns the next active key according to the pool strategy, which must be released
+// once its transaction is sent.
+func (kp *keyPool) acquire() (*poolKey, error) {
+	start := kp.next.Add(1) - 1
+
+	var selected *poolKey
+
+	for i := range kp.keys {
+		k := kp.keys[(start+uint64(i))%uint64(len(kp.keys))]
+		if k.retired.Load() {
+			continue
+		}
+
+		if kp.strategy == KeyPoolStrategyRoundRobin {
+			selected = k
+			break
+		}
+
+		if selected == nil || k.pending.Load() < selected.pending.Load() {
+			selected = k
+		}
+	}
+
+	if selected == nil {
+		return nil, errNoActiveKeys
+	}
+
+	kp.track(selected, 1)
+
+	return selected, nil
+}
+
+// release marks a transaction of the given key as no longer pending.
+func (kp *keyPool) release(k *poolKey) {
+	kp.track(k, -1)
+}
+
+// track updates the number of pending transactions of the given key.
+func (kp *keyPool) track(k *poolKey, delta int64) {
+	pending := k.pending.Add(delta)
+
+	relayer.ProcessorKeyPendingTxs.WithLabelValues(k.address.Hex()).Set(float64(pending))
+}
+
+// close closes the transaction managers of all the keys.
+func (kp *keyPool) close() {
+	for _, k := range kp.keys {
+		k.txmgr.Close()
+	}
+}
+
+// send sends the candidate transaction with the next active key.
+func (kp *keyPool) send(ctx context.Context, candidate txmgr.TxCandidate) (*types.Receipt, error) {
+	k, err := kp.acquire()
+	if err != nil {
+		return nil, err
+	}
+
+	defer kp.release(k)
+
+	return k.txmgr.Send(ctx, candidate)
+}
+
+// sendFrom sends the candidate transaction with the given key, even if it is retired, for the
+// messages only its owner can process.
+func (kp *keyPool) sendFrom(
+	ctx context.Context,
+	address common.Address,
+	candidate txmgr.TxCandidate,
+) (*types.Receipt, error) {
+	for _, k := range kp.keys {
+		if k.address != address {
+			continue
+		}
+
+		kp.track(k, 1)
+		defer kp.release(k)
+
+		return k.txmgr.Send(ctx, candidate)
+	}
+
+	return nil, errKeyNotInPool
+}
+
+// startBalanceMonitor starts monitoring the balances of the pool keys, once per pool, as the
+// pool may be shared by several processors.
+func (kp *keyPool) startBalanceMonitor(
+	ctx context.Context,
+	client ethClient,
+	interval time.Duration,
+	wg *sync.WaitGroup,
+) {
+	kp.monitorOnce.Do(func() {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			kp.monitorBalances(ctx, client, interval)
+		}()
+	})
+}
+
+// monitorBalances checks the balances of the pool keys every interval, until the context is done.
+func (kp *keyPool) monitorBalances(ctx context.Context, client ethClient, interval time.Duration) {
+	if interval == 0 {
+		interval = time.Minute
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		kp.checkBalances(ctx, client)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkBalances updates the balance metric of every pool key, retires the keys whose balance
+// fell below the min balance, and reactivates the retired keys which were refunded.
+func (kp *keyPool) checkBalances(ctx context.Context, client ethClient) {
+	for _, k := range kp.keys {
+		balance, err := client.BalanceAt(ctx, k.address, nil)
+		if err != nil {
+			slog.Warn("Failed to retrieve processor key balance", "address", k.address.Hex(), "error", err)
+			continue
+		}
+
+		balanceEth, _ := new(big.Float).Quo(
+			new(big.Float).SetInt(balance),
+			big.NewFloat(math.Pow10(18)),
+		).Float64()
+		relayer.ProcessorKeyBalance.WithLabelValues(k.address.Hex()).Set(balanceEth)
+
+		if kp.minBalance == nil {
+			continue
+		}
+
+		low := balance.Cmp(kp.minBalance) < 0
+
+		if low && k.retired.CompareAndSwap(false, true) {
+			slog.Warn("Retiring processor key below min balance",
+				"address", k.address.Hex(),
+				"balance", balance.String(),
+				"pending", k.pending.Load(),
+			)
+		} else if !low && k.retired.CompareAndSwap(true, false) {
+			slog.Info("Reactivating refunded processor key",
+				"address", k.address.Hex(),
+				"balance", balance.String(),
+			)
+		}
+
+		retired := 0.0
+		if k.retired.Load() {
+			retired = 1
+		}
+
+		relayer.ProcessorKeyRetired.WithLabelValues(k.address.Hex()).Set(retired)
+	}
+}
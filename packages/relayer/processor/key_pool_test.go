@@ -0,0 +1,143 @@
+package processor
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+// balancesEthClient is an eth client which returns the configured balance of every address.
+type balancesEthClient struct {
+	mock.EthClient
+
+	balances map[common.Address]*big.Int
+}
+
+func (c *balancesEthClient) BalanceAt(_ context.Context, account common.Address, _ *big.Int) (*big.Int, error) {
+	return c.balances[account], nil
+}
+
+func newTestKeyPool(t *testing.T, strategy string, minBalance *big.Int) *keyPool {
+	kp, err := newKeyPool(
+		strategy,
+		minBalance,
+		&poolKey{address: common.HexToAddress("0x1"), txmgr: &mock.TxManager{}},
+		&poolKey{address: common.HexToAddress("0x2"), txmgr: &mock.TxManager{}},
+		&poolKey{address: common.HexToAddress("0x3"), txmgr: &mock.TxManager{}},
+	)
+	assert.Nil(t, err)
+
+	return kp
+}
+
+func Test_newKeyPool_InvalidStrategy(t *testing.T) {
+	_, err := newKeyPool("random", nil)
+	assert.ErrorIs(t, err, errInvalidKeyStrategy)
+}
+
+func Test_keyPool_RoundRobin(t *testing.T) {
+	kp := newTestKeyPool(t, KeyPoolStrategyRoundRobin, nil)
+
+	kp.keys[1].retired.Store(true)
+
+	var addresses []common.Address
+
+	for i := 0; i < 4; i++ {
+		k, err := kp.acquire()
+		assert.Nil(t, err)
+
+		addresses = append(addresses, k.address)
+	}
+
+	assert.Equal(t, []common.Address{
+		common.HexToAddress("0x1"),
+		common.HexToAddress("0x3"),
+		common.HexToAddress("0x3"),
+		common.HexToAddress("0x1"),
+	}, addresses)
+}
+
+func Test_keyPool_LeastPending(t *testing.T) {
+	kp := newTestKeyPool(t, KeyPoolStrategyLeastPending, nil)
+
+	var acquired []*poolKey
+
+	for i := 0; i < 3; i++ {
+		k, err := kp.acquire()
+		assert.Nil(t, err)
+
+		acquired = append(acquired, k)
+	}
+
+	kp.release(acquired[1])
+
+	// the second key is the only one without pending transactions.
+	for i := 0; i < 3; i++ {
+		k, err := kp.acquire()
+		assert.Nil(t, err)
+		assert.Equal(t, common.HexToAddress("0x2"), k.address)
+
+		kp.release(k)
+	}
+}
+
+func Test_keyPool_NoActiveKeys(t *testing.T) {
+	kp := newTestKeyPool(t, KeyPoolStrategyRoundRobin, nil)
+
+	for _, k := range kp.keys {
+		k.retired.Store(true)
+	}
+
+	_, err := kp.send(context.Background(), txmgr.TxCandidate{})
+	assert.Equal(t, errNoActiveKeys, err)
+
+	// messages only the key owner can process are still sent with retired keys.
+	_, err = kp.sendFrom(context.Background(), common.HexToAddress("0x2"), txmgr.TxCandidate{})
+	assert.Nil(t, err)
+
+	_, err = kp.sendFrom(context.Background(), common.HexToAddress("0x4"), txmgr.TxCandidate{})
+	assert.Equal(t, errKeyNotInPool, err)
+}
+
+func Test_keyPool_CheckBalances(t *testing.T) {
+	kp := newTestKeyPool(t, KeyPoolStrategyRoundRobin, big.NewInt(100))
+
+	client := &balancesEthClient{balances: map[common.Address]*big.Int{
+		common.HexToAddress("0x1"): big.NewInt(100),
+		common.HexToAddress("0x2"): big.NewInt(99),
+		common.HexToAddress("0x3"): big.NewInt(1000),
+	}}
+
+	kp.checkBalances(context.Background(), client)
+
+	assert.False(t, kp.keys[0].retired.Load())
+	assert.True(t, kp.keys[1].retired.Load())
+	assert.False(t, kp.keys[2].retired.Load())
+
+	// refunded keys are reactivated.
+	client.balances[common.HexToAddress("0x2")] = big.NewInt(200)
+
+	kp.checkBalances(context.Background(), client)
+
+	assert.False(t, kp.keys[1].retired.Load())
+}
+
+func Test_keyPool_CheckBalances_NoMinBalance(t *testing.T) {
+	kp := newTestKeyPool(t, KeyPoolStrategyRoundRobin, big.NewInt(0))
+
+	kp.checkBalances(context.Background(), &balancesEthClient{balances: map[common.Address]*big.Int{
+		common.HexToAddress("0x1"): big.NewInt(0),
+		common.HexToAddress("0x2"): big.NewInt(0),
+		common.HexToAddress("0x3"): big.NewInt(0),
+	}})
+
+	for _, k := range kp.keys {
+		assert.False(t, k.retired.Load())
+	}
+}
@@ -0,0 +1,175 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+)
+
+// MultiProcessor runs one Processor for each route in a route table, in a single process. All
+// routes share the same database connection, and the routes with the same destination chain share
+// one key pool, so the nonces of the processor keys are managed per destination chain.
+type MultiProcessor struct {
+	processors []*Processor
+	// db and keyPools are shared by the processors, and closed once they are all stopped.
+	db       db.DB
+	keyPools map[string]*keyPool
+}
+
+// InitFromCli creates a new multi-route processor from a cli context
+func (m *MultiProcessor) InitFromCli(ctx context.Context, c *cli.Context) error {
+	cfg, err := NewConfigFromCliContext(c)
+	if err != nil {
+		return err
+	}
+
+	routes, err := LoadRoutes(c.String(flags.RoutesFile.Name))
+	if err != nil {
+		return err
+	}
+
+	return InitMultiProcessorFromConfig(ctx, m, cfg, routes)
+}
+
+// InitMultiProcessorFromConfig initializes a processor for each of the given routes, based on the given
+// shared config.
+func InitMultiProcessorFromConfig(ctx context.Context, m *MultiProcessor, cfg *Config, routes []Route) error {
+	sharedDB, err := cfg.OpenDBFunc()
+	if err != nil {
+		return err
+	}
+
+	m.db = sharedDB
+
+	// key pools by destination chain ID
+	keyPools := make(map[string]*keyPool)
+	m.keyPools = keyPools
+
+	// route names by source and destination chain IDs, the routes between the same chains
+	// would share their queue.
+	chainPairs := make(map[string]string)
+
+	// quota schedulers by destination chain ID and quota manager address
+	quotaSchedulers := make(map[string]*quotaScheduler)
+
+	for _, r := range routes {
+		routeCfg := r.config(cfg)
+		routeCfg.OpenDBFunc = func() (db.DB, error) {
+			return sharedDB, nil
+		}
+
+		destChainID, err := chainID(ctx, r.DestRPCUrl)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := keyPools[destChainID]; !ok {
+			if keyPools[destChainID], err = newKeyPoolFromConfig(
+				fmt.Sprintf("processor-%v", destChainID),
+				routeCfg,
+			); err != nil {
+				return err
+			}
+		}
+
+		routeCfg.keyPool = keyPools[destChainID]
+
+		p := new(Processor)
+		if err := InitFromConfig(ctx, p, routeCfg); err != nil {
+			return fmt.Errorf("route %s: %w", r.Name, err)
+		}
+
+		chainPair := fmt.Sprintf("%v-%v", p.srcChainId.String(), destChainID)
+		if name, ok := chainPairs[chainPair]; ok {
+			return fmt.Errorf("route %s: same source and destination chains as route %s", r.Name, name)
+		}
+
+		chainPairs[chainPair] = r.Name
+
+		// routes with the same destination chain and quota manager share its quota.
+		if p.quotaScheduler != nil {
+			key := fmt.Sprintf("%v-%v", destChainID, routeCfg.DestQuotaManagerAddress.Hex())
+
+			if s, ok := quotaSchedulers[key]; ok {
+				p.quotaScheduler = s
+			} else {
+				quotaSchedulers[key] = p.quotaScheduler
+			}
+		}
+
+		slog.Info("initialized processor route",
+			"route", r.Name,
+			"srcChainID", p.srcChainId.String(),
+			"destChainID", destChainID,
+			"hops", len(r.Hops),
+			"concurrency", routeCfg.Concurrency,
+		)
+
+		m.processors = append(m.processors, p)
+	}
+
+	return nil
+}
+
+func (m *MultiProcessor) Name() string {
+	return "multiprocessor"
+}
+
+// Start starts all route processors.
+func (m *MultiProcessor) Start() error {
+	for _, p := range m.processors {
+		if err := p.Start(); err != nil {
+			return fmt.Errorf("route %s: %w", p.routeName, err)
+		}
+	}
+
+	return nil
+}
+
+// Close stops all route processors, and then closes the resources they share.
+func (m *MultiProcessor) Close(ctx context.Context) {
+	for _, p := range m.processors {
+		p.stop()
+	}
+
+	for _, kp := range m.keyPools {
+		kp.close()
+	}
+
+	if m.db == nil {
+		return
+	}
+
+	// Close db connection.
+	sqlDB, err := m.db.DB()
+	if err != nil {
+		slog.Error("Failed to get db connection", "err", err)
+		return
+	}
+
+	if err := sqlDB.Close(); err != nil {
+		slog.Error("Failed to close db connection", "err", err)
+	}
+}
+
+// chainID fetches the chain ID of the given RPC endpoint.
+func chainID(ctx context.Context, rpcURL string) (string, error) {
+	client, err := ethclient.Dial(rpcURL)
+	if err != nil {
+		return "", err
+	}
+	defer client.Close()
+
+	id, err := client.ChainID(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return id.String(), nil
+}
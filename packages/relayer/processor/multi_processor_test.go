@@ -0,0 +1,35 @@
+package processor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+func Test_MultiProcessor_Close(t *testing.T) {
+	txMgrs := []*mock.TxManager{{}, {}}
+
+	kp, err := newKeyPool(
+		KeyPoolStrategyRoundRobin,
+		nil,
+		&poolKey{address: common.HexToAddress("0x1"), txmgr: txMgrs[0]},
+		&poolKey{address: common.HexToAddress("0x2"), txmgr: txMgrs[1]},
+	)
+	assert.Nil(t, err)
+
+	// the routes share the key pool of their destination chain.
+	m := &MultiProcessor{
+		processors: []*Processor{{keyPool: kp}, {keyPool: kp}},
+		keyPools:   map[string]*keyPool{"167001": kp},
+	}
+
+	m.Close(context.Background())
+
+	for _, txMgr := range txMgrs {
+		assert.Equal(t, 1, txMgr.Closes)
+	}
+}
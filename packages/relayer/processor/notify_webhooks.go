@@ -0,0 +1,40 @@
+package processor
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/webhook"
+)
+
+// notifyProcessable notifies the registered webhooks the given message passed the profitability
+// check, and its processMessage transaction is about to be sent. A delivery is only saved once per
+// webhook, event and msgHash, so a message sent again after a failed attempt is notified once. The
+// notification is best effort, a message is not held back if it can not be saved.
+func (p *Processor) notifyProcessable(ctx context.Context, event *bridge.BridgeMessageSent) {
+	if p.webhookNotifier == nil {
+		return
+	}
+
+	msgHash := common.Hash(event.MsgHash).Hex()
+
+	notification, err := webhook.NewMessageNotification(
+		relayer.WebhookEventMessageProcessable,
+		msgHash,
+		relayer.EventStatusNew,
+		event.Message,
+		p.srcChainId.Uint64(),
+		event.Raw,
+	)
+	if err == nil {
+		err = p.webhookNotifier.Notify(ctx, notification)
+	}
+
+	if err != nil {
+		slog.Error("error notifying processable message webhooks", "msgHash", msgHash, "error", err)
+	}
+}
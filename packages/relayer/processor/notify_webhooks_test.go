@@ -0,0 +1,53 @@
+package processor
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/webhook"
+)
+
+func Test_notifyProcessable(t *testing.T) {
+	p := newTestProcessor(false)
+
+	event := &bridge.BridgeMessageSent{
+		MsgHash: [32]byte{0x01},
+		Message: bridge.IBridgeMessage{
+			Id:          1,
+			SrcChainId:  mock.MockChainID.Uint64(),
+			DestChainId: mock.MockChainID.Uint64(),
+			Value:       big.NewInt(0),
+		},
+		Raw: types.Log{
+			Address: relayer.ZeroAddress,
+			Topics:  []common.Hash{relayer.ZeroHash},
+		},
+	}
+
+	// webhooks are disabled.
+	p.notifyProcessable(context.Background(), event)
+
+	webhookRepo := mock.NewWebhookRepository(&relayer.Webhook{ID: 1, Enabled: true})
+
+	notifier, err := webhook.New(webhook.Opts{Repo: webhookRepo})
+	assert.Nil(t, err)
+
+	p.webhookNotifier = notifier
+
+	p.notifyProcessable(context.Background(), event)
+
+	// a message sent again is only notified once.
+	p.notifyProcessable(context.Background(), event)
+
+	deliveries := webhookRepo.Deliveries()
+	assert.Equal(t, 1, len(deliveries))
+	assert.Equal(t, relayer.WebhookEventMessageProcessable, deliveries[0].Event)
+	assert.Equal(t, common.Hash(event.MsgHash).Hex(), deliveries[0].MsgHash)
+}
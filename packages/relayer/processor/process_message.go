@@ -9,16 +9,13 @@ import (
 	"math"
 	"math/big"
 	"strings"
-	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/params"
 	"github.com/pkg/errors"
 	"github.com/taikoxyz/taiko-mono/packages/relayer"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
@@ -143,6 +140,25 @@ func (p *Processor) processMessage(
 		return false, msgBody.TimesRetried, err
 	}
 
+	// drop messages sent in a block which was reorged out, the indexer queues them again
+	// if they were included in the canonical chain.
+	orphaned, err := p.isOrphaned(ctx, msgBody.Event.Raw)
+	if err != nil {
+		return false, msgBody.TimesRetried, errors.Wrap(err, "p.isOrphaned")
+	}
+
+	if orphaned {
+		slog.Warn("message was sent in a reorged block, dropping it",
+			"msgHash", common.Hash(msgBody.Event.MsgHash).Hex(),
+			"srcTxHash", msgBody.Event.Raw.TxHash.Hex(),
+			"blockHash", msgBody.Event.Raw.BlockHash.Hex(),
+		)
+
+		relayer.ProcessorOrphanedMessages.Inc()
+
+		return false, msgBody.TimesRetried, nil
+	}
+
 	// check paused status
 	paused, err := p.destBridge.Paused(&bind.CallOpts{
 		Context: ctx,
@@ -156,9 +172,9 @@ func (p *Processor) processMessage(
 		return true, msgBody.TimesRetried, nil
 	}
 
-	// destQuotaManager is optional, it will not be set for L1-L2 bridging
+	// quotaScheduler is optional, it will not be set for L1-L2 bridging
 	// but will be set for L2-L1 bridging.
-	if p.destQuotaManager != nil {
+	if p.quotaScheduler != nil {
 		eventType, canonicalToken, amount, err := relayer.DecodeMessageData(
 			msgBody.Event.Message.Data,
 			msgBody.Event.Message.Value,
@@ -179,20 +195,17 @@ func (p *Processor) processMessage(
 				value = amount
 			}
 
-			hasQuota, waitUntil, err := p.hasQuotaAvailable(ctx, tokenAddress, value)
+			delay, err := p.quotaScheduler.schedule(ctx, tokenAddress, msgBody.Event.MsgHash, value)
 			if err != nil {
 				return false, msgBody.TimesRetried, err
 			}
 
-			if !hasQuota {
-				// wait until quota available
-				slog.Info("quota not available for token", "waitUntil", waitUntil)
+			// delay the message until the quota is predicted to be available, instead of
+			// blocking a worker while waiting.
+			if delay > 0 {
+				slog.Info("quota not available for token", "tokenAddress", tokenAddress.Hex(), "delay", delay)
 
-				select {
-				case <-ctx.Done():
-					return false, msgBody.TimesRetried, ctx.Err()
-				case <-time.After(time.Duration(int64(waitUntil)) * time.Second):
-				}
+				return false, msgBody.TimesRetried, &errQuotaLimited{delay: delay}
 			}
 		}
 	}
@@ -421,16 +434,6 @@ func (p *Processor) sendProcessMessageCall(
 		return nil, errors.New("message not received")
 	}
 
-	baseFee, err := p.getBaseFee(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	gasTipCap, err := p.destEthClient.SuggestGasTipCap(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	data, err := encoding.BridgeABI.Pack("processMessage", event.Message, proof)
 	if err != nil {
 		return nil, err
@@ -451,54 +454,48 @@ func (p *Processor) sendProcessMessageCall(
 		gasLimit = uint64(float64(gasLimit) * 1.05)
 	}
 
-	var estimatedMaxCost uint64
+	var estimatedMaxCost *big.Int
 
 	if bool(p.profitableOnly) {
-		profitable, err := p.isProfitable(
+		// simulate the transaction with the fee oracle, and confirm it is profitable
+		auth, err := bind.NewKeyedTransactorWithChainID(p.ecdsaKey, p.destChainId)
+		if err != nil {
+			return nil, err
+		}
+
+		msg := &ethereum.CallMsg{
+			From: auth.From,
+			To:   &p.cfg.DestBridgeAddress,
+			Data: data,
+		}
+
+		profitable, estimate, err := p.isProfitable(
 			ctx,
 			id,
 			event.Message.Fee,
 			gasLimit,
-			baseFee.Uint64(),
-			gasTipCap.Uint64(),
+			msg,
 		)
 		if err != nil || !profitable {
 			if err == errImpossible {
 				return nil, errImpossible
 			}
 
-			return nil, relayer.ErrUnprofitable
-		}
-
-		// now simulate the transaction and lets confirm it is profitable
-		auth, err := bind.NewKeyedTransactorWithChainID(p.ecdsaKey, p.destChainId)
-		if err != nil {
-			return nil, err
-		}
-
-		msg := ethereum.CallMsg{
-			From: auth.From,
-			To:   &p.cfg.DestBridgeAddress,
-			Data: data,
-		}
+			if err != nil {
+				return nil, err
+			}
 
-		gasUsed, err := p.destEthClient.EstimateGas(context.Background(), msg)
-		if err != nil {
-			return nil, err
+			return nil, relayer.ErrUnprofitable
 		}
 
 		slog.Info("estimatedGasUsed",
-			"gasUsed", gasUsed,
+			"gasUsed", estimate.GasUsed,
 			"messageGasLimit", event.Message.GasLimit,
 			"paddedGasLimit", gasLimit,
 			"srcTxHash", event.Raw.TxHash.Hex(),
 		)
 
-		if gasUsed > gasLimit {
-			return nil, relayer.ErrUnprofitable
-		}
-
-		estimatedMaxCost = gasUsed * ((baseFee.Uint64() * 2) + gasTipCap.Uint64())
+		estimatedMaxCost = estimate.Cost
 	}
 
 	// we should check event status one more time, after we have waiting for
@@ -521,6 +518,8 @@ func (p *Processor) sendProcessMessageCall(
 		return nil, errUnprocessable
 	}
 
+	p.notifyProcessable(ctx, event)
+
 	candidate := txmgr.TxCandidate{
 		TxData:   data,
 		Blobs:    nil,
@@ -528,7 +527,15 @@ func (p *Processor) sendProcessMessageCall(
 		GasLimit: gasLimit,
 	}
 
-	receipt, err := p.txmgr.Send(ctx, candidate)
+	var receipt *types.Receipt
+
+	if event.Message.GasLimit == 0 {
+		// only the message owner, the processor key, can process a message with no gasLimit.
+		receipt, err = p.keyPool.sendFrom(ctx, p.relayerAddr, candidate)
+	} else {
+		receipt, err = p.keyPool.send(ctx, candidate)
+	}
+
 	if err != nil {
 		slog.Warn("Failed to send ProcessMessage transaction", "error", err.Error())
 		return nil, err
@@ -551,7 +558,7 @@ func (p *Processor) sendProcessMessageCall(
 	relayer.MessageSentEventsProcessed.Inc()
 
 	if p.profitableOnly {
-		cost := receipt.GasUsed * receipt.EffectiveGasPrice.Uint64()
+		cost := new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), receipt.EffectiveGasPrice)
 
 		slog.Info("tx cost", "txHash", hex.EncodeToString(receipt.TxHash.Bytes()),
 			"srcTxHash", event.Raw.TxHash.Hex(),
@@ -559,7 +566,7 @@ func (p *Processor) sendProcessMessageCall(
 			"estimatedMaxCost", estimatedMaxCost,
 		)
 
-		if cost > estimatedMaxCost {
+		if cost.Cmp(estimatedMaxCost) > 0 {
 			relayer.UnprofitableMessageAfterTransacting.Inc()
 		} else {
 			relayer.ProfitableMessageAfterTransacting.Inc()
@@ -644,32 +651,3 @@ func (p *Processor) saveMessageStatusChangedEvent(
 
 	return nil
 }
-
-// getBaseFee determines the baseFee on the dest chain
-func (p *Processor) getBaseFee(ctx context.Context) (*big.Int, error) {
-	blk, err := p.destEthClient.BlockByNumber(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	var baseFee *big.Int
-
-	if p.taikoL2 != nil {
-		latestL2Block, err := p.destEthClient.BlockByNumber(ctx, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		bf, err := p.taikoL2.GetBasefee(&bind.CallOpts{Context: ctx}, blk.NumberU64(), uint32(latestL2Block.GasUsed()))
-		if err != nil {
-			return nil, err
-		}
-
-		baseFee = bf.Basefee
-	} else {
-		cfg := params.NetworkIDToChainConfigOrDefault(p.destChainId)
-		baseFee = eip1559.CalcBaseFee(cfg, blk.Header())
-	}
-
-	return baseFee, nil
-}
@@ -3,8 +3,10 @@ package processor
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -47,7 +49,7 @@ func (p *Processor) processSingle(ctx context.Context) error {
 				return err
 			}
 
-			if _, _, err := p.processMessage(ctx, queue.Message{
+			if err := p.processSingleMessage(ctx, queue.Message{
 				Body: marshalledMsg,
 			}); err != nil {
 				return err
@@ -57,3 +59,22 @@ func (p *Processor) processSingle(ctx context.Context) error {
 
 	return nil
 }
+
+// processSingleMessage processes the given message, waiting for the destination quota
+// while the message is quota-limited, since there is no queue to delay it in.
+func (p *Processor) processSingleMessage(ctx context.Context, msg queue.Message) error {
+	for {
+		_, _, err := p.processMessage(ctx, msg)
+
+		var quotaLimited *errQuotaLimited
+		if !errors.As(err, &quotaLimited) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(quotaLimited.delay):
+		}
+	}
+}
@@ -14,14 +14,11 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
-	"github.com/ethereum-optimism/optimism/op-service/txmgr"
-	txmgrMetrics "github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
-	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/urfave/cli/v2"
 
@@ -33,10 +30,12 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/quotamanager"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/signalservice"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/taikol2"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/feeoracle"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/proof"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/repo"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/utils"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/webhook"
 )
 
 // ethClient is a slimmed down interface of a go-ethereum ethclient.Client
@@ -94,8 +93,13 @@ type Processor struct {
 	destERC721Vault  relayer.TokenVault
 	destQuotaManager relayer.QuotaManager
 
+	quotaScheduler *quotaScheduler
+
 	prover *proof.Prover
 
+	// webhookNotifier is nil when webhooks are disabled.
+	webhookNotifier *webhook.Notifier
+
 	relayerAddr             common.Address
 	srcSignalServiceAddress common.Address
 
@@ -117,13 +121,11 @@ type Processor struct {
 	srcChainId  *big.Int
 	destChainId *big.Int
 
-	taikoL2 *taikol2.TaikoL2
-
 	targetTxHash *common.Hash // optional, set to target processing a specific txHash only
 
 	cfg *Config
 
-	txmgr txmgr.TxManager
+	keyPool *keyPool
 
 	maxMessageRetries uint64
 
@@ -131,6 +133,12 @@ type Processor struct {
 	processingTxHashMu sync.Mutex
 
 	minFeeToProcess uint64
+
+	feeOracle feeoracle.FeeOracle
+
+	routeName string
+	// concurrencySem limits the number of messages processed concurrently, nil means no limit.
+	concurrencySem chan struct{}
 }
 
 // InitFromCli creates a new processor from a cli context
@@ -248,6 +256,7 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 		}
 
 		p.destQuotaManager = destQuotaManager
+		p.quotaScheduler = newQuotaScheduler(destQuotaManager)
 	}
 
 	var destERC721Vault *erc721vault.ERC721Vault
@@ -298,14 +307,11 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 
 	relayerAddr := crypto.PubkeyToAddress(*publicKeyECDSA)
 
-	var taikoL2 *taikol2.TaikoL2
+	var destTaikoL2 feeoracle.TaikoL2
 	if cfg.EnableTaikoL2 {
-		taikoL2, err = taikol2.NewTaikoL2(cfg.DestTaikoAddress, destEthClient)
-		if err != nil {
+		if destTaikoL2, err = taikol2.NewTaikoL2(cfg.DestTaikoAddress, destEthClient); err != nil {
 			return err
 		}
-
-		p.taikoL2 = taikoL2
 	}
 
 	var q queue.Queue
@@ -316,15 +322,24 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 		}
 	}
 
-	if p.txmgr, err = txmgr.NewSimpleTxManager(
-		"processor",
-		log.Root(),
-		new(txmgrMetrics.NoopTxMetrics),
-		*cfg.TxmgrConfigs,
-	); err != nil {
+	if cfg.keyPool != nil {
+		p.keyPool = cfg.keyPool
+	} else if p.keyPool, err = newKeyPoolFromConfig("processor", cfg); err != nil {
 		return err
 	}
 
+	if cfg.WebhooksEnabled {
+		webhookRepository, err := repo.NewWebhookRepository(db)
+		if err != nil {
+			return err
+		}
+
+		// the notifier only saves the deliveries, which are delivered by the indexers.
+		if p.webhookNotifier, err = webhook.New(webhook.Opts{Repo: webhookRepository}); err != nil {
+			return err
+		}
+	}
+
 	p.hops = hops
 	p.prover = prover
 	p.eventRepo = eventRepository
@@ -370,6 +385,32 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 
 	p.minFeeToProcess = p.cfg.MinFeeToProcess
 
+	hopEthClients := make([]feeoracle.BlockClient, 0, len(hops))
+	for _, hop := range hops {
+		hopEthClients = append(hopEthClients, hop.ethClient)
+	}
+
+	if p.feeOracle, err = feeoracle.NewForRoute(feeoracle.RouteOpts{
+		SrcEthClient:  srcEthClient,
+		DestEthClient: destEthClient,
+		DestChainID:   destChainID,
+		HopEthClients: hopEthClients,
+		DestTaikoL2:   destTaikoL2,
+		Simulate:      true,
+		Margin:        cfg.FeeOracleMargin,
+	}); err != nil {
+		return err
+	}
+
+	p.routeName = cfg.RouteName
+	if p.routeName == "" {
+		p.routeName = fmt.Sprintf("%v-%v", srcChainID.String(), destChainID.String())
+	}
+
+	if cfg.Concurrency != 0 {
+		p.concurrencySem = make(chan struct{}, cfg.Concurrency)
+	}
+
 	slog.Info("minFeeToProcess", "minFeeToProcess", p.minFeeToProcess)
 
 	return nil
@@ -380,9 +421,9 @@ func (p *Processor) Name() string {
 }
 
 func (p *Processor) Close(ctx context.Context) {
-	p.cancel()
+	p.stop()
 
-	p.wg.Wait()
+	p.keyPool.close()
 
 	// Close db connection.
 	if err := p.eventRepo.Close(); err != nil {
@@ -390,6 +431,17 @@ func (p *Processor) Close(ctx context.Context) {
 	}
 }
 
+// stop stops processing the messages, and waits for the ones being processed.
+func (p *Processor) stop() {
+	if p.cancel == nil {
+		return
+	}
+
+	p.cancel()
+
+	p.wg.Wait()
+}
+
 func (p *Processor) Start() error {
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -428,6 +480,8 @@ func (p *Processor) Start() error {
 		}
 	}()
 
+	p.keyPool.startBalanceMonitor(ctx, p.destEthClient, p.cfg.KeyPoolBalanceCheckInterval, &p.wg)
+
 	go p.eventLoop(ctx)
 
 	go func() {
@@ -456,10 +510,41 @@ func (p *Processor) eventLoop(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case msg := <-p.msgCh:
+			// wait for a free slot if the concurrency is limited, this also stops receiving
+			// new messages from the queue subscription.
+			if p.concurrencySem != nil {
+				select {
+				case <-ctx.Done():
+					return
+				case p.concurrencySem <- struct{}{}:
+				}
+			}
+
+			relayer.ProcessorInflightMessages.WithLabelValues(p.routeName).Inc()
+
 			go func(m queue.Message) {
+				defer func() {
+					relayer.ProcessorInflightMessages.WithLabelValues(p.routeName).Dec()
+
+					if p.concurrencySem != nil {
+						<-p.concurrencySem
+					}
+				}()
+
 				shouldRequeue, timesRetried, err := p.processMessage(ctx, m)
 
+				// a quota-limited message is not failed, it is delayed until the quota is
+				// predicted to be available.
+				var quotaLimited *errQuotaLimited
+				if errors.As(err, &quotaLimited) {
+					p.delayMessage(ctx, m, quotaLimited.delay)
+
+					return
+				}
+
 				if err != nil {
+					relayer.ProcessorRouteMessagesFailed.WithLabelValues(p.routeName).Inc()
+
 					switch {
 					case errors.Is(err, errUnprocessable):
 						if err := p.queue.Ack(ctx, m); err != nil {
@@ -474,7 +559,7 @@ func (p *Processor) eventLoop(ctx context.Context) {
 
 						if err := p.queue.Publish(
 							ctx,
-							fmt.Sprintf("%v-unprofitable", p.queueName()),
+							queue.UnprofitableQueueName(p.queueName()),
 							m.Body,
 							headers,
 							p.cfg.UnprofitableMessageQueueExpiration,
@@ -508,6 +593,8 @@ func (p *Processor) eventLoop(ctx context.Context) {
 					return
 				}
 
+				relayer.ProcessorRouteMessagesProcessed.WithLabelValues(p.routeName).Inc()
+
 				if shouldRequeue {
 					// we want to negatively acknowledge the message
 					if err := p.queue.Nack(ctx, m, true); err != nil {
@@ -532,3 +619,28 @@ func (p *Processor) eventLoop(ctx context.Context) {
 		}
 	}
 }
+
+// delayMessage publishes the given message to the delay queue of the delay, which routes it back
+// to the processing queue once the delay expires, and acknowledges it on the processing queue.
+func (p *Processor) delayMessage(ctx context.Context, m queue.Message, delay time.Duration) {
+	if err := p.queue.Publish(
+		ctx,
+		queue.DelayQueueName(p.queueName(), queue.DelayBucket(delay)),
+		m.Body,
+		nil,
+		nil,
+	); err != nil {
+		slog.Error("error publishing delayed message", "error", err)
+
+		// requeue the message, so it is not lost.
+		if err := p.queue.Nack(ctx, m, true); err != nil {
+			slog.Error("Err nacking message", "err", err.Error())
+		}
+
+		return
+	}
+
+	if err := p.queue.Ack(ctx, m); err != nil {
+		slog.Error("Err acking message", "err", err.Error())
+	}
+}
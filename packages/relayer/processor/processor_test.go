@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/feeoracle"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/proof"
 )
@@ -20,7 +21,7 @@ func newTestProcessor(profitableOnly bool) *Processor {
 		encoding.CACHE_NOTHING,
 	)
 
-	return &Processor{
+	p := &Processor{
 		eventRepo:                 &mock.EventRepository{},
 		destBridge:                &mock.Bridge{},
 		srcEthClient:              &mock.EthClient{},
@@ -28,6 +29,7 @@ func newTestProcessor(profitableOnly bool) *Processor {
 		destERC20Vault:            &mock.TokenVault{},
 		srcSignalService:          &mock.SignalService{},
 		ecdsaKey:                  privateKey,
+		relayerAddr:               crypto.PubkeyToAddress(privateKey.PublicKey),
 		prover:                    prover,
 		srcCaller:                 &mock.Caller{},
 		profitableOnly:            profitableOnly,
@@ -40,7 +42,6 @@ func newTestProcessor(profitableOnly bool) *Processor {
 		ethClientTimeout:          10 * time.Second,
 		srcChainId:                mock.MockChainID,
 		destChainId:               mock.MockChainID,
-		txmgr:                     &mock.TxManager{},
 		cfg: &Config{
 			DestBridgeAddress: common.HexToAddress("0xC4279588B8dA563D264e286E2ee7CE8c244444d6"),
 		},
@@ -48,4 +49,21 @@ func newTestProcessor(profitableOnly bool) *Processor {
 		destQuotaManager:   &mock.QuotaManager{},
 		processingTxHashes: make(map[common.Hash]bool, 0),
 	}
+
+	p.quotaScheduler = newQuotaScheduler(p.destQuotaManager)
+
+	p.keyPool, _ = newKeyPool(
+		KeyPoolStrategyRoundRobin,
+		nil,
+		&poolKey{address: p.relayerAddr, txmgr: &mock.TxManager{}},
+	)
+
+	p.feeOracle, _ = feeoracle.NewForRoute(feeoracle.RouteOpts{
+		SrcEthClient:  p.srcEthClient,
+		DestEthClient: p.destEthClient,
+		DestChainID:   p.destChainId,
+		Simulate:      true,
+	})
+
+	return p
 }
@@ -0,0 +1,261 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+// quotaRateRefreshInterval is how long the quota and period of a token are cached for.
+var quotaRateRefreshInterval = 5 * time.Minute
+
+// errQuotaLimited is returned when a message can not be processed until enough destination
+// quota is available, the message should be delayed by the given delay.
+type errQuotaLimited struct {
+	delay time.Duration
+}
+
+func (e *errQuotaLimited) Error() string {
+	return fmt.Sprintf("quota not available, delaying message by %v", e.delay)
+}
+
+// quotaRate is the quota of a token, which fully refills every period.
+type quotaRate struct {
+	quota     *big.Int
+	period    uint64
+	fetchedAt time.Time
+}
+
+// waitingMessage is a message delayed until enough quota is available.
+type waitingMessage struct {
+	amount  *big.Int
+	readyAt time.Time
+}
+
+// quotaScheduler schedules the processing of the messages limited by the destination chain
+// quota. It tracks the refill rate of each token's quota, predicts when enough quota will be
+// available for a message, and orders the waiting messages of a token by amount, so smaller
+// messages are not blocked behind a larger one.
+type quotaScheduler struct {
+	quotaManager relayer.QuotaManager
+
+	mu      sync.Mutex
+	rates   map[common.Address]*quotaRate
+	waiting map[common.Address]map[common.Hash]*waitingMessage
+
+	now func() time.Time
+}
+
+func newQuotaScheduler(quotaManager relayer.QuotaManager) *quotaScheduler {
+	return &quotaScheduler{
+		quotaManager: quotaManager,
+		rates:        make(map[common.Address]*quotaRate),
+		waiting:      make(map[common.Address]map[common.Hash]*waitingMessage),
+		now:          time.Now,
+	}
+}
+
+// schedule returns how long the given message has to be delayed until the quota of the token
+// covers its amount, and the amounts of the smaller messages waiting for the same quota.
+// A zero delay means the message can be processed now.
+func (s *quotaScheduler) schedule(
+	ctx context.Context,
+	tokenAddress common.Address,
+	msgHash common.Hash,
+	amount *big.Int,
+) (time.Duration, error) {
+	available, err := s.quotaManager.AvailableQuota(&bind.CallOpts{
+		Context: ctx,
+	}, tokenAddress, common.Big0)
+	if err != nil {
+		return 0, err
+	}
+
+	// the token has no quota.
+	if available.Cmp(math.MaxBig256) == 0 {
+		return 0, nil
+	}
+
+	rate, err := s.rate(ctx, tokenAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	defer s.updateMetrics(tokenAddress)
+
+	s.prune(tokenAddress, rate)
+
+	waiting := s.waiting[tokenAddress]
+	if waiting == nil {
+		waiting = make(map[common.Hash]*waitingMessage)
+		s.waiting[tokenAddress] = waiting
+	}
+
+	// the message can never fit the quota, we check again every period in case it is raised.
+	if amount.Cmp(rate.quota) > 0 {
+		delay := time.Duration(rate.period) * time.Second
+
+		waiting[msgHash] = &waitingMessage{
+			amount:  amount,
+			readyAt: s.now().Add(delay),
+		}
+
+		relayer.ProcessorQuotaDelayedMessages.Inc()
+
+		slog.Warn("amount exceeds the token quota",
+			"tokenAddress", tokenAddress.Hex(),
+			"msgHash", msgHash.Hex(),
+			"amount", amount.String(),
+			"quota", rate.quota.String(),
+		)
+
+		return delay, nil
+	}
+
+	// the smaller waiting messages are processed first.
+	needed := new(big.Int).Set(amount)
+
+	for hash, m := range waiting {
+		if hash == msgHash {
+			continue
+		}
+
+		if cmp := m.amount.Cmp(amount); cmp < 0 || (cmp == 0 && hash.Big().Cmp(msgHash.Big()) < 0) {
+			needed.Add(needed, m.amount)
+		}
+	}
+
+	slog.Info("available quota",
+		"tokenAddress", tokenAddress.Hex(),
+		"available", available.String(),
+		"required", amount.String(),
+		"needed", needed.String(),
+	)
+
+	if available.Cmp(needed) >= 0 {
+		delete(waiting, msgHash)
+
+		return 0, nil
+	}
+
+	// the quota refills linearly, by quota / period every second.
+	missing := new(big.Int).Sub(needed, available)
+
+	seconds := new(big.Int).Mul(missing, new(big.Int).SetUint64(rate.period))
+	seconds.Add(seconds, rate.quota)
+	seconds.Sub(seconds, common.Big1)
+	seconds.Div(seconds, rate.quota)
+
+	// the waiting messages are re-evaluated at least every period, as the messages ahead
+	// may have been processed elsewhere, or the quota may have been raised.
+	if seconds.Cmp(new(big.Int).SetUint64(rate.period)) > 0 {
+		seconds.SetUint64(rate.period)
+	}
+
+	delay := time.Duration(seconds.Int64()) * time.Second
+	if delay < time.Second {
+		delay = time.Second
+	}
+
+	waiting[msgHash] = &waitingMessage{
+		amount:  amount,
+		readyAt: s.now().Add(delay),
+	}
+
+	relayer.ProcessorQuotaDelayedMessages.Inc()
+
+	return delay, nil
+}
+
+// rate returns the quota and period of the given token, which are cached for
+// quotaRateRefreshInterval.
+func (s *quotaScheduler) rate(ctx context.Context, tokenAddress common.Address) (*quotaRate, error) {
+	s.mu.Lock()
+	rate, ok := s.rates[tokenAddress]
+	s.mu.Unlock()
+
+	if ok && s.now().Sub(rate.fetchedAt) < quotaRateRefreshInterval {
+		return rate, nil
+	}
+
+	period, err := s.quotaManager.QuotaPeriod(&bind.CallOpts{
+		Context: ctx,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the quota fully refills every period, so the quota available a period from now
+	// is the whole quota of the token.
+	quota, err := s.quotaManager.AvailableQuota(&bind.CallOpts{
+		Context: ctx,
+	}, tokenAddress, period)
+	if err != nil {
+		return nil, err
+	}
+
+	if period.Sign() == 0 || quota.Sign() == 0 {
+		return nil, fmt.Errorf("invalid quota %v for period %v", quota, period)
+	}
+
+	rate = &quotaRate{
+		quota:     quota,
+		period:    period.Uint64(),
+		fetchedAt: s.now(),
+	}
+
+	s.mu.Lock()
+	s.rates[tokenAddress] = rate
+	s.mu.Unlock()
+
+	return rate, nil
+}
+
+// prune removes the waiting messages which did not come back a period after they were
+// ready, e.g. because they were processed by another relayer.
+func (s *quotaScheduler) prune(tokenAddress common.Address, rate *quotaRate) {
+	now := s.now()
+
+	for hash, m := range s.waiting[tokenAddress] {
+		if now.After(m.readyAt.Add(time.Duration(rate.period) * time.Second)) {
+			delete(s.waiting[tokenAddress], hash)
+		}
+	}
+}
+
+// updateMetrics exports the quota-limited backlog of the given token.
+func (s *quotaScheduler) updateMetrics(tokenAddress common.Address) {
+	amount := new(big.Int)
+
+	var wait time.Duration
+
+	now := s.now()
+
+	for _, m := range s.waiting[tokenAddress] {
+		amount.Add(amount, m.amount)
+
+		if d := m.readyAt.Sub(now); d > wait {
+			wait = d
+		}
+	}
+
+	amountFloat, _ := new(big.Float).SetInt(amount).Float64()
+
+	token := tokenAddress.Hex()
+
+	relayer.ProcessorQuotaLimitedMessages.WithLabelValues(token).Set(float64(len(s.waiting[tokenAddress])))
+	relayer.ProcessorQuotaLimitedAmount.WithLabelValues(token).Set(amountFloat)
+	relayer.ProcessorQuotaWaitSeconds.WithLabelValues(token).Set(wait.Seconds())
+}
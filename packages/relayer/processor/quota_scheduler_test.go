@@ -0,0 +1,210 @@
+package processor
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+// refillingQuotaManager is a mock quota manager whose quota refills linearly over the period,
+// the same as the QuotaManager contract.
+type refillingQuotaManager struct {
+	quota     *big.Int
+	available *big.Int
+	period    uint64
+}
+
+func (q *refillingQuotaManager) AvailableQuota(
+	opts *bind.CallOpts,
+	_token common.Address,
+	_leap *big.Int,
+) (*big.Int, error) {
+	if q.quota == nil {
+		return math.MaxBig256, nil
+	}
+
+	issuance := new(big.Int).Mul(q.quota, _leap)
+	issuance.Div(issuance, new(big.Int).SetUint64(q.period))
+
+	available := issuance.Add(issuance, q.available)
+	if available.Cmp(q.quota) > 0 {
+		return new(big.Int).Set(q.quota), nil
+	}
+
+	return available, nil
+}
+
+func (q *refillingQuotaManager) QuotaPeriod(opts *bind.CallOpts) (*big.Int, error) {
+	return new(big.Int).SetUint64(q.period), nil
+}
+
+func newTestQuotaScheduler(available int64) *quotaScheduler {
+	return newQuotaScheduler(&refillingQuotaManager{
+		quota:     big.NewInt(1000),
+		available: big.NewInt(available),
+		period:    100,
+	})
+}
+
+func Test_quotaScheduler_schedule(t *testing.T) {
+	tests := []struct {
+		name      string
+		available int64
+		amount    int64
+		wantDelay time.Duration
+	}{
+		{
+			"available",
+			1000,
+			1000,
+			0,
+		},
+		{
+			"refillsInTwentySeconds",
+			100,
+			300,
+			20 * time.Second,
+		},
+		{
+			"roundsUp",
+			100,
+			101,
+			time.Second,
+		},
+		{
+			"exceedsQuota",
+			1000,
+			1001,
+			100 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestQuotaScheduler(tt.available)
+
+			delay, err := s.schedule(context.Background(), zeroAddress, common.HexToHash("0x1"), big.NewInt(tt.amount))
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantDelay, delay)
+		})
+	}
+}
+
+func Test_quotaScheduler_schedule_noQuota(t *testing.T) {
+	s := newQuotaScheduler(&refillingQuotaManager{})
+
+	delay, err := s.schedule(context.Background(), zeroAddress, common.HexToHash("0x1"), big.NewInt(1))
+	assert.Nil(t, err)
+	assert.Equal(t, time.Duration(0), delay)
+}
+
+func Test_quotaScheduler_schedule_ordersByAmount(t *testing.T) {
+	s := newTestQuotaScheduler(100)
+
+	large := common.HexToHash("0x1")
+	medium := common.HexToHash("0x2")
+	small := common.HexToHash("0x3")
+
+	// the large message waits for 800 to refill.
+	delay, err := s.schedule(context.Background(), zeroAddress, large, big.NewInt(900))
+	assert.Nil(t, err)
+	assert.Equal(t, 80*time.Second, delay)
+
+	// the medium message is not blocked behind the large one, it only waits for its own amount.
+	delay, err = s.schedule(context.Background(), zeroAddress, medium, big.NewInt(300))
+	assert.Nil(t, err)
+	assert.Equal(t, 20*time.Second, delay)
+
+	// the small message fits the available quota.
+	delay, err = s.schedule(context.Background(), zeroAddress, small, big.NewInt(50))
+	assert.Nil(t, err)
+	assert.Equal(t, time.Duration(0), delay)
+
+	// the large message waits for the medium message ahead of it.
+	delay, err = s.schedule(context.Background(), zeroAddress, large, big.NewInt(900))
+	assert.Nil(t, err)
+	assert.Equal(t, 100*time.Second, delay)
+
+	assert.Equal(t, 2, len(s.waiting[zeroAddress]))
+	assert.NotContains(t, s.waiting[zeroAddress], small)
+}
+
+func Test_quotaScheduler_prune(t *testing.T) {
+	s := newTestQuotaScheduler(100)
+
+	now := time.Now()
+	s.now = func() time.Time { return now }
+
+	waiting := common.HexToHash("0x1")
+
+	delay, err := s.schedule(context.Background(), zeroAddress, waiting, big.NewInt(300))
+	assert.Nil(t, err)
+	assert.Equal(t, 20*time.Second, delay)
+
+	// the waiting message never came back, e.g. it was processed by another relayer.
+	now = now.Add(delay + 101*time.Second)
+
+	delay, err = s.schedule(context.Background(), zeroAddress, common.HexToHash("0x2"), big.NewInt(500))
+	assert.Nil(t, err)
+	assert.Equal(t, 40*time.Second, delay)
+	assert.NotContains(t, s.waiting[zeroAddress], waiting)
+}
+
+// publishingQueue is a mock queue recording the published messages.
+type publishingQueue struct {
+	mock.Queue
+	queueNames  []string
+	expirations []string
+	acked       int
+}
+
+func (q *publishingQueue) Publish(
+	ctx context.Context,
+	queueName string,
+	msg []byte,
+	headers map[string]interface{},
+	expiration *string,
+) error {
+	q.queueNames = append(q.queueNames, queueName)
+
+	if expiration != nil {
+		q.expirations = append(q.expirations, *expiration)
+	}
+
+	return nil
+}
+
+func (q *publishingQueue) Ack(ctx context.Context, msg queue.Message) error {
+	q.acked++
+
+	return nil
+}
+
+func Test_delayMessage(t *testing.T) {
+	p := newTestProcessor(true)
+
+	q := &publishingQueue{}
+	p.queue = q
+
+	p.delayMessage(context.Background(), queue.Message{Body: []byte("{}")}, 20*time.Second)
+	p.delayMessage(context.Background(), queue.Message{Body: []byte("{}")}, 10*time.Minute)
+	p.delayMessage(context.Background(), queue.Message{Body: []byte("{}")}, 48*time.Hour)
+
+	// the messages are delayed in the delay queue of their bucket, with its queue-level TTL.
+	assert.Equal(t, []string{
+		queue.DelayQueueName(p.queueName(), time.Minute),
+		queue.DelayQueueName(p.queueName(), 15*time.Minute),
+		queue.DelayQueueName(p.queueName(), 24*time.Hour),
+	}, q.queueNames)
+	assert.Equal(t, 0, len(q.expirations))
+	assert.Equal(t, 3, q.acked)
+}
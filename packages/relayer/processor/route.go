@@ -0,0 +1,146 @@
+package processor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+// HopRoute is the configuration of an intermediary hop of a route.
+type HopRoute struct {
+	SignalServiceAddress common.Address `json:"signalServiceAddress"`
+	TaikoAddress         common.Address `json:"taikoAddress"`
+	RPCUrl               string         `json:"rpcUrl"`
+}
+
+// Route is an entry of the route table of a multi-route processor, which describes how
+// to process the messages sent from one source chain to one destination chain.
+type Route struct {
+	Name                    string         `json:"name"`
+	SrcRPCUrl               string         `json:"srcRpcUrl"`
+	DestRPCUrl              string         `json:"destRpcUrl"`
+	SrcSignalServiceAddress common.Address `json:"srcSignalServiceAddress"`
+	DestBridgeAddress       common.Address `json:"destBridgeAddress"`
+	DestERC20VaultAddress   common.Address `json:"destERC20VaultAddress"`
+	DestERC721VaultAddress  common.Address `json:"destERC721VaultAddress"`
+	DestERC1155VaultAddress common.Address `json:"destERC1155VaultAddress"`
+	DestTaikoAddress        common.Address `json:"destTaikoAddress"`
+	DestQuotaManagerAddress common.Address `json:"destQuotaManagerAddress"`
+	EnableTaikoL2           bool           `json:"enableTaikoL2"`
+	Hops                    []HopRoute     `json:"hops"`
+	// Concurrency overrides the processor concurrency for this route if not zero.
+	Concurrency uint64 `json:"concurrency"`
+}
+
+// LoadRoutes loads and validates the route table in the given JSON file.
+func LoadRoutes(path string) ([]Route, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var routes []Route
+	if err := json.Unmarshal(data, &routes); err != nil {
+		return nil, fmt.Errorf("invalid routes file %s: %w", path, err)
+	}
+
+	if len(routes) == 0 {
+		return nil, fmt.Errorf("no routes in %s", path)
+	}
+
+	names := make(map[string]bool, len(routes))
+
+	// the messages of a route are the ones sent by its source signal service to its destination
+	// bridge, two routes with the same ones would process the same messages.
+	paths := make(map[string]string, len(routes))
+
+	for i, r := range routes {
+		if r.Name == "" {
+			return nil, fmt.Errorf("route %d: name is required", i)
+		}
+
+		if names[r.Name] {
+			return nil, fmt.Errorf("route %s: duplicate name", r.Name)
+		}
+
+		names[r.Name] = true
+
+		if r.SrcRPCUrl == "" || r.DestRPCUrl == "" {
+			return nil, fmt.Errorf("route %s: srcRpcUrl and destRpcUrl are required", r.Name)
+		}
+
+		for field, addr := range map[string]common.Address{
+			"srcSignalServiceAddress": r.SrcSignalServiceAddress,
+			"destBridgeAddress":       r.DestBridgeAddress,
+			"destERC20VaultAddress":   r.DestERC20VaultAddress,
+			"destTaikoAddress":        r.DestTaikoAddress,
+		} {
+			if addr == relayer.ZeroAddress {
+				return nil, fmt.Errorf("route %s: %s is required", r.Name, field)
+			}
+		}
+
+		for _, path := range []string{
+			fmt.Sprintf("%v->%v", r.SrcRPCUrl, r.DestRPCUrl),
+			fmt.Sprintf("%v->%v", r.SrcSignalServiceAddress.Hex(), r.DestBridgeAddress.Hex()),
+		} {
+			if name, ok := paths[path]; ok {
+				return nil, fmt.Errorf("route %s: duplicate of route %s", r.Name, name)
+			}
+
+			paths[path] = r.Name
+		}
+
+		for j, h := range r.Hops {
+			if h.RPCUrl == "" || h.SignalServiceAddress == relayer.ZeroAddress {
+				return nil, fmt.Errorf("route %s: hop %d requires rpcUrl and signalServiceAddress", r.Name, j)
+			}
+		}
+	}
+
+	return routes, nil
+}
+
+// config returns the processor config of this route, based on the given shared config.
+func (r Route) config(base *Config) *Config {
+	cfg := *base
+
+	cfg.RouteName = r.Name
+	cfg.SrcRPCUrl = r.SrcRPCUrl
+	cfg.DestRPCUrl = r.DestRPCUrl
+	cfg.SrcSignalServiceAddress = r.SrcSignalServiceAddress
+	cfg.DestBridgeAddress = r.DestBridgeAddress
+	cfg.DestERC20VaultAddress = r.DestERC20VaultAddress
+	cfg.DestERC721VaultAddress = r.DestERC721VaultAddress
+	cfg.DestERC1155VaultAddress = r.DestERC1155VaultAddress
+	cfg.DestTaikoAddress = r.DestTaikoAddress
+	cfg.DestQuotaManagerAddress = r.DestQuotaManagerAddress
+	cfg.EnableTaikoL2 = r.EnableTaikoL2
+	// a multi-route processor always consumes from the queues.
+	cfg.TargetTxHash = nil
+
+	if r.Concurrency != 0 {
+		cfg.Concurrency = r.Concurrency
+	}
+
+	cfg.hopConfigs = []hopConfig{}
+	for _, h := range r.Hops {
+		cfg.hopConfigs = append(cfg.hopConfigs, hopConfig{
+			signalServiceAddress: h.SignalServiceAddress,
+			taikoAddress:         h.TaikoAddress,
+			rpcURL:               h.RPCUrl,
+		})
+	}
+
+	if base.TxmgrConfigs != nil {
+		txmgrConfigs := *base.TxmgrConfigs
+		txmgrConfigs.L1RPCURL = r.DestRPCUrl
+		cfg.TxmgrConfigs = &txmgrConfigs
+	}
+
+	return &cfg
+}
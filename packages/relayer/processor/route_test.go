@@ -0,0 +1,142 @@
+package processor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+var testRoutes = `[
+	{
+		"name": "l1-to-l2a",
+		"srcRpcUrl": "http://l1",
+		"destRpcUrl": "http://l2a",
+		"srcSignalServiceAddress": "0x0000000000000000000000000000000000000001",
+		"destBridgeAddress": "0x0000000000000000000000000000000000000002",
+		"destERC20VaultAddress": "0x0000000000000000000000000000000000000003",
+		"destTaikoAddress": "0x0000000000000000000000000000000000000004",
+		"enableTaikoL2": true,
+		"concurrency": 4
+	},
+	{
+		"name": "l2a-to-l2b",
+		"srcRpcUrl": "http://l2a",
+		"destRpcUrl": "http://l2b",
+		"srcSignalServiceAddress": "0x0000000000000000000000000000000000000005",
+		"destBridgeAddress": "0x0000000000000000000000000000000000000006",
+		"destERC20VaultAddress": "0x0000000000000000000000000000000000000007",
+		"destTaikoAddress": "0x0000000000000000000000000000000000000008",
+		"hops": [
+			{
+				"signalServiceAddress": "0x0000000000000000000000000000000000000009",
+				"taikoAddress": "0x000000000000000000000000000000000000000a",
+				"rpcUrl": "http://l1"
+			}
+		]
+	}
+]`
+
+func writeRoutesFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "routes.json")
+	assert.Nil(t, os.WriteFile(path, []byte(content), 0600))
+
+	return path
+}
+
+func Test_LoadRoutes(t *testing.T) {
+	routes, err := LoadRoutes(writeRoutesFile(t, testRoutes))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(routes))
+	assert.Equal(t, "l1-to-l2a", routes[0].Name)
+	assert.Equal(t, uint64(4), routes[0].Concurrency)
+	assert.Equal(t, 1, len(routes[1].Hops))
+	assert.Equal(t, "http://l1", routes[1].Hops[0].RPCUrl)
+}
+
+func Test_LoadRoutes_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"empty", `[]`, "no routes"},
+		{"noName", `[{"srcRpcUrl": "http://l1"}]`, "name is required"},
+		{"duplicateName", `[
+			{"name": "a", "srcRpcUrl": "http://l1", "destRpcUrl": "http://l2",
+			 "srcSignalServiceAddress": "0x0000000000000000000000000000000000000001",
+			 "destBridgeAddress": "0x0000000000000000000000000000000000000002",
+			 "destERC20VaultAddress": "0x0000000000000000000000000000000000000003",
+			 "destTaikoAddress": "0x0000000000000000000000000000000000000004"},
+			{"name": "a"}
+		]`, "duplicate name"},
+		{"duplicateChains", `[
+			{"name": "a", "srcRpcUrl": "http://l1", "destRpcUrl": "http://l2",
+			 "srcSignalServiceAddress": "0x0000000000000000000000000000000000000001",
+			 "destBridgeAddress": "0x0000000000000000000000000000000000000002",
+			 "destERC20VaultAddress": "0x0000000000000000000000000000000000000003",
+			 "destTaikoAddress": "0x0000000000000000000000000000000000000004"},
+			{"name": "b", "srcRpcUrl": "http://l1", "destRpcUrl": "http://l2",
+			 "srcSignalServiceAddress": "0x0000000000000000000000000000000000000005",
+			 "destBridgeAddress": "0x0000000000000000000000000000000000000006",
+			 "destERC20VaultAddress": "0x0000000000000000000000000000000000000003",
+			 "destTaikoAddress": "0x0000000000000000000000000000000000000004"}
+		]`, "route b: duplicate of route a"},
+		{"duplicateContracts", `[
+			{"name": "a", "srcRpcUrl": "http://l1", "destRpcUrl": "http://l2",
+			 "srcSignalServiceAddress": "0x0000000000000000000000000000000000000001",
+			 "destBridgeAddress": "0x0000000000000000000000000000000000000002",
+			 "destERC20VaultAddress": "0x0000000000000000000000000000000000000003",
+			 "destTaikoAddress": "0x0000000000000000000000000000000000000004"},
+			{"name": "b", "srcRpcUrl": "http://l1-backup", "destRpcUrl": "http://l2",
+			 "srcSignalServiceAddress": "0x0000000000000000000000000000000000000001",
+			 "destBridgeAddress": "0x0000000000000000000000000000000000000002",
+			 "destERC20VaultAddress": "0x0000000000000000000000000000000000000003",
+			 "destTaikoAddress": "0x0000000000000000000000000000000000000004"}
+		]`, "route b: duplicate of route a"},
+		{"noBridge", `[{"name": "a", "srcRpcUrl": "http://l1", "destRpcUrl": "http://l2",
+			 "srcSignalServiceAddress": "0x0000000000000000000000000000000000000001",
+			 "destERC20VaultAddress": "0x0000000000000000000000000000000000000003",
+			 "destTaikoAddress": "0x0000000000000000000000000000000000000004"}]`, "destBridgeAddress is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadRoutes(writeRoutesFile(t, tt.content))
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_Route_config(t *testing.T) {
+	routes, err := LoadRoutes(writeRoutesFile(t, testRoutes))
+	assert.Nil(t, err)
+
+	hash := common.HexToHash("0x1")
+	base := &Config{
+		Concurrency:  2,
+		TargetTxHash: &hash,
+		TxmgrConfigs: &txmgr.CLIConfig{L1RPCURL: "http://base"},
+	}
+
+	cfg := routes[0].config(base)
+	assert.Equal(t, "l1-to-l2a", cfg.RouteName)
+	assert.Equal(t, "http://l2a", cfg.DestRPCUrl)
+	assert.Equal(t, "http://l2a", cfg.TxmgrConfigs.L1RPCURL)
+	assert.Equal(t, uint64(4), cfg.Concurrency)
+	assert.True(t, cfg.EnableTaikoL2)
+	assert.Nil(t, cfg.TargetTxHash)
+	assert.Equal(t, 0, len(cfg.hopConfigs))
+
+	cfg = routes[1].config(base)
+	assert.Equal(t, uint64(2), cfg.Concurrency)
+	assert.Equal(t, 1, len(cfg.hopConfigs))
+	assert.Equal(t, common.HexToAddress("0x9"), cfg.hopConfigs[0].signalServiceAddress)
+
+	// the shared config should not be modified.
+	assert.Equal(t, "http://base", base.TxmgrConfigs.L1RPCURL)
+	assert.NotNil(t, base.TargetTxHash)
+}
@@ -150,4 +150,129 @@ var (
 		Name: "relayer_key_balance",
 		Help: "Current balance of the relayer key",
 	})
+	ProcessorKeyBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "processor_key_balance",
+		Help: "Current balance of a processor pool key",
+	}, []string{"address"})
+	ProcessorKeyPendingTxs = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "processor_key_pending_txs",
+		Help: "Current number of transactions being sent with a processor pool key",
+	}, []string{"address"})
+	ProcessorKeyRetired = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "processor_key_retired",
+		Help: "Whether a processor pool key is retired because its balance is below the min balance",
+	}, []string{"address"})
+	ProcessorQuotaLimitedMessages = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "processor_quota_limited_messages",
+		Help: "Current number of messages waiting for the destination quota of a token",
+	}, []string{"token"})
+	ProcessorQuotaLimitedAmount = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "processor_quota_limited_amount",
+		Help: "Current total amount of the messages waiting for the destination quota of a token",
+	}, []string{"token"})
+	ProcessorQuotaWaitSeconds = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "processor_quota_wait_seconds",
+		Help: "Current longest predicted wait of the messages waiting for the destination quota of a token",
+	}, []string{"token"})
+	ProcessorQuotaDelayedMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "processor_quota_delayed_messages_ops_total",
+		Help: "The total number of messages delayed until enough destination quota is available",
+	})
+	ProcessorRouteMessagesProcessed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "processor_route_messages_processed_ops_total",
+		Help: "The total number of queue messages processed without error, by processor route",
+	}, []string{"route"})
+	ProcessorRouteMessagesFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "processor_route_messages_failed_ops_total",
+		Help: "The total number of queue messages failed to process, by processor route",
+	}, []string{"route"})
+	ProcessorInflightMessages = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "processor_inflight_messages",
+		Help: "Current number of queue messages being processed, by processor route",
+	}, []string{"route"})
+	WebhookNotificationsQueued = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "webhook_notifications_queued_ops_total",
+		Help: "The total number of webhook deliveries saved to the delivery log",
+	})
+	WebhookDeliveries = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "webhook_deliveries_ops_total",
+		Help: "The total number of webhook notifications delivered successfully",
+	})
+	WebhookDeliveryAttemptErrors = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "webhook_delivery_attempt_errors_ops_total",
+		Help: "The total number of webhook delivery attempts which failed",
+	})
+	WebhookDeliveriesFailed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "webhook_deliveries_failed_ops_total",
+		Help: "The total number of webhook deliveries abandoned after the max attempts",
+	})
+	RetrierMessagesRetried = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "retrier_messages_retried_ops_total",
+		Help: "The total number of retriable messages retried successfully",
+	})
+	RetrierRetryErrors = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "retrier_retry_errors_ops_total",
+		Help: "The total number of errors retrying retriable messages",
+	})
+	RetrierRecallProofsBuilt = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "retrier_recall_proofs_built_ops_total",
+		Help: "The total number of recall proofs built for failed messages",
+	})
+	ReorgsDetected = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "indexer_reorgs_detected_ops_total",
+		Help: "The total number of reorgs of the indexed blocks detected and rolled back by the indexer",
+	})
+	ReorgsTooDeep = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "indexer_reorgs_too_deep_ops_total",
+		Help: "The total number of reorgs deeper than the indexed block history, which need a resync",
+	})
+	ProcessorOrphanedMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "processor_orphaned_messages_ops_total",
+		Help: "The total number of queued messages dropped because their block was reorged out",
+	})
+	CanaryMessagesSent = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "canary_messages_sent_ops_total",
+		Help: "The total number of canary messages sent, by route",
+	}, []string{"route"})
+	CanaryStageLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "canary_stage_latency_seconds",
+		Help:    "Time for a canary message to complete each stage, and in total, by route",
+		Buckets: prometheus.ExponentialBuckets(5, 2, 12),
+	}, []string{"route", "stage"})
+	CanaryFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "canary_failures_ops_total",
+		Help: "The total number of canary messages which failed or timed out, by route and stage",
+	}, []string{"route", "stage"})
+	CanarySLOBreaches = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "canary_slo_breaches_ops_total",
+		Help: "The total number of canary messages not processed within the SLO, by route and stage",
+	}, []string{"route", "stage"})
+	CanaryInflightMessages = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "canary_inflight_messages",
+		Help: "Current number of canary messages followed, by route",
+	}, []string{"route"})
+	WatchdogInvariantViolations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "watchdog_invariant_violations_ops_total",
+		Help: "The total number of watchdog invariant violations, by invariant and action",
+	}, []string{"invariant", "action"})
+	WatchdogInvariantViolated = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "watchdog_invariant_violated",
+		Help: "Whether a periodic watchdog invariant is currently violated, by invariant",
+	}, []string{"invariant"})
+	WatchdogInvariantCheckErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "watchdog_invariant_check_errors_ops_total",
+		Help: "The total number of errors checking a watchdog invariant, by invariant",
+	}, []string{"invariant"})
+	WatchdogProposalsOpened = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "watchdog_proposals_opened_ops_total",
+		Help: "The total number of admin proposals opened by the watchdog, by invariant",
+	}, []string{"invariant"})
+	WatchdogIncidents = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "watchdog_incidents_ops_total",
+		Help: "The total number of watchdog incidents awaiting approval, by invariant and outcome",
+	}, []string{"invariant", "outcome"})
+	WatchdogOpenIncidents = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "watchdog_open_incidents",
+		Help: "Current number of watchdog incidents awaiting approval",
+	})
 )
@@ -0,0 +1,56 @@
+package relayer
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/morkid/paginate"
+	"gorm.io/datatypes"
+)
+
+// RecallableMessage is a message which failed on its destination chain, with a prebuilt proof
+// of its failure signal, so its owner can recall it on the source chain.
+type RecallableMessage struct {
+	ID          int    `json:"id"`
+	MsgHash     string `json:"msgHash"`
+	SrcChainID  uint64 `json:"srcChainID"`
+	DestChainID uint64 `json:"destChainID"`
+	SrcOwner    string `json:"srcOwner"`
+	// Message is the JSON encoded bridge message, the first argument of `recallMessage`.
+	Message datatypes.JSON `json:"message"`
+	// Proof is the hex encoded signal proof, the second argument of `recallMessage`.
+	Proof string `json:"proof"`
+	// ProofBlockID is the destination chain block the proof was generated at.
+	ProofBlockID uint64    `json:"proofBlockID"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+type SaveRecallableMessageOpts struct {
+	MsgHash      string
+	SrcChainID   uint64
+	DestChainID  uint64
+	SrcOwner     string
+	Message      string
+	Proof        string
+	ProofBlockID uint64
+}
+
+type FindAllRecallableMessagesOpts struct {
+	Address    common.Address
+	SrcChainID *uint64
+}
+
+// RecallableMessageRepository is used to interact with recallable messages in the store
+type RecallableMessageRepository interface {
+	Save(ctx context.Context, opts *SaveRecallableMessageOpts) error
+	FindAllByAddress(
+		ctx context.Context,
+		req *http.Request,
+		opts FindAllRecallableMessagesOpts,
+	) (*paginate.Page, error)
+	FindAllByRoute(ctx context.Context, srcChainID uint64, destChainID uint64) ([]*RecallableMessage, error)
+	DeleteByMsgHash(ctx context.Context, msgHash string) error
+}
@@ -0,0 +1,108 @@
+package retrier
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/urfave/cli/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	pkgFlags "github.com/taikoxyz/taiko-mono/packages/relayer/pkg/flags"
+)
+
+// Config is a struct used to initialize a retrier.
+type Config struct {
+	// address configs
+	DestBridgeAddress        common.Address
+	DestSignalServiceAddress common.Address
+	DestQuotaManagerAddress  common.Address
+
+	// private key
+	RetrierPrivateKey *ecdsa.PrivateKey
+
+	// Interval is the interval to re-evaluate the retriable and failed messages.
+	Interval time.Duration
+
+	// db configs
+	DatabaseUsername        string
+	DatabasePassword        string
+	DatabaseName            string
+	DatabaseHost            string
+	DatabaseDialect         string
+	DatabaseMaxIdleConns    uint64
+	DatabaseMaxOpenConns    uint64
+	DatabaseMaxConnLifetime uint64
+	// rpc configs
+	SrcRPCUrl  string
+	DestRPCUrl string
+	OpenDBFunc func() (db.DB, error)
+
+	TxmgrConfigs *txmgr.CLIConfig
+}
+
+// NewConfigFromCliContext creates a new config instance from command line flags.
+func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
+	retrierPrivateKey, err := crypto.ToECDSA(
+		common.Hex2Bytes(c.String(flags.RetrierPrivateKey.Name)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid retrierPrivateKey: %w", err)
+	}
+
+	var destQuotaManagerAddress common.Address
+	if c.IsSet(flags.DestQuotaManagerAddress.Name) {
+		destQuotaManagerAddress = common.HexToAddress(c.String(flags.DestQuotaManagerAddress.Name))
+	}
+
+	return &Config{
+		DestBridgeAddress:        common.HexToAddress(c.String(flags.DestBridgeAddress.Name)),
+		DestSignalServiceAddress: common.HexToAddress(c.String(flags.DestSignalServiceAddress.Name)),
+		DestQuotaManagerAddress:  destQuotaManagerAddress,
+		RetrierPrivateKey:        retrierPrivateKey,
+		Interval:                 c.Duration(flags.RetrierInterval.Name),
+		DatabaseUsername:         c.String(flags.DatabaseUsername.Name),
+		DatabasePassword:         c.String(flags.DatabasePassword.Name),
+		DatabaseName:             c.String(flags.DatabaseName.Name),
+		DatabaseHost:             c.String(flags.DatabaseHost.Name),
+		DatabaseDialect:          c.String(flags.DatabaseDialect.Name),
+		DatabaseMaxIdleConns:     c.Uint64(flags.DatabaseMaxIdleConns.Name),
+		DatabaseMaxOpenConns:     c.Uint64(flags.DatabaseMaxOpenConns.Name),
+		DatabaseMaxConnLifetime:  c.Uint64(flags.DatabaseConnMaxLifetime.Name),
+		SrcRPCUrl:                c.String(flags.SrcRPCUrl.Name),
+		DestRPCUrl:               c.String(flags.DestRPCUrl.Name),
+		TxmgrConfigs: pkgFlags.InitTxmgrConfigsFromCli(
+			c.String(flags.DestRPCUrl.Name),
+			retrierPrivateKey,
+			c,
+		),
+		OpenDBFunc: func() (db.DB, error) {
+			return db.OpenDBConnection(db.DBConnectionOpts{
+				Name:            c.String(flags.DatabaseUsername.Name),
+				Password:        c.String(flags.DatabasePassword.Name),
+				Database:        c.String(flags.DatabaseName.Name),
+				Host:            c.String(flags.DatabaseHost.Name),
+				Dialect:         c.String(flags.DatabaseDialect.Name),
+				MaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
+				MaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
+				MaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
+				OpenFunc: func(dialector gorm.Dialector) (db.DB, error) {
+					gormDB, err := gorm.Open(dialector, &gorm.Config{
+						Logger: logger.Default.LogMode(logger.Silent),
+					})
+					if err != nil {
+						return nil, err
+					}
+
+					return db.New(gormDB), nil
+				},
+			})
+		},
+	}, nil
+}
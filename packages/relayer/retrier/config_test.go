@@ -0,0 +1,93 @@
+package retrier
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
+)
+
+var (
+	destBridgeAddr          = "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD377"
+	destSignalServiceAddr   = "0x33FaC9201494f0bd17B9892B9fae4d52fe3BD377"
+	destQuotaManagerAddr    = "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD357"
+	databaseMaxIdleConns    = "10"
+	databaseMaxOpenConns    = "10"
+	databaseMaxConnLifetime = "30"
+)
+
+func setupApp() *cli.App {
+	app := cli.NewApp()
+	app.Flags = flags.RetrierFlags
+	app.Action = func(ctx *cli.Context) error {
+		_, err := NewConfigFromCliContext(ctx)
+		return err
+	}
+
+	return app
+}
+
+func TestNewConfigFromCliContext(t *testing.T) {
+	app := setupApp()
+
+	app.Action = func(ctx *cli.Context) error {
+		c, err := NewConfigFromCliContext(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, "dbuser", c.DatabaseUsername)
+		assert.Equal(t, "dbpass", c.DatabasePassword)
+		assert.Equal(t, "dbname", c.DatabaseName)
+		assert.Equal(t, "dbhost", c.DatabaseHost)
+		assert.Equal(t, "srcRpcUrl", c.SrcRPCUrl)
+		assert.Equal(t, "destRpcUrl", c.DestRPCUrl)
+		assert.Equal(t, common.HexToAddress(destBridgeAddr), c.DestBridgeAddress)
+		assert.Equal(t, common.HexToAddress(destSignalServiceAddr), c.DestSignalServiceAddress)
+		assert.Equal(t, common.HexToAddress(destQuotaManagerAddr), c.DestQuotaManagerAddress)
+		assert.Equal(t, uint64(10), c.DatabaseMaxIdleConns)
+		assert.Equal(t, uint64(10), c.DatabaseMaxOpenConns)
+		assert.Equal(t, uint64(30), c.DatabaseMaxConnLifetime)
+		assert.Equal(t, 5*time.Minute, c.Interval)
+		assert.NotNil(t, c.RetrierPrivateKey)
+		assert.NotNil(t, c.TxmgrConfigs)
+
+		return err
+	}
+
+	assert.Nil(t, app.Run([]string{
+		"TestNewConfigFromCliContext",
+		"--" + flags.DatabaseUsername.Name, "dbuser",
+		"--" + flags.DatabasePassword.Name, "dbpass",
+		"--" + flags.DatabaseHost.Name, "dbhost",
+		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.SrcRPCUrl.Name, "srcRpcUrl",
+		"--" + flags.DestRPCUrl.Name, "destRpcUrl",
+		"--" + flags.DestBridgeAddress.Name, destBridgeAddr,
+		"--" + flags.DestSignalServiceAddress.Name, destSignalServiceAddr,
+		"--" + flags.DestQuotaManagerAddress.Name, destQuotaManagerAddr,
+		"--" + flags.RetrierPrivateKey.Name, dummyEcdsaKey,
+		"--" + flags.RetrierInterval.Name, "5m",
+		"--" + flags.DatabaseMaxIdleConns.Name, databaseMaxIdleConns,
+		"--" + flags.DatabaseMaxOpenConns.Name, databaseMaxOpenConns,
+		"--" + flags.DatabaseConnMaxLifetime.Name, databaseMaxConnLifetime,
+	}))
+}
+
+func TestNewConfigFromCliContext_InvalidPrivateKey(t *testing.T) {
+	app := setupApp()
+
+	assert.ErrorContains(t, app.Run([]string{
+		"TestNewConfigFromCliContext",
+		"--" + flags.DatabaseUsername.Name, "dbuser",
+		"--" + flags.DatabasePassword.Name, "dbpass",
+		"--" + flags.DatabaseHost.Name, "dbhost",
+		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.SrcRPCUrl.Name, "srcRpcUrl",
+		"--" + flags.DestRPCUrl.Name, "destRpcUrl",
+		"--" + flags.DestBridgeAddress.Name, destBridgeAddr,
+		"--" + flags.DestSignalServiceAddress.Name, destSignalServiceAddr,
+		"--" + flags.RetrierPrivateKey.Name, "invalid",
+	}), "invalid retrierPrivateKey")
+}
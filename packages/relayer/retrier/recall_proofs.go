@@ -0,0 +1,178 @@
+package retrier
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/proof"
+)
+
+// buildRecallProofs builds the proofs of the failure signals of the failed messages of the route,
+// so their owner can recall them on the source chain. Messages which are no longer failed, e.g.
+// because they were recalled, are removed from the recallable messages.
+//
+// Only direct routes are supported, the failure signal is proven against the destination chain
+// data synced to the source chain, without intermediary hops.
+func (r *Retrier) buildRecallProofs(ctx context.Context) error {
+	failed, err := r.eventRepo.FindAllMessageSentByLatestStatus(
+		ctx,
+		r.srcChainID.Uint64(),
+		r.destChainID.Uint64(),
+		relayer.EventStatusFailed,
+	)
+	if err != nil {
+		return errors.Wrap(err, "r.eventRepo.FindAllMessageSentByLatestStatus")
+	}
+
+	existing, err := r.recallableMessageRepo.FindAllByRoute(ctx, r.srcChainID.Uint64(), r.destChainID.Uint64())
+	if err != nil {
+		return errors.Wrap(err, "r.recallableMessageRepo.FindAllByRoute")
+	}
+
+	failedMsgHashes := make(map[string]struct{}, len(failed))
+	for _, e := range failed {
+		failedMsgHashes[e.MsgHash] = struct{}{}
+	}
+
+	existingMsgHashes := make(map[string]struct{}, len(existing))
+
+	for _, m := range existing {
+		if _, ok := failedMsgHashes[m.MsgHash]; ok {
+			existingMsgHashes[m.MsgHash] = struct{}{}
+			continue
+		}
+
+		if err := r.recallableMessageRepo.DeleteByMsgHash(ctx, m.MsgHash); err != nil {
+			return errors.Wrap(err, "r.recallableMessageRepo.DeleteByMsgHash")
+		}
+	}
+
+	for _, e := range failed {
+		if _, ok := existingMsgHashes[e.MsgHash]; ok {
+			continue
+		}
+
+		if err := r.buildRecallProof(ctx, e); err != nil {
+			slog.Warn("error building recall proof", "msgHash", e.MsgHash, "err", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// buildRecallProof builds and saves the proof of the failure signal of the given failed message,
+// once the destination chain block the message failed in has been synced to the source chain.
+func (r *Retrier) buildRecallProof(ctx context.Context, e *relayer.Event) error {
+	message, err := decodeMessage(e)
+	if err != nil {
+		return errors.Wrap(err, "decodeMessage")
+	}
+
+	failedBlockID, err := r.failedBlockID(ctx, e.MsgHash)
+	if err != nil {
+		return errors.Wrap(err, "r.failedBlockID")
+	}
+
+	synced, err := r.eventRepo.ChainDataSyncedEventByBlockNumberOrGreater(
+		ctx,
+		r.srcChainID.Uint64(),
+		r.destChainID.Uint64(),
+		failedBlockID,
+	)
+	if err != nil {
+		return errors.Wrap(err, "r.eventRepo.ChainDataSyncedEventByBlockNumberOrGreater")
+	}
+
+	// the failure can not be proven on the source chain yet, we will try again next interval.
+	if synced == nil {
+		slog.Info("failed block not synced to source chain yet", "msgHash", e.MsgHash, "failedBlockID", failedBlockID)
+		return nil
+	}
+
+	msgHash := common.HexToHash(e.MsgHash)
+
+	signal, err := r.destBridge.SignalForFailedMessage(&bind.CallOpts{Context: ctx}, msgHash)
+	if err != nil {
+		return errors.Wrap(err, "r.destBridge.SignalForFailedMessage")
+	}
+
+	key, err := r.destSignalService.GetSignalSlot(
+		&bind.CallOpts{Context: ctx},
+		r.destChainID.Uint64(),
+		r.cfg.DestBridgeAddress,
+		signal,
+	)
+	if err != nil {
+		return errors.Wrap(err, "r.destSignalService.GetSignalSlot")
+	}
+
+	encodedSignalProof, err := r.prover.EncodedSignalProofWithHops(ctx, []proof.HopParams{
+		{
+			ChainID:              r.srcChainID,
+			SignalServiceAddress: r.cfg.DestSignalServiceAddress,
+			SignalService:        r.destSignalService,
+			Key:                  key,
+			Blocker:              r.destEthClient,
+			Caller:               r.destCaller,
+			BlockNumber:          synced.BlockID,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "r.prover.EncodedSignalProofWithHops")
+	}
+
+	marshaledMessage, err := json.Marshal(message)
+	if err != nil {
+		return errors.Wrap(err, "json.Marshal")
+	}
+
+	if err := r.recallableMessageRepo.Save(ctx, &relayer.SaveRecallableMessageOpts{
+		MsgHash:      e.MsgHash,
+		SrcChainID:   r.srcChainID.Uint64(),
+		DestChainID:  r.destChainID.Uint64(),
+		SrcOwner:     message.SrcOwner.Hex(),
+		Message:      string(marshaledMessage),
+		Proof:        hexutil.Encode(encodedSignalProof),
+		ProofBlockID: synced.BlockID,
+	}); err != nil {
+		return errors.Wrap(err, "r.recallableMessageRepo.Save")
+	}
+
+	slog.Info("Built recall proof", "msgHash", e.MsgHash, "proofBlockID", synced.BlockID)
+
+	relayer.RetrierRecallProofsBuilt.Inc()
+
+	return nil
+}
+
+// failedBlockID returns the destination chain block the given message's latest
+// failed status change was emitted in.
+func (r *Retrier) failedBlockID(ctx context.Context, msgHash string) (uint64, error) {
+	events, err := r.eventRepo.FindAllByMsgHash(ctx, msgHash)
+	if err != nil {
+		return 0, errors.Wrap(err, "r.eventRepo.FindAllByMsgHash")
+	}
+
+	var blockID uint64
+
+	for _, e := range events {
+		if e.Event == relayer.EventNameMessageStatusChanged &&
+			e.Status == relayer.EventStatusFailed &&
+			e.EmittedBlockID > blockID {
+			blockID = e.EmittedBlockID
+		}
+	}
+
+	if blockID == 0 {
+		return 0, errors.New("no failed message status changed event found")
+	}
+
+	return blockID, nil
+}
@@ -0,0 +1,91 @@
+package retrier
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+)
+
+func Test_buildRecallProofs(t *testing.T) {
+	r := newTestRetrier()
+
+	srcOwner := common.HexToAddress("0x123")
+
+	failed := common.HexToHash("0x1")
+	saveMessage(t, r, failed, bridge.IBridgeMessage{
+		SrcOwner: srcOwner,
+		Value:    big.NewInt(1),
+	}, relayer.EventStatusFailed)
+
+	retriable := common.HexToHash("0x2")
+	saveMessage(t, r, retriable, bridge.IBridgeMessage{
+		SrcOwner: srcOwner,
+		Value:    big.NewInt(1),
+	}, relayer.EventStatusRetriable)
+
+	// a message which was recalled since its proof was built.
+	recalled := common.HexToHash("0x3")
+	assert.Nil(t, r.recallableMessageRepo.Save(context.Background(), &relayer.SaveRecallableMessageOpts{
+		MsgHash:     recalled.Hex(),
+		SrcChainID:  r.srcChainID.Uint64(),
+		DestChainID: r.destChainID.Uint64(),
+		SrcOwner:    srcOwner.Hex(),
+		Message:     "{}",
+		Proof:       "0x1234",
+	}))
+
+	assert.Nil(t, r.buildRecallProofs(context.Background()))
+
+	messages, err := r.recallableMessageRepo.FindAllByRoute(
+		context.Background(),
+		r.srcChainID.Uint64(),
+		r.destChainID.Uint64(),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(messages))
+	assert.Equal(t, failed.Hex(), messages[0].MsgHash)
+	assert.NotEmpty(t, messages[0].Proof)
+
+	req, err := http.NewRequest(http.MethodGet, "", nil)
+	assert.Nil(t, err)
+
+	page, err := r.recallableMessageRepo.FindAllByAddress(
+		context.Background(),
+		req,
+		relayer.FindAllRecallableMessagesOpts{Address: srcOwner},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(*page.Items.(*[]relayer.RecallableMessage)))
+
+	// the proof is only built once.
+	assert.Nil(t, r.buildRecallProofs(context.Background()))
+
+	messages, err = r.recallableMessageRepo.FindAllByRoute(
+		context.Background(),
+		r.srcChainID.Uint64(),
+		r.destChainID.Uint64(),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(messages))
+}
+
+func Test_failedBlockID(t *testing.T) {
+	r := newTestRetrier()
+
+	failed := common.HexToHash("0x1")
+	saveMessage(t, r, failed, bridge.IBridgeMessage{}, relayer.EventStatusFailed)
+
+	blockID, err := r.failedBlockID(context.Background(), failed.Hex())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(10), blockID)
+
+	_, err = r.failedBlockID(context.Background(), common.HexToHash("0x2").Hex())
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,246 @@
+package retrier
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	txmgrMetrics "github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/quotamanager"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/signalservice"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/proof"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/repo"
+)
+
+// ethClient is a slimmed down interface of a go-ethereum ethclient.Client
+// we can use for mocking and testing
+type ethClient interface {
+	BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error)
+	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+}
+
+// Retrier periodically re-evaluates the messages of a route which failed on the destination
+// chain. Retriable messages are retried once they can succeed, e.g. after the destination
+// quota refills, if the retrier is allowed to retry them. Failed messages get a prebuilt proof
+// of their failure signal, so their owner can recall them on the source chain.
+type Retrier struct {
+	cancel context.CancelFunc
+
+	eventRepo             relayer.EventRepository
+	recallableMessageRepo relayer.RecallableMessageRepository
+
+	destBridge        relayer.Bridge
+	destSignalService relayer.SignalService
+	destQuotaManager  relayer.QuotaManager
+
+	destEthClient ethClient
+	destCaller    relayer.Caller
+
+	prover *proof.Prover
+
+	txmgr txmgr.TxManager
+
+	retrierAddr common.Address
+
+	srcChainID  *big.Int
+	destChainID *big.Int
+
+	interval time.Duration
+
+	wg sync.WaitGroup
+
+	cfg *Config
+}
+
+// InitFromCli creates a new retrier from a cli context
+func (r *Retrier) InitFromCli(ctx context.Context, c *cli.Context) error {
+	cfg, err := NewConfigFromCliContext(c)
+	if err != nil {
+		return err
+	}
+
+	return InitFromConfig(ctx, r, cfg)
+}
+
+// nolint: funlen
+func InitFromConfig(ctx context.Context, r *Retrier, cfg *Config) error {
+	db, err := cfg.OpenDBFunc()
+	if err != nil {
+		return err
+	}
+
+	eventRepository, err := repo.NewEventRepository(db)
+	if err != nil {
+		return err
+	}
+
+	recallableMessageRepository, err := repo.NewRecallableMessageRepository(db)
+	if err != nil {
+		return err
+	}
+
+	srcEthClient, err := ethclient.Dial(cfg.SrcRPCUrl)
+	if err != nil {
+		return err
+	}
+
+	destEthClient, err := ethclient.Dial(cfg.DestRPCUrl)
+	if err != nil {
+		return err
+	}
+
+	destRpcClient, err := rpc.Dial(cfg.DestRPCUrl)
+	if err != nil {
+		return err
+	}
+
+	destBridge, err := bridge.NewBridge(cfg.DestBridgeAddress, destEthClient)
+	if err != nil {
+		return err
+	}
+
+	destSignalService, err := signalservice.NewSignalService(cfg.DestSignalServiceAddress, destEthClient)
+	if err != nil {
+		return err
+	}
+
+	// destQuotaManager is optional, it will not be set for L1-L2 bridging
+	// but will be set for L2-L1 bridging.
+	if cfg.DestQuotaManagerAddress != relayer.ZeroAddress {
+		if r.destQuotaManager, err = quotamanager.NewQuotaManager(
+			cfg.DestQuotaManagerAddress,
+			destEthClient,
+		); err != nil {
+			return err
+		}
+	}
+
+	srcChainID, err := srcEthClient.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+
+	destChainID, err := destEthClient.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+
+	prover, err := proof.New(destEthClient, encoding.CACHE_NOTHING)
+	if err != nil {
+		return err
+	}
+
+	if r.txmgr, err = txmgr.NewSimpleTxManager(
+		"retrier",
+		log.Root(),
+		new(txmgrMetrics.NoopTxMetrics),
+		*cfg.TxmgrConfigs,
+	); err != nil {
+		return err
+	}
+
+	r.eventRepo = eventRepository
+	r.recallableMessageRepo = recallableMessageRepository
+
+	r.destBridge = destBridge
+	r.destSignalService = destSignalService
+
+	r.destEthClient = destEthClient
+	r.destCaller = destRpcClient
+
+	r.prover = prover
+
+	r.retrierAddr = crypto.PubkeyToAddress(cfg.RetrierPrivateKey.PublicKey)
+
+	r.srcChainID = srcChainID
+	r.destChainID = destChainID
+
+	r.interval = cfg.Interval
+	if r.interval == 0 {
+		r.interval = time.Minute
+	}
+
+	r.cfg = cfg
+
+	return nil
+}
+
+func (r *Retrier) Name() string {
+	return "retrier"
+}
+
+func (r *Retrier) Close(ctx context.Context) {
+	r.cancel()
+
+	r.wg.Wait()
+
+	// Close db connection.
+	if err := r.eventRepo.Close(); err != nil {
+		slog.Error("Failed to close db connection", "err", err)
+	}
+}
+
+func (r *Retrier) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	r.cancel = cancel
+
+	r.wg.Add(1)
+
+	go r.eventLoop(ctx)
+
+	return nil
+}
+
+// eventLoop re-evaluates the retriable and failed messages every interval, until the
+// context is done.
+func (r *Retrier) eventLoop(ctx context.Context) {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.retryMessages(ctx); err != nil {
+			slog.Error("error retrying messages", "err", err.Error())
+		}
+
+		if err := r.buildRecallProofs(ctx); err != nil {
+			slog.Error("error building recall proofs", "err", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// decodeMessage decodes the bridge message of an indexed MessageSent event.
+func decodeMessage(e *relayer.Event) (bridge.IBridgeMessage, error) {
+	var event struct {
+		Message bridge.IBridgeMessage
+	}
+
+	if err := json.Unmarshal(e.Data, &event); err != nil {
+		return bridge.IBridgeMessage{}, err
+	}
+
+	return event.Message, nil
+}
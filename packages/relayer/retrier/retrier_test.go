@@ -0,0 +1,135 @@
+package retrier
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/proof"
+)
+
+var dummyEcdsaKey = "8da4ef21b864d2cc526dbdb2a120bd2874c36c9d0a1fb7f8c63d7f7a8b41de8f"
+
+// statusBridge is a mock bridge returning the configured on-chain message statuses.
+type statusBridge struct {
+	mock.Bridge
+	statuses map[common.Hash]relayer.EventStatus
+}
+
+func (b *statusBridge) MessageStatus(opts *bind.CallOpts, msgHash [32]byte) (uint8, error) {
+	return uint8(b.statuses[msgHash]), nil
+}
+
+// sendingTxManager is a mock transaction manager recording the sent transactions.
+type sendingTxManager struct {
+	mock.TxManager
+	sent []txmgr.TxCandidate
+}
+
+func (t *sendingTxManager) Send(ctx context.Context, candidate txmgr.TxCandidate) (*types.Receipt, error) {
+	t.sent = append(t.sent, candidate)
+
+	return &types.Receipt{Status: types.ReceiptStatusSuccessful}, nil
+}
+
+func newTestRetrier() *Retrier {
+	privateKey, _ := crypto.HexToECDSA(dummyEcdsaKey)
+
+	prover, _ := proof.New(
+		&mock.Blocker{},
+		encoding.CACHE_NOTHING,
+	)
+
+	return &Retrier{
+		eventRepo:             &mock.EventRepository{},
+		recallableMessageRepo: mock.NewRecallableMessageRepository(),
+		destBridge: &statusBridge{
+			statuses: make(map[common.Hash]relayer.EventStatus),
+		},
+		destSignalService: &mock.SignalService{},
+		destEthClient:     &mock.Blocker{},
+		destCaller:        &mock.Caller{},
+		prover:            prover,
+		txmgr:             &sendingTxManager{},
+		retrierAddr:       crypto.PubkeyToAddress(privateKey.PublicKey),
+		srcChainID:        big.NewInt(1),
+		destChainID:       big.NewInt(2),
+		cfg: &Config{
+			DestBridgeAddress:        common.HexToAddress(destBridgeAddr),
+			DestSignalServiceAddress: common.HexToAddress(destSignalServiceAddr),
+		},
+	}
+}
+
+// saveMessage indexes a sent message of the retrier's route, and its latest status on the
+// destination chain.
+func saveMessage(
+	t *testing.T,
+	r *Retrier,
+	msgHash common.Hash,
+	message bridge.IBridgeMessage,
+	status relayer.EventStatus,
+) {
+	data, err := json.Marshal(struct {
+		Message bridge.IBridgeMessage
+	}{message})
+	assert.Nil(t, err)
+
+	_, err = r.eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+		Name:        relayer.EventNameMessageSent,
+		Event:       relayer.EventNameMessageSent,
+		Data:        string(data),
+		ChainID:     r.srcChainID,
+		DestChainID: r.destChainID,
+		MsgHash:     msgHash.Hex(),
+		Status:      relayer.EventStatusNew,
+	})
+	assert.Nil(t, err)
+
+	_, err = r.eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+		Name:           relayer.EventNameMessageStatusChanged,
+		Event:          relayer.EventNameMessageStatusChanged,
+		Data:           "{}",
+		ChainID:        r.destChainID,
+		DestChainID:    r.srcChainID,
+		MsgHash:        msgHash.Hex(),
+		Status:         status,
+		EmittedBlockID: 10,
+	})
+	assert.Nil(t, err)
+
+	r.destBridge.(*statusBridge).statuses[msgHash] = status
+}
+
+func Test_Name(t *testing.T) {
+	r := Retrier{}
+
+	assert.Equal(t, "retrier", r.Name())
+}
+
+func Test_decodeMessage(t *testing.T) {
+	e := &relayer.Event{
+		Data: []byte(`{"Message": {"Id": 5, "GasLimit": 100, "Value": 10}}`),
+	}
+
+	message, err := decodeMessage(e)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), message.Id)
+	assert.Equal(t, uint32(100), message.GasLimit)
+	assert.Equal(t, big.NewInt(10), message.Value)
+
+	_, err = decodeMessage(&relayer.Event{Data: []byte("invalid")})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,147 @@
+package retrier
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
+)
+
+// retryMessages retries the retriable messages of the route, errors retrying a single message
+// are logged, so they don't block the other messages.
+func (r *Retrier) retryMessages(ctx context.Context) error {
+	events, err := r.eventRepo.FindAllMessageSentByLatestStatus(
+		ctx,
+		r.srcChainID.Uint64(),
+		r.destChainID.Uint64(),
+		relayer.EventStatusRetriable,
+	)
+	if err != nil {
+		return errors.Wrap(err, "r.eventRepo.FindAllMessageSentByLatestStatus")
+	}
+
+	for _, e := range events {
+		if err := r.retryMessage(ctx, e); err != nil {
+			relayer.RetrierRetryErrors.Inc()
+
+			slog.Warn("error retrying message", "msgHash", e.MsgHash, "err", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// retryMessage retries the given retriable message, if the retrier is allowed to, and the
+// destination quota is available.
+func (r *Retrier) retryMessage(ctx context.Context, e *relayer.Event) error {
+	message, err := decodeMessage(e)
+	if err != nil {
+		return errors.Wrap(err, "decodeMessage")
+	}
+
+	status, err := r.destBridge.MessageStatus(&bind.CallOpts{Context: ctx}, common.HexToHash(e.MsgHash))
+	if err != nil {
+		return errors.Wrap(err, "r.destBridge.MessageStatus")
+	}
+
+	// the message was retried, or failed, since its status was indexed.
+	if relayer.EventStatus(status) != relayer.EventStatusRetriable {
+		return nil
+	}
+
+	if !canRetryMessage(message, r.retrierAddr) {
+		slog.Info("gasLimit == 0 and owner is not the retrier key, can not retry", "msgHash", e.MsgHash)
+		return nil
+	}
+
+	if r.destQuotaManager != nil {
+		available, err := r.hasQuotaAvailable(ctx, message)
+		if err != nil {
+			return errors.Wrap(err, "r.hasQuotaAvailable")
+		}
+
+		if !available {
+			slog.Info("quota not available, retrying later", "msgHash", e.MsgHash)
+			return nil
+		}
+	}
+
+	data, err := encoding.BridgeABI.Pack("retryMessage", message, false)
+	if err != nil {
+		return errors.Wrap(err, "encoding.BridgeABI.Pack")
+	}
+
+	// the gas is estimated by the transaction manager, so a retry which would still fail
+	// reverts in the estimation, and is not sent.
+	receipt, err := r.txmgr.Send(ctx, txmgr.TxCandidate{
+		TxData: data,
+		To:     &r.cfg.DestBridgeAddress,
+	})
+	if err != nil {
+		return errors.Wrap(err, "r.txmgr.Send")
+	}
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return errors.Errorf("retryMessage transaction reverted, txHash: %v", receipt.TxHash.Hex())
+	}
+
+	slog.Info("Retried message", "msgHash", e.MsgHash, "txHash", receipt.TxHash.Hex())
+
+	relayer.RetrierMessagesRetried.Inc()
+
+	if err := r.eventRepo.UpdateStatus(ctx, e.ID, relayer.EventStatusDone); err != nil {
+		return errors.Wrap(err, "r.eventRepo.UpdateStatus")
+	}
+
+	return nil
+}
+
+// canRetryMessage determines whether a retriable message can be retried by the given address,
+// a message with a gasLimit of 0 can only be retried by its destination owner.
+func canRetryMessage(message bridge.IBridgeMessage, retrierAddr common.Address) bool {
+	return message.GasLimit != 0 || message.DestOwner == retrierAddr
+}
+
+// hasQuotaAvailable checks whether the destination quota covers the ETH or ERC20 amount of the
+// given message.
+func (r *Retrier) hasQuotaAvailable(ctx context.Context, message bridge.IBridgeMessage) (bool, error) {
+	eventType, canonicalToken, amount, err := relayer.DecodeMessageData(message.Data, message.Value)
+	if err != nil {
+		return false, err
+	}
+
+	// default to ETH (zero address) and msg value, overwrite if ERC20
+	tokenAddress := relayer.ZeroAddress
+
+	value := message.Value
+
+	switch eventType {
+	case relayer.EventTypeSendETH:
+	case relayer.EventTypeSendERC20:
+		tokenAddress = canonicalToken.Address()
+		value = amount
+	default:
+		// dont check quota for NFTs
+		return true, nil
+	}
+
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	available, err := r.destQuotaManager.AvailableQuota(&bind.CallOpts{Context: ctx}, tokenAddress, common.Big0)
+	if err != nil {
+		return false, err
+	}
+
+	return available.Cmp(value) >= 0, nil
+}
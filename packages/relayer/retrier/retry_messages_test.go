@@ -0,0 +1,148 @@
+package retrier
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+func Test_retryMessages(t *testing.T) {
+	r := newTestRetrier()
+
+	retriable := common.HexToHash("0x1")
+	saveMessage(t, r, retriable, bridge.IBridgeMessage{
+		GasLimit: 100,
+		Value:    big.NewInt(1),
+	}, relayer.EventStatusRetriable)
+
+	// only the destination owner can retry a message with a gasLimit of 0.
+	notAuthorized := common.HexToHash("0x2")
+	saveMessage(t, r, notAuthorized, bridge.IBridgeMessage{
+		DestOwner: common.HexToAddress("0x123"),
+		Value:     big.NewInt(1),
+	}, relayer.EventStatusRetriable)
+
+	owned := common.HexToHash("0x3")
+	saveMessage(t, r, owned, bridge.IBridgeMessage{
+		DestOwner: r.retrierAddr,
+		Value:     big.NewInt(1),
+	}, relayer.EventStatusRetriable)
+
+	// the message was retried since its status was indexed.
+	retried := common.HexToHash("0x4")
+	saveMessage(t, r, retried, bridge.IBridgeMessage{
+		GasLimit: 100,
+		Value:    big.NewInt(1),
+	}, relayer.EventStatusRetriable)
+	r.destBridge.(*statusBridge).statuses[retried] = relayer.EventStatusDone
+
+	failed := common.HexToHash("0x5")
+	saveMessage(t, r, failed, bridge.IBridgeMessage{
+		GasLimit: 100,
+		Value:    big.NewInt(1),
+	}, relayer.EventStatusFailed)
+
+	assert.Nil(t, r.retryMessages(context.Background()))
+
+	txmgr := r.txmgr.(*sendingTxManager)
+	assert.Equal(t, 2, len(txmgr.sent))
+
+	for _, candidate := range txmgr.sent {
+		assert.Equal(t, r.cfg.DestBridgeAddress, *candidate.To)
+	}
+
+	events, err := r.eventRepo.FindAllByMsgHash(context.Background(), retriable.Hex())
+	assert.Nil(t, err)
+	assert.Equal(t, relayer.EventStatusDone, events[0].Status)
+
+	events, err = r.eventRepo.FindAllByMsgHash(context.Background(), notAuthorized.Hex())
+	assert.Nil(t, err)
+	assert.Equal(t, relayer.EventStatusNew, events[0].Status)
+}
+
+func Test_canRetryMessage(t *testing.T) {
+	retrierAddr := common.HexToAddress("0x123")
+
+	tests := []struct {
+		name    string
+		message bridge.IBridgeMessage
+		want    bool
+	}{
+		{
+			"gasLimit",
+			bridge.IBridgeMessage{GasLimit: 1, DestOwner: common.HexToAddress("0x456")},
+			true,
+		},
+		{
+			"noGasLimitDestOwner",
+			bridge.IBridgeMessage{DestOwner: retrierAddr},
+			true,
+		},
+		{
+			"noGasLimitNotDestOwner",
+			bridge.IBridgeMessage{DestOwner: common.HexToAddress("0x456")},
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, canRetryMessage(tt.message, retrierAddr))
+		})
+	}
+}
+
+func Test_hasQuotaAvailable(t *testing.T) {
+	r := newTestRetrier()
+	r.destQuotaManager = &mock.QuotaManager{}
+
+	tests := []struct {
+		name    string
+		message bridge.IBridgeMessage
+		want    bool
+	}{
+		{
+			"ethBelowQuota",
+			bridge.IBridgeMessage{Value: big.NewInt(10000)},
+			true,
+		},
+		{
+			"ethAboveQuota",
+			bridge.IBridgeMessage{Value: big.NewInt(10001)},
+			false,
+		},
+		{
+			"noValue",
+			bridge.IBridgeMessage{},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			available, err := r.hasQuotaAvailable(context.Background(), tt.message)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, available)
+		})
+	}
+}
+
+func Test_retryMessages_quotaNotAvailable(t *testing.T) {
+	r := newTestRetrier()
+	r.destQuotaManager = &mock.QuotaManager{}
+
+	saveMessage(t, r, common.HexToHash("0x1"), bridge.IBridgeMessage{
+		GasLimit: 100,
+		Value:    big.NewInt(10001),
+	}, relayer.EventStatusRetriable)
+
+	assert.Nil(t, r.retryMessages(context.Background()))
+	assert.Equal(t, 0, len(r.txmgr.(*sendingTxManager).sent))
+}
@@ -0,0 +1,216 @@
+package watchdog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/cyberhorsey/errors"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
+)
+
+// proposalTimeout is the timeout of the requests opening an admin proposal.
+var proposalTimeout = 10 * time.Second
+
+// adminProposal is posted to the proposal URL to ask the admins of the bridges to act on a
+// violated invariant.
+type adminProposal struct {
+	Invariant    string                `json:"invariant"`
+	Reason       string                `json:"reason"`
+	Transactions []proposedTransaction `json:"transactions"`
+}
+
+// proposedTransaction is a transaction the admins are asked to send.
+type proposedTransaction struct {
+	ChainID uint64 `json:"chainId"`
+	To      string `json:"to"`
+	Data    string `json:"data"`
+}
+
+// act reports the violation of the given invariant, and takes the action it is configured with.
+func (w *Watchdog) act(ctx context.Context, inv invariant, v *violation) error {
+	relayer.WatchdogInvariantViolations.WithLabelValues(inv.Name(), string(inv.Action())).Inc()
+
+	slog.Error("invariant violated",
+		"invariant", inv.Name(),
+		"action", inv.Action(),
+		"reason", v.reason,
+	)
+
+	switch inv.Action() {
+	case ActionPause:
+		return w.pauseBridges(ctx)
+	case ActionPropose:
+		return w.propose(ctx, inv, v)
+	case ActionApprove:
+		return w.requestApproval(ctx, inv, v)
+	default:
+		return nil
+	}
+}
+
+// pauseBridges pauses the bridges of both chains.
+func (w *Watchdog) pauseBridges(ctx context.Context) error {
+	for _, b := range []struct {
+		bridge  relayer.Bridge
+		address common.Address
+		txmgr   txmgr.TxManager
+	}{
+		{w.srcBridge, w.cfg.SrcBridgeAddress, w.srcTxmgr},
+		{w.destBridge, w.cfg.DestBridgeAddress, w.destTxmgr},
+	} {
+		pauseReceipt, err := w.pauseBridge(ctx, b.bridge, b.address, b.txmgr)
+		if err != nil {
+			return err
+		}
+
+		if pauseReceipt != nil {
+			slog.Info("Mined pause tx",
+				"txHash", pauseReceipt.TxHash.Hex(),
+				"bridgeAddress", b.address.Hex(),
+			)
+
+			if pauseReceipt.Status != types.ReceiptStatusSuccessful {
+				slog.Error("Error pausing bridge", "bridgeAddress", b.address)
+
+				relayer.BridgePausedErrors.Inc()
+
+				return fmt.Errorf("pause tx %s reverted", pauseReceipt.TxHash.Hex())
+			}
+		}
+
+		relayer.BridgePaused.Inc()
+	}
+
+	return nil
+}
+
+func (w *Watchdog) pauseBridge(
+	ctx context.Context,
+	bridge relayer.Bridge,
+	bridgeAddress common.Address,
+	mgr txmgr.TxManager,
+) (*types.Receipt, error) {
+	paused, err := bridge.Paused(&bind.CallOpts{
+		Context: ctx,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if paused {
+		slog.Info("bridge already paused")
+
+		return nil, nil
+	}
+
+	data, err := encoding.BridgeABI.Pack("pause")
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding.BridgeABI.Pack")
+	}
+
+	candidate := txmgr.TxCandidate{
+		TxData: data,
+		Blobs:  nil,
+		To:     &bridgeAddress,
+	}
+
+	receipt, err := mgr.Send(ctx, candidate)
+	if err != nil {
+		slog.Warn("Failed to send pause transaction", "error", err.Error())
+		return nil, err
+	}
+
+	return receipt, nil
+}
+
+// pauseTransactions returns the transactions pausing the bridges of both chains.
+func (w *Watchdog) pauseTransactions() ([]proposedTransaction, error) {
+	data, err := encoding.BridgeABI.Pack("pause")
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding.BridgeABI.Pack")
+	}
+
+	return []proposedTransaction{
+		{
+			ChainID: w.srcChainId.Uint64(),
+			To:      w.cfg.SrcBridgeAddress.Hex(),
+			Data:    hexutil.Encode(data),
+		},
+		{
+			ChainID: w.destChainId.Uint64(),
+			To:      w.cfg.DestBridgeAddress.Hex(),
+			Data:    hexutil.Encode(data),
+		},
+	}, nil
+}
+
+// propose opens an admin proposal to pause the bridges of both chains. A proposal is only
+// opened once for each invariant, the admins are expected to follow up on it.
+func (w *Watchdog) propose(ctx context.Context, inv invariant, v *violation) error {
+	w.mu.Lock()
+	proposed := w.proposed[inv.Name()]
+	w.mu.Unlock()
+
+	if proposed {
+		slog.Info("proposal already opened", "invariant", inv.Name())
+
+		return nil
+	}
+
+	transactions, err := w.pauseTransactions()
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(adminProposal{
+		Invariant:    inv.Name(),
+		Reason:       v.reason,
+		Transactions: transactions,
+	})
+	if err != nil {
+		return errors.Wrap(err, "json.Marshal")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, proposalTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.cfg.ProposalURL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "http.NewRequestWithContext")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "http.DefaultClient.Do")
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("proposal rejected with status %v", resp.StatusCode)
+	}
+
+	w.mu.Lock()
+	w.proposed[inv.Name()] = true
+	w.mu.Unlock()
+
+	relayer.WatchdogProposalsOpened.WithLabelValues(inv.Name()).Inc()
+
+	slog.Info("opened admin proposal", "invariant", inv.Name())
+
+	return nil
+}
@@ -0,0 +1,482 @@
+package watchdog
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/cyberhorsey/errors"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+)
+
+// incidentWebhookTimeout is the timeout of the requests sending an incident report.
+var incidentWebhookTimeout = 10 * time.Second
+
+// the outcomes of an incident.
+var (
+	incidentApproved = "approved"
+	incidentRejected = "rejected"
+	incidentTimedOut = "timedOut"
+)
+
+// the decisions the incident signatures are domain-separated with.
+const (
+	decisionReport  = "report"
+	decisionApprove = "approve"
+	decisionReject  = "reject"
+)
+
+// incidentReport is sent to the incident webhook when an invariant with the approve action is
+// violated. Its ID is the keccak256 hash of the report without the ID and the signature. The
+// watchdog signs the incident digest of the report decision, and the approvers approve or reject
+// the incident by signing the digest of their decision, as EIP-191 personal messages.
+type incidentReport struct {
+	ID            string                 `json:"id"`
+	Invariant     string                 `json:"invariant"`
+	Reason        string                 `json:"reason"`
+	Message       *bridge.IBridgeMessage `json:"message,omitempty"`
+	Evidence      map[string]string      `json:"evidence,omitempty"`
+	Transactions  []proposedTransaction  `json:"transactions"`
+	Approvers     []common.Address       `json:"approvers"`
+	Quorum        uint64                 `json:"quorum"`
+	CreatedAt     int64                  `json:"createdAt"`
+	Deadline      int64                  `json:"deadline"`
+	TimeoutAction Action                 `json:"timeoutAction"`
+	Watchdog      common.Address         `json:"watchdog"`
+	ChainID       uint64                 `json:"chainId"`
+	Signature     string                 `json:"signature"`
+}
+
+// incident is an incident report awaiting the approvers.
+type incident struct {
+	report       *incidentReport
+	invariant    string
+	violationKey string
+	approvals    map[common.Address]bool
+	rejections   map[common.Address]bool
+
+	// decision receives the action decided by a quorum of the approvers.
+	decision chan Action
+}
+
+// requestApproval opens an incident for the violation of the given invariant, sends its signed
+// report to the incident webhook, and waits for the approvers in the background. Only one
+// incident is opened at a time for each violation. The incident is saved before returning, so
+// the violating message can be acknowledged, and a restarted watchdog resumes the incident.
+func (w *Watchdog) requestApproval(ctx context.Context, inv invariant, v *violation) error {
+	inc, err := w.openIncident(ctx, inv, v)
+	if err != nil || inc == nil {
+		return err
+	}
+
+	w.wg.Add(1)
+
+	go w.awaitApproval(ctx, inc)
+
+	// the incident times out to its timeout action even if the report could not be sent.
+	if err := w.sendIncidentReport(ctx, inc.report); err != nil {
+		slog.Error("error sending incident report", "id", inc.report.ID, "error", err)
+	}
+
+	return nil
+}
+
+// openIncident opens and saves an incident for the violation of the given invariant, it returns
+// nil if an incident is already open for the violation.
+func (w *Watchdog) openIncident(ctx context.Context, inv invariant, v *violation) (*incident, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	violationKey := v.key(inv)
+
+	if id, ok := w.openIncidents[violationKey]; ok {
+		slog.Info("incident already awaiting approval", "invariant", inv.Name(), "violation", violationKey, "id", id)
+
+		return nil, nil
+	}
+
+	report, err := w.newIncidentReport(inv, v)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedReport, err := json.Marshal(report)
+	if err != nil {
+		return nil, errors.Wrap(err, "json.Marshal")
+	}
+
+	if err := w.incidentRepo.Save(ctx, &relayer.SaveIncidentOpts{
+		IncidentID:   report.ID,
+		SrcChainID:   w.srcChainId.Uint64(),
+		DestChainID:  w.destChainId.Uint64(),
+		Invariant:    inv.Name(),
+		ViolationKey: violationKey,
+		Report:       string(encodedReport),
+	}); err != nil {
+		return nil, errors.Wrap(err, "w.incidentRepo.Save")
+	}
+
+	inc := &incident{
+		report:       report,
+		invariant:    inv.Name(),
+		violationKey: violationKey,
+		approvals:    make(map[common.Address]bool),
+		rejections:   make(map[common.Address]bool),
+		decision:     make(chan Action, 1),
+	}
+
+	w.trackIncident(inc)
+
+	return inc, nil
+}
+
+// trackIncident registers the given open incident, w.mu must be held.
+func (w *Watchdog) trackIncident(inc *incident) {
+	w.incidents[inc.report.ID] = inc
+	w.openIncidents[inc.violationKey] = inc.report.ID
+
+	relayer.WatchdogOpenIncidents.Inc()
+}
+
+// resumeIncidents resumes waiting for the approvers on the incidents left open by a previous
+// run of the watchdog. An incident whose deadline passed meanwhile times out right away.
+func (w *Watchdog) resumeIncidents(ctx context.Context) error {
+	saved, err := w.incidentRepo.FindAllOpen(ctx, w.srcChainId.Uint64(), w.destChainId.Uint64())
+	if err != nil {
+		return errors.Wrap(err, "w.incidentRepo.FindAllOpen")
+	}
+
+	for _, s := range saved {
+		inc := &incident{
+			report:       &incidentReport{},
+			invariant:    s.Invariant,
+			violationKey: s.ViolationKey,
+			approvals:    make(map[common.Address]bool),
+			rejections:   make(map[common.Address]bool),
+			decision:     make(chan Action, 1),
+		}
+
+		if err := json.Unmarshal(s.Report, inc.report); err != nil {
+			return errors.Wrap(err, "json.Unmarshal")
+		}
+
+		for _, signers := range []struct {
+			encoded []byte
+			set     map[common.Address]bool
+		}{
+			{s.Approvals, inc.approvals},
+			{s.Rejections, inc.rejections},
+		} {
+			var addresses []common.Address
+			if err := json.Unmarshal(signers.encoded, &addresses); err != nil {
+				return errors.Wrap(err, "json.Unmarshal")
+			}
+
+			for _, address := range addresses {
+				signers.set[address] = true
+			}
+		}
+
+		w.mu.Lock()
+		w.trackIncident(inc)
+		w.decideIncident(inc)
+		w.mu.Unlock()
+
+		slog.Info("resumed incident", "id", inc.report.ID, "invariant", inc.invariant)
+
+		w.wg.Add(1)
+
+		go w.awaitApproval(ctx, inc)
+	}
+
+	return nil
+}
+
+// newIncidentReport builds and signs the incident report of the given violation.
+func (w *Watchdog) newIncidentReport(inv invariant, v *violation) (*incidentReport, error) {
+	transactions, err := w.pauseTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	report := &incidentReport{
+		Invariant:     inv.Name(),
+		Reason:        v.reason,
+		Message:       v.message,
+		Evidence:      v.evidence,
+		Transactions:  transactions,
+		Approvers:     w.cfg.Approvers,
+		Quorum:        w.cfg.ApprovalQuorum,
+		CreatedAt:     now.Unix(),
+		Deadline:      now.Add(w.cfg.ApprovalTimeout).Unix(),
+		TimeoutAction: w.cfg.ApprovalTimeoutAction,
+		Watchdog:      w.watchdogAddr,
+		ChainID:       w.destChainId.Uint64(),
+	}
+
+	unsigned, err := json.Marshal(report)
+	if err != nil {
+		return nil, errors.Wrap(err, "json.Marshal")
+	}
+
+	id := crypto.Keccak256Hash(unsigned)
+
+	signature, err := signIncidentDigest(incidentDigest(report.ChainID, id, decisionReport), w.ecdsaKey)
+	if err != nil {
+		return nil, err
+	}
+
+	report.ID = id.Hex()
+	report.Signature = hexutil.Encode(signature)
+
+	return report, nil
+}
+
+// sendIncidentReport POSTs the given report to the incident webhook.
+func (w *Watchdog) sendIncidentReport(ctx context.Context, report *incidentReport) error {
+	body, err := json.Marshal(report)
+	if err != nil {
+		return errors.Wrap(err, "json.Marshal")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, incidentWebhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.cfg.IncidentWebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "http.NewRequestWithContext")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "http.DefaultClient.Do")
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("incident report rejected with status %v", resp.StatusCode)
+	}
+
+	slog.Info("sent incident report", "id", report.ID, "invariant", report.Invariant)
+
+	return nil
+}
+
+// awaitApproval waits for the decision of the approvers on the given incident, or its timeout,
+// and pauses the bridges if it was approved, or if it timed out and the timeout action is pause.
+func (w *Watchdog) awaitApproval(ctx context.Context, inc *incident) {
+	defer w.wg.Done()
+
+	defer w.closeIncident(inc)
+
+	t := time.NewTimer(time.Until(time.Unix(inc.report.Deadline, 0)))
+	defer t.Stop()
+
+	var (
+		action  Action
+		outcome string
+	)
+
+	select {
+	case <-ctx.Done():
+		return
+	case action = <-inc.decision:
+		outcome = incidentApproved
+		if action != ActionPause {
+			outcome = incidentRejected
+		}
+	case <-t.C:
+		action = w.cfg.ApprovalTimeoutAction
+		outcome = incidentTimedOut
+	}
+
+	relayer.WatchdogIncidents.WithLabelValues(inc.invariant, outcome).Inc()
+
+	slog.Warn("incident closed",
+		"id", inc.report.ID,
+		"invariant", inc.invariant,
+		"outcome", outcome,
+		"action", action,
+	)
+
+	if action == ActionPause {
+		// the incident is left open, so the bridges are paused again when it is resumed.
+		if err := w.pauseBridges(ctx); err != nil {
+			slog.Error("error pausing bridges", "id", inc.report.ID, "error", err)
+
+			return
+		}
+	}
+
+	if err := w.incidentRepo.UpdateOutcome(ctx, inc.report.ID, outcome); err != nil {
+		slog.Error("error saving incident outcome", "id", inc.report.ID, "error", err)
+	}
+}
+
+// closeIncident stops tracking the given incident, so the next occurrence of its violation
+// opens a new one.
+func (w *Watchdog) closeIncident(inc *incident) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.incidents, inc.report.ID)
+	delete(w.openIncidents, inc.violationKey)
+
+	relayer.WatchdogOpenIncidents.Dec()
+}
+
+// signIncident records and saves the signature of an approver approving or rejecting the
+// incident with the given ID, and decides the incident once a quorum of the approvers agrees.
+// It returns the number of approvals and rejections of the incident.
+func (w *Watchdog) signIncident(
+	ctx context.Context,
+	id string,
+	signature []byte,
+	approve bool,
+) (int, int, error) {
+	decision := decisionReject
+	if approve {
+		decision = decisionApprove
+	}
+
+	digest := incidentDigest(w.destChainId.Uint64(), common.HexToHash(id), decision)
+
+	signer, err := recoverIncidentSigner(digest, signature)
+	if err != nil {
+		return 0, 0, errInvalidSignature
+	}
+
+	isApprover := false
+
+	for _, approver := range w.cfg.Approvers {
+		if approver == signer {
+			isApprover = true
+		}
+	}
+
+	if !isApprover {
+		return 0, 0, errNotApprover
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	inc, ok := w.incidents[id]
+	if !ok {
+		return 0, 0, errIncidentNotFound
+	}
+
+	// an approver can change their mind until the incident is decided.
+	if approve {
+		inc.approvals[signer] = true
+		delete(inc.rejections, signer)
+	} else {
+		inc.rejections[signer] = true
+		delete(inc.approvals, signer)
+	}
+
+	if err := w.incidentRepo.UpdateSignatures(ctx, id, &relayer.UpdateIncidentSignaturesOpts{
+		Approvals:  signerAddresses(inc.approvals),
+		Rejections: signerAddresses(inc.rejections),
+	}); err != nil {
+		return 0, 0, errors.Wrap(err, "w.incidentRepo.UpdateSignatures")
+	}
+
+	slog.Info("incident signed", "id", id, "signer", signer.Hex(), "approve", approve)
+
+	w.decideIncident(inc)
+
+	return len(inc.approvals), len(inc.rejections), nil
+}
+
+// decideIncident decides the given incident once a quorum of the approvers agrees, w.mu must
+// be held.
+func (w *Watchdog) decideIncident(inc *incident) {
+	quorum := int(w.cfg.ApprovalQuorum)
+
+	switch {
+	case len(inc.approvals) >= quorum:
+		inc.decide(ActionPause)
+	case len(inc.rejections) >= quorum:
+		inc.decide(ActionAlert)
+	}
+}
+
+// signerAddresses returns the hex addresses of the given set of signers.
+func signerAddresses(signers map[common.Address]bool) []string {
+	addresses := make([]string, 0, len(signers))
+
+	for signer := range signers {
+		addresses = append(addresses, signer.Hex())
+	}
+
+	return addresses
+}
+
+// decide sends the decision on the incident, unless it was already decided.
+func (inc *incident) decide(action Action) {
+	select {
+	case inc.decision <- action:
+	default:
+	}
+}
+
+// incidentDigest is the hash signed for the given decision on the incident with the given ID,
+// keccak256(chainID ‖ id ‖ decision) with the chain ID as a uint256, so a signature can neither
+// be replayed for another decision, nor on the watchdog of another chain.
+func incidentDigest(chainID uint64, id common.Hash, decision string) common.Hash {
+	return crypto.Keccak256Hash(
+		common.LeftPadBytes(new(big.Int).SetUint64(chainID).Bytes(), 32),
+		id.Bytes(),
+		[]byte(decision),
+	)
+}
+
+// signIncidentDigest signs the given incident digest as an EIP-191 personal message.
+func signIncidentDigest(digest common.Hash, key *ecdsa.PrivateKey) ([]byte, error) {
+	signature, err := crypto.Sign(accounts.TextHash(digest.Bytes()), key)
+	if err != nil {
+		return nil, errors.Wrap(err, "crypto.Sign")
+	}
+
+	signature[crypto.RecoveryIDOffset] += 27
+
+	return signature, nil
+}
+
+// recoverIncidentSigner recovers the address which signed the given incident digest as an
+// EIP-191 personal message.
+func recoverIncidentSigner(digest common.Hash, signature []byte) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("invalid signature length %v", len(signature))
+	}
+
+	sig := common.CopyBytes(signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pub, err := crypto.SigToPub(accounts.TextHash(digest.Bytes()), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*pub), nil
+}
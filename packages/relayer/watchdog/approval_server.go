@@ -0,0 +1,121 @@
+package watchdog
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log/slog"
+	"net/http"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/labstack/echo/v4"
+)
+
+// signIncidentRequest is the body of the requests approving or rejecting an incident.
+type signIncidentRequest struct {
+	Signature string `json:"signature"`
+}
+
+// incidentResponse is the state of an incident.
+type incidentResponse struct {
+	Report     *incidentReport `json:"report,omitempty"`
+	Approvals  int             `json:"approvals"`
+	Rejections int             `json:"rejections"`
+	Quorum     uint64          `json:"quorum"`
+}
+
+// newApprovalServer builds the http server the approvers approve or reject the incidents on.
+func (w *Watchdog) newApprovalServer() *echo.Echo {
+	e := echo.New()
+
+	e.HideBanner = true
+
+	e.GET("/incidents/:id", w.getIncident)
+	e.POST("/incidents/:id/approvals", w.approveIncident)
+	e.POST("/incidents/:id/rejections", w.rejectIncident)
+
+	return e
+}
+
+// startApprovalServer starts the approval http server, it is closed when the given context is
+// cancelled.
+func (w *Watchdog) startApprovalServer(ctx context.Context) {
+	e := w.newApprovalServer()
+
+	go func() {
+		<-ctx.Done()
+
+		if err := e.Shutdown(context.Background()); err != nil {
+			slog.Error("Failed to close approval server", "error", err)
+		}
+	}()
+
+	go func() {
+		slog.Info("Starting approval server", "port", w.cfg.ApprovalHTTPPort)
+
+		if err := e.Start(fmt.Sprintf(":%v", w.cfg.ApprovalHTTPPort)); err != nil && err != http.ErrServerClosed {
+			slog.Error("Starting approval server error", "error", err)
+		}
+	}()
+}
+
+func (w *Watchdog) getIncident(c echo.Context) error {
+	id := html.EscapeString(c.Param("id"))
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	inc, ok := w.incidents[id]
+	if !ok {
+		return webutils.LogAndRenderErrors(c, http.StatusNotFound, errIncidentNotFound)
+	}
+
+	return c.JSON(http.StatusOK, incidentResponse{
+		Report:     inc.report,
+		Approvals:  len(inc.approvals),
+		Rejections: len(inc.rejections),
+		Quorum:     w.cfg.ApprovalQuorum,
+	})
+}
+
+func (w *Watchdog) approveIncident(c echo.Context) error {
+	return w.handleSignIncident(c, true)
+}
+
+func (w *Watchdog) rejectIncident(c echo.Context) error {
+	return w.handleSignIncident(c, false)
+}
+
+func (w *Watchdog) handleSignIncident(c echo.Context, approve bool) error {
+	req := &signIncidentRequest{}
+	if err := c.Bind(req); err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, err)
+	}
+
+	signature, err := hexutil.Decode(req.Signature)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, errInvalidSignature)
+	}
+
+	approvals, rejections, err := w.signIncident(
+		c.Request().Context(),
+		html.EscapeString(c.Param("id")),
+		signature,
+		approve,
+	)
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if err == errIncidentNotFound {
+			status = http.StatusNotFound
+		}
+
+		return webutils.LogAndRenderErrors(c, status, err)
+	}
+
+	return c.JSON(http.StatusOK, incidentResponse{
+		Approvals:  approvals,
+		Rejections: rejections,
+		Quorum:     w.cfg.ApprovalQuorum,
+	})
+}
@@ -0,0 +1,350 @@
+package watchdog
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+)
+
+// incidentWebhook is a test server recording the incident reports it receives.
+type incidentWebhook struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	reports []incidentReport
+}
+
+func newIncidentWebhook(t *testing.T) *incidentWebhook {
+	h := &incidentWebhook{}
+
+	h.Server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		var report incidentReport
+
+		assert.Nil(t, json.NewDecoder(req.Body).Decode(&report))
+
+		h.mu.Lock()
+		h.reports = append(h.reports, report)
+		h.mu.Unlock()
+	}))
+
+	t.Cleanup(h.Close)
+
+	return h
+}
+
+func newTestApprovalWatchdog(
+	t *testing.T,
+	quorum uint64,
+	timeout time.Duration,
+) (*Watchdog, *incidentWebhook, []*ecdsa.PrivateKey) {
+	webhook := newIncidentWebhook(t)
+
+	w := newTestWatchdog()
+
+	w.ecdsaKey, _ = crypto.HexToECDSA(dummyEcdsaKey)
+	w.watchdogAddr = crypto.PubkeyToAddress(w.ecdsaKey.PublicKey)
+
+	var approvers []*ecdsa.PrivateKey
+
+	for i := 0; i < 3; i++ {
+		key, err := crypto.GenerateKey()
+		assert.Nil(t, err)
+
+		approvers = append(approvers, key)
+		w.cfg.Approvers = append(w.cfg.Approvers, crypto.PubkeyToAddress(key.PublicKey))
+	}
+
+	w.cfg.IncidentWebhookURL = webhook.URL
+	w.cfg.ApprovalQuorum = quorum
+	w.cfg.ApprovalTimeout = timeout
+	w.cfg.ApprovalTimeoutAction = ActionPause
+
+	return w, webhook, approvers
+}
+
+func signTestIncidentDecision(t *testing.T, w *Watchdog, id string, key *ecdsa.PrivateKey, approve bool) []byte {
+	decision := decisionReject
+	if approve {
+		decision = decisionApprove
+	}
+
+	signature, err := signIncidentDigest(incidentDigest(w.destChainId.Uint64(), common.HexToHash(id), decision), key)
+	assert.Nil(t, err)
+
+	return signature
+}
+
+func signTestIncident(t *testing.T, w *Watchdog, id string, key *ecdsa.PrivateKey, approve bool) error {
+	signature := signTestIncidentDecision(t, w, id, key, approve)
+
+	_, _, err := w.signIncident(context.Background(), id, signature, approve)
+
+	return err
+}
+
+func approveInvariant() *countingInvariant {
+	return &countingInvariant{
+		invariantBase: invariantBase{name: "counting", action: ActionApprove},
+	}
+}
+
+func Test_requestApproval_approved(t *testing.T) {
+	w, webhook, approvers := newTestApprovalWatchdog(t, 2, time.Hour)
+
+	inv := approveInvariant()
+
+	assert.Nil(t, w.act(context.Background(), inv, &violation{
+		reason:   "violated",
+		evidence: map[string]string{"blockNumber": "1"},
+	}))
+
+	// only one incident is opened at a time for each invariant.
+	assert.Nil(t, w.act(context.Background(), inv, &violation{reason: "violated"}))
+
+	assert.Equal(t, 1, len(webhook.reports))
+
+	report := webhook.reports[0]
+	assert.Equal(t, "counting", report.Invariant)
+	assert.Equal(t, "1", report.Evidence["blockNumber"])
+	assert.Equal(t, 2, len(report.Transactions))
+	assert.Equal(t, uint64(2), report.Quorum)
+
+	// the report is signed by the watchdog.
+	signature, err := hexutil.Decode(report.Signature)
+	assert.Nil(t, err)
+
+	signer, err := recoverIncidentSigner(
+		incidentDigest(report.ChainID, common.HexToHash(report.ID), decisionReport),
+		signature,
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, w.watchdogAddr, signer)
+	assert.Equal(t, w.destChainId.Uint64(), report.ChainID)
+
+	// the ID is the hash of the report without the ID and signature.
+	unsigned := report
+	unsigned.ID = ""
+	unsigned.Signature = ""
+
+	data, err := json.Marshal(unsigned)
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.Keccak256Hash(data).Hex(), report.ID)
+
+	// an approval can not be replayed as a rejection.
+	approval := signTestIncidentDecision(t, w, report.ID, approvers[0], true)
+
+	_, _, err = w.signIncident(context.Background(), report.ID, approval, false)
+	assert.Equal(t, errNotApprover, err)
+
+	_, _, err = w.signIncident(context.Background(), report.ID, approval, true)
+	assert.Nil(t, err)
+
+	// the same approver is only counted once.
+	assert.Nil(t, signTestIncident(t, w, report.ID, approvers[0], true))
+	assert.Equal(t, 0, len(w.srcTxmgr.(*sendingTxManager).sent))
+
+	assert.Nil(t, signTestIncident(t, w, report.ID, approvers[1], true))
+
+	w.wg.Wait()
+
+	assert.Equal(t, 1, len(w.srcTxmgr.(*sendingTxManager).sent))
+	assert.Equal(t, 1, len(w.destTxmgr.(*sendingTxManager).sent))
+
+	// the incident is closed.
+	assert.Equal(t, errIncidentNotFound, signTestIncident(t, w, report.ID, approvers[2], true))
+}
+
+func Test_requestApproval_rejected(t *testing.T) {
+	w, webhook, approvers := newTestApprovalWatchdog(t, 1, time.Hour)
+
+	assert.Nil(t, w.act(context.Background(), approveInvariant(), &violation{reason: "violated"}))
+	assert.Nil(t, signTestIncident(t, w, webhook.reports[0].ID, approvers[0], false))
+
+	w.wg.Wait()
+
+	assert.Equal(t, 0, len(w.srcTxmgr.(*sendingTxManager).sent))
+	assert.Equal(t, 0, len(w.openIncidents))
+}
+
+func Test_requestApproval_violations(t *testing.T) {
+	w, webhook, _ := newTestApprovalWatchdog(t, 1, time.Hour)
+
+	inv := approveInvariant()
+
+	for _, id := range []uint64{1, 2, 1} {
+		assert.Nil(t, w.act(context.Background(), inv, &violation{
+			reason:  "violated",
+			message: &bridge.IBridgeMessage{Id: id, SrcChainId: 1},
+		}))
+	}
+
+	// one incident is opened for each violating message.
+	assert.Equal(t, 2, len(webhook.reports))
+	assert.Equal(t, 2, len(w.openIncidents))
+}
+
+func Test_resumeIncidents(t *testing.T) {
+	w, webhook, approvers := newTestApprovalWatchdog(t, 2, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	assert.Nil(t, w.act(ctx, approveInvariant(), &violation{reason: "violated"}))
+
+	id := webhook.reports[0].ID
+
+	assert.Nil(t, signTestIncident(t, w, id, approvers[0], true))
+
+	// the watchdog stops before the incident is decided.
+	cancel()
+	w.wg.Wait()
+
+	restarted, _, _ := newTestApprovalWatchdog(t, 2, time.Hour)
+	restarted.ecdsaKey = w.ecdsaKey
+	restarted.cfg.Approvers = w.cfg.Approvers
+	restarted.incidentRepo = w.incidentRepo
+
+	assert.Nil(t, restarted.resumeIncidents(context.Background()))
+
+	// the same violation does not open another incident.
+	assert.Nil(t, restarted.act(context.Background(), approveInvariant(), &violation{reason: "violated"}))
+	assert.Equal(t, 1, len(restarted.openIncidents))
+
+	// the approval signed before the restart counts towards the quorum.
+	assert.Nil(t, signTestIncident(t, restarted, id, approvers[1], true))
+
+	restarted.wg.Wait()
+
+	assert.Equal(t, 1, len(restarted.srcTxmgr.(*sendingTxManager).sent))
+
+	incidents, err := restarted.incidentRepo.FindAllOpen(context.Background(), 1, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(incidents))
+}
+
+func Test_requestApproval_timeout(t *testing.T) {
+	tests := []struct {
+		name          string
+		timeoutAction Action
+		wantPaused    bool
+	}{
+		{
+			"pause",
+			ActionPause,
+			true,
+		},
+		{
+			"alert",
+			ActionAlert,
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, _, _ := newTestApprovalWatchdog(t, 1, 10*time.Millisecond)
+			w.cfg.ApprovalTimeoutAction = tt.timeoutAction
+
+			assert.Nil(t, w.act(context.Background(), approveInvariant(), &violation{reason: "violated"}))
+
+			w.wg.Wait()
+
+			assert.Equal(t, tt.wantPaused, len(w.srcTxmgr.(*sendingTxManager).sent) == 1)
+		})
+	}
+}
+
+func Test_signIncident_notApprover(t *testing.T) {
+	w, webhook, _ := newTestApprovalWatchdog(t, 1, time.Hour)
+
+	assert.Nil(t, w.act(context.Background(), approveInvariant(), &violation{reason: "violated"}))
+
+	key, err := crypto.GenerateKey()
+	assert.Nil(t, err)
+
+	assert.Equal(t, errNotApprover, signTestIncident(t, w, webhook.reports[0].ID, key, true))
+
+	_, _, err = w.signIncident(context.Background(), webhook.reports[0].ID, []byte{1}, true)
+	assert.Equal(t, errInvalidSignature, err)
+}
+
+func Test_approvalServer(t *testing.T) {
+	w, webhook, approvers := newTestApprovalWatchdog(t, 2, time.Hour)
+
+	assert.Nil(t, w.act(context.Background(), approveInvariant(), &violation{reason: "violated"}))
+
+	id := webhook.reports[0].ID
+
+	signature := signTestIncidentDecision(t, w, id, approvers[0], true)
+
+	e := w.newApprovalServer()
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			"approve",
+			http.MethodPost,
+			fmt.Sprintf("/incidents/%s/approvals", id),
+			fmt.Sprintf(`{"signature": "%s"}`, hexutil.Encode(signature)),
+			http.StatusOK,
+			`"approvals":1`,
+		},
+		{
+			"get",
+			http.MethodGet,
+			fmt.Sprintf("/incidents/%s", id),
+			"",
+			http.StatusOK,
+			`"invariant":"counting"`,
+		},
+		{
+			"invalidSignature",
+			http.MethodPost,
+			fmt.Sprintf("/incidents/%s/rejections", id),
+			`{"signature": "0x1234"}`,
+			http.StatusUnprocessableEntity,
+			"",
+		},
+		{
+			"notFound",
+			http.MethodGet,
+			"/incidents/0x1",
+			"",
+			http.StatusNotFound,
+			"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.wantBody)
+		})
+	}
+}
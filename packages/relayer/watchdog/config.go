@@ -3,6 +3,7 @@ package watchdog
 import (
 	"crypto/ecdsa"
 	"fmt"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
@@ -10,9 +11,7 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
-	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/rabbitmq"
 	"github.com/urfave/cli/v2"
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
@@ -41,6 +40,7 @@ type Config struct {
 	DatabasePassword        string
 	DatabaseName            string
 	DatabaseHost            string
+	DatabaseDialect         string
 	DatabaseMaxIdleConns    uint64
 	DatabaseMaxOpenConns    uint64
 	DatabaseMaxConnLifetime uint64
@@ -59,6 +59,19 @@ type Config struct {
 
 	SrcTxmgrConfigs  *txmgr.CLIConfig
 	DestTxmgrConfigs *txmgr.CLIConfig
+
+	// invariant configs
+	Invariants        []InvariantConfig
+	InvariantInterval time.Duration
+	ProposalURL       string
+
+	// approval configs
+	IncidentWebhookURL    string
+	Approvers             []common.Address
+	ApprovalQuorum        uint64
+	ApprovalTimeout       time.Duration
+	ApprovalTimeoutAction Action
+	ApprovalHTTPPort      uint64
 }
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
@@ -70,7 +83,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, fmt.Errorf("invalid watchdogPrivateKey: %w", err)
 	}
 
-	return &Config{
+	cfg := &Config{
 		WatchdogPrivateKey:      watchdogPrivateKey,
 		DestBridgeAddress:       common.HexToAddress(c.String(flags.DestBridgeAddress.Name)),
 		SrcBridgeAddress:        common.HexToAddress(c.String(flags.SrcBridgeAddress.Name)),
@@ -78,6 +91,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		DatabasePassword:        c.String(flags.DatabasePassword.Name),
 		DatabaseName:            c.String(flags.DatabaseName.Name),
 		DatabaseHost:            c.String(flags.DatabaseHost.Name),
+		DatabaseDialect:         c.String(flags.DatabaseDialect.Name),
 		DatabaseMaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
 		DatabaseMaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
 		DatabaseMaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
@@ -94,17 +108,26 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		BackoffRetryInterval:    c.Uint64(flags.BackOffRetryInterval.Name),
 		BackOffMaxRetrys:        c.Uint64(flags.BackOffMaxRetrys.Name),
 		ETHClientTimeout:        c.Uint64(flags.ETHClientTimeout.Name),
+		Invariants:              DefaultInvariants,
+		InvariantInterval:       c.Duration(flags.WatchdogInvariantInterval.Name),
+		ProposalURL:             c.String(flags.WatchdogProposalURL.Name),
+		IncidentWebhookURL:      c.String(flags.WatchdogIncidentWebhookURL.Name),
+		ApprovalQuorum:          c.Uint64(flags.WatchdogApprovalQuorum.Name),
+		ApprovalTimeout:         c.Duration(flags.WatchdogApprovalTimeout.Name),
+		ApprovalTimeoutAction:   Action(c.String(flags.WatchdogApprovalTimeoutAction.Name)),
+		ApprovalHTTPPort:        c.Uint64(flags.WatchdogApprovalHTTPPort.Name),
 		OpenDBFunc: func() (db.DB, error) {
 			return db.OpenDBConnection(db.DBConnectionOpts{
 				Name:            c.String(flags.DatabaseUsername.Name),
 				Password:        c.String(flags.DatabasePassword.Name),
 				Database:        c.String(flags.DatabaseName.Name),
 				Host:            c.String(flags.DatabaseHost.Name),
+				Dialect:         c.String(flags.DatabaseDialect.Name),
 				MaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
 				MaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
 				MaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
-				OpenFunc: func(dsn string) (db.DB, error) {
-					gormDB, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+				OpenFunc: func(dialector gorm.Dialector) (db.DB, error) {
+					gormDB, err := gorm.Open(dialector, &gorm.Config{
 						Logger: logger.Default.LogMode(logger.Silent),
 					})
 					if err != nil {
@@ -115,22 +138,6 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 				},
 			})
 		},
-		OpenQueueFunc: func() (queue.Queue, error) {
-			opts := queue.NewQueueOpts{
-				Username:      c.String(flags.QueueUsername.Name),
-				Password:      c.String(flags.QueuePassword.Name),
-				Host:          c.String(flags.QueueHost.Name),
-				Port:          c.String(flags.QueuePort.Name),
-				PrefetchCount: c.Uint64(flags.QueuePrefetchCount.Name),
-			}
-
-			q, err := rabbitmq.NewQueue(opts)
-			if err != nil {
-				return nil, err
-			}
-
-			return q, nil
-		},
 		SrcTxmgrConfigs: pkgFlags.InitTxmgrConfigsFromCli(
 			c.String(flags.SrcRPCUrl.Name),
 			watchdogPrivateKey,
@@ -141,5 +148,58 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 			watchdogPrivateKey,
 			c,
 		),
-	}, nil
+	}
+
+	if path := c.String(flags.WatchdogInvariantsFile.Name); path != "" {
+		if cfg.Invariants, err = LoadInvariants(path); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, approver := range c.StringSlice(flags.WatchdogApprovers.Name) {
+		if !common.IsHexAddress(approver) {
+			return nil, fmt.Errorf("invalid approver address %s", approver)
+		}
+
+		cfg.Approvers = append(cfg.Approvers, common.HexToAddress(approver))
+	}
+
+	for _, inv := range cfg.Invariants {
+		if inv.Action == ActionPropose && cfg.ProposalURL == "" {
+			return nil, fmt.Errorf("invariant %s: %s is required to propose", inv.Name, flags.WatchdogProposalURL.Name)
+		}
+
+		if inv.Action == ActionApprove {
+			if err := cfg.validateApproval(); err != nil {
+				return nil, fmt.Errorf("invariant %s: %w", inv.Name, err)
+			}
+		}
+	}
+
+	cfg.OpenQueueFunc = func() (queue.Queue, error) {
+		return pkgFlags.OpenQueueFromCli(c, cfg.OpenDBFunc)
+	}
+
+	return cfg, nil
+}
+
+// validateApproval validates the configuration of the approval of the incidents.
+func (c *Config) validateApproval() error {
+	if c.IncidentWebhookURL == "" {
+		return fmt.Errorf("%s is required to approve", flags.WatchdogIncidentWebhookURL.Name)
+	}
+
+	if len(c.Approvers) == 0 {
+		return fmt.Errorf("%s are required to approve", flags.WatchdogApprovers.Name)
+	}
+
+	if c.ApprovalQuorum == 0 || c.ApprovalQuorum > uint64(len(c.Approvers)) {
+		return fmt.Errorf("%s must be between 1 and the number of approvers", flags.WatchdogApprovalQuorum.Name)
+	}
+
+	if c.ApprovalTimeoutAction != ActionPause && c.ApprovalTimeoutAction != ActionAlert {
+		return fmt.Errorf("%s must be %q or %q", flags.WatchdogApprovalTimeoutAction.Name, ActionPause, ActionAlert)
+	}
+
+	return nil
 }
@@ -2,6 +2,7 @@ package watchdog
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
@@ -65,6 +66,12 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		assert.Equal(t, uint64(30), c.DatabaseMaxConnLifetime)
 		assert.Equal(t, uint64(10), c.ETHClientTimeout)
 		assert.Equal(t, uint64(100), c.QueuePrefetch)
+		assert.Equal(t, DefaultInvariants, c.Invariants)
+		assert.Equal(t, time.Minute, c.InvariantInterval)
+		assert.Equal(t, uint64(1), c.ApprovalQuorum)
+		assert.Equal(t, 30*time.Minute, c.ApprovalTimeout)
+		assert.Equal(t, ActionPause, c.ApprovalTimeoutAction)
+		assert.Equal(t, uint64(6062), c.ApprovalHTTPPort)
 
 		c.OpenDBFunc = func() (db.DB, error) {
 			return &mock.DB{}, nil
@@ -105,3 +112,60 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		"--" + flags.QueuePrefetchCount.Name, "100",
 	}))
 }
+
+func TestConfig_validateApproval(t *testing.T) {
+	approvers := []common.Address{common.HexToAddress("0x1"), common.HexToAddress("0x2")}
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{
+			"valid",
+			Config{
+				IncidentWebhookURL:    "http://localhost",
+				Approvers:             approvers,
+				ApprovalQuorum:        2,
+				ApprovalTimeoutAction: ActionAlert,
+			},
+			false,
+		},
+		{
+			"noWebhook",
+			Config{
+				Approvers:             approvers,
+				ApprovalQuorum:        1,
+				ApprovalTimeoutAction: ActionPause,
+			},
+			true,
+		},
+		{
+			"quorumAboveApprovers",
+			Config{
+				IncidentWebhookURL:    "http://localhost",
+				Approvers:             approvers,
+				ApprovalQuorum:        3,
+				ApprovalTimeoutAction: ActionPause,
+			},
+			true,
+		},
+		{
+			"invalidTimeoutAction",
+			Config{
+				IncidentWebhookURL:    "http://localhost",
+				Approvers:             approvers,
+				ApprovalQuorum:        1,
+				ApprovalTimeoutAction: ActionPropose,
+			},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.validateApproval()
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package watchdog
+
+import "github.com/cyberhorsey/errors"
+
+var (
+	errIncidentNotFound = errors.NotFound.NewWithKeyAndDetail(
+		"ERR_INCIDENT_NOT_FOUND",
+		"Incident not found, or already closed",
+	)
+	errInvalidSignature = errors.Validation.NewWithKeyAndDetail(
+		"ERR_INVALID_SIGNATURE",
+		"Invalid signature",
+	)
+	errNotApprover = errors.Validation.NewWithKeyAndDetail(
+		"ERR_NOT_APPROVER",
+		"Signer is not an approver",
+	)
+)
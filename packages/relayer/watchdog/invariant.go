@@ -0,0 +1,327 @@
+package watchdog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/signalservice"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+// Action is what the watchdog does when an invariant is violated.
+type Action string
+
+var (
+	// ActionAlert only logs the violation and exports it as a metric.
+	ActionAlert Action = "alert"
+	// ActionPause pauses the bridges of both chains.
+	ActionPause Action = "pause"
+	// ActionPropose opens an admin proposal to pause the bridges of both chains.
+	ActionPropose Action = "propose"
+	// ActionApprove sends a signed incident report to the approvers, and pauses the bridges of
+	// both chains once a quorum of them approves it, or when it times out.
+	ActionApprove Action = "approve"
+)
+
+// InvariantType is the kind of check an invariant performs.
+type InvariantType string
+
+var (
+	// InvariantMessageSent checks that every processed message was sent by the bridge of
+	// the other chain.
+	InvariantMessageSent InvariantType = "messageSent"
+	// InvariantERC20Solvency checks that the vault holds at least as many canonical tokens as
+	// the supply of the bridged token on the other chain.
+	InvariantERC20Solvency InvariantType = "erc20Solvency"
+	// InvariantERC721Solvency checks that the vault holds the canonical token of each of the given
+	// token IDs bridged to the other chain, as bridged ERC721 tokens have no supply.
+	InvariantERC721Solvency InvariantType = "erc721Solvency"
+	// InvariantOutflowLimit checks that the amount of a token released by the processed
+	// messages stays below a limit over a sliding window.
+	InvariantOutflowLimit InvariantType = "outflowLimit"
+	// InvariantSignalRoot checks that the state roots synced to a signal service match the
+	// blocks of the other chain.
+	InvariantSignalRoot InvariantType = "signalRoot"
+)
+
+// the chains an invariant can be configured for.
+var (
+	chainSrc  = "src"
+	chainDest = "dest"
+)
+
+// InvariantConfig is an entry of the invariants file of the watchdog.
+type InvariantConfig struct {
+	Type   InvariantType `json:"type"`
+	Name   string        `json:"name"`
+	Action Action        `json:"action"`
+
+	// Chain is the chain, "src" or "dest", the vault or signal service is deployed on.
+	Chain string `json:"chain"`
+
+	// solvency invariants
+	VaultAddress common.Address `json:"vaultAddress"`
+	Token        common.Address `json:"token"`
+	BridgedToken common.Address `json:"bridgedToken"`
+	TokenIDs     []*big.Int     `json:"tokenIds"`
+
+	// outflow limit invariant, Token is the canonical token, the zero address for ETH.
+	Limit  *big.Int `json:"limit"`
+	Window string   `json:"window"`
+
+	// signal root invariant
+	SignalServiceAddress common.Address `json:"signalServiceAddress"`
+}
+
+// DefaultInvariants are the invariants checked when no invariants file is configured.
+var DefaultInvariants = []InvariantConfig{
+	{
+		Type:   InvariantMessageSent,
+		Name:   string(InvariantMessageSent),
+		Action: ActionPause,
+	},
+}
+
+// LoadInvariants loads and validates the invariants file at the given path.
+func LoadInvariants(path string) ([]InvariantConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var invariants []InvariantConfig
+	if err := json.Unmarshal(data, &invariants); err != nil {
+		return nil, fmt.Errorf("invalid invariants file %s: %w", path, err)
+	}
+
+	if len(invariants) == 0 {
+		return nil, fmt.Errorf("no invariants in %s", path)
+	}
+
+	names := make(map[string]bool, len(invariants))
+
+	for i := range invariants {
+		inv := &invariants[i]
+
+		if inv.Name == "" {
+			inv.Name = string(inv.Type)
+		}
+
+		if names[inv.Name] {
+			return nil, fmt.Errorf("invariant %s: duplicate name", inv.Name)
+		}
+
+		names[inv.Name] = true
+
+		if err := inv.validate(); err != nil {
+			return nil, fmt.Errorf("invariant %s: %w", inv.Name, err)
+		}
+	}
+
+	return invariants, nil
+}
+
+func (c *InvariantConfig) validate() error {
+	switch c.Action {
+	case ActionAlert, ActionPause, ActionPropose, ActionApprove:
+	default:
+		return fmt.Errorf("invalid action %q", c.Action)
+	}
+
+	switch c.Type {
+	case InvariantMessageSent:
+		return nil
+	case InvariantERC20Solvency, InvariantERC721Solvency:
+		if err := c.validateChain(); err != nil {
+			return err
+		}
+
+		if c.VaultAddress == relayer.ZeroAddress || c.Token == relayer.ZeroAddress ||
+			c.BridgedToken == relayer.ZeroAddress {
+			return fmt.Errorf("vaultAddress, token and bridgedToken are required")
+		}
+
+		if c.Type == InvariantERC721Solvency && len(c.TokenIDs) == 0 {
+			return fmt.Errorf("tokenIds are required")
+		}
+
+		return nil
+	case InvariantOutflowLimit:
+		if c.Limit == nil || c.Limit.Sign() <= 0 {
+			return fmt.Errorf("limit must be positive")
+		}
+
+		window, err := time.ParseDuration(c.Window)
+		if err != nil || window <= 0 {
+			return fmt.Errorf("invalid window %q", c.Window)
+		}
+
+		return nil
+	case InvariantSignalRoot:
+		if err := c.validateChain(); err != nil {
+			return err
+		}
+
+		if c.SignalServiceAddress == relayer.ZeroAddress {
+			return fmt.Errorf("signalServiceAddress is required")
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("invalid type %q", c.Type)
+	}
+}
+
+func (c *InvariantConfig) validateChain() error {
+	if c.Chain != chainSrc && c.Chain != chainDest {
+		return fmt.Errorf("chain must be %q or %q", chainSrc, chainDest)
+	}
+
+	return nil
+}
+
+// violation describes why an invariant does not hold.
+type violation struct {
+	reason string
+
+	// message is the processed message which violated a message invariant.
+	message *bridge.IBridgeMessage
+
+	// evidence are the on-chain observations the violation can be verified with.
+	evidence map[string]string
+}
+
+// key identifies the violation among the violations of the given invariant, the violations of
+// a message invariant are told apart by their message.
+func (v *violation) key(inv invariant) string {
+	if v.message == nil {
+		return inv.Name()
+	}
+
+	return fmt.Sprintf("%v:%v:%v", inv.Name(), v.message.SrcChainId, v.message.Id)
+}
+
+// invariant is a property of the bridges the watchdog enforces.
+type invariant interface {
+	Name() string
+	Action() Action
+}
+
+// messageInvariant is an invariant checked for every processed message.
+type messageInvariant interface {
+	invariant
+	checkMessage(ctx context.Context, msg *queue.QueueMessageProcessedBody) (*violation, error)
+}
+
+// periodicInvariant is an invariant checked every invariant interval.
+type periodicInvariant interface {
+	invariant
+	check(ctx context.Context) (*violation, error)
+}
+
+// invariantBase implements the invariant interface from the configuration of an invariant.
+type invariantBase struct {
+	name   string
+	action Action
+}
+
+func (b invariantBase) Name() string {
+	return b.name
+}
+
+func (b invariantBase) Action() Action {
+	return b.action
+}
+
+// chainSide is one of the two chains of the watchdog.
+type chainSide struct {
+	chainID   *big.Int
+	ethClient ethClient
+	backend   bind.ContractBackend
+}
+
+// sides returns the chain the given invariant is configured on, and the other chain.
+func (w *Watchdog) sides(c InvariantConfig) (chainSide, chainSide) {
+	src := chainSide{chainID: w.srcChainId, ethClient: w.srcEthClient, backend: w.srcBackend}
+	dest := chainSide{chainID: w.destChainId, ethClient: w.destEthClient, backend: w.destBackend}
+
+	if c.Chain == chainDest {
+		return dest, src
+	}
+
+	return src, dest
+}
+
+// newInvariants builds the invariants of the given configurations.
+func (w *Watchdog) newInvariants(configs []InvariantConfig) error {
+	w.messageInvariants = nil
+	w.periodicInvariants = nil
+
+	for _, c := range configs {
+		base := invariantBase{name: c.Name, action: c.Action}
+
+		on, other := w.sides(c)
+
+		switch c.Type {
+		case InvariantMessageSent:
+			w.messageInvariants = append(w.messageInvariants, &messageSentInvariant{
+				invariantBase: base,
+				bridge:        w.destBridge,
+				bridgeAddress: w.cfg.DestBridgeAddress,
+				chainID:       w.destChainId,
+				ethClient:     w.destEthClient,
+			})
+		case InvariantERC20Solvency:
+			w.periodicInvariants = append(w.periodicInvariants, &solvencyInvariant{
+				invariantBase: base,
+				vault:         c.VaultAddress,
+				token:         c.Token,
+				bridgedToken:  c.BridgedToken,
+				tokens:        &contractTokenReader{caller: on.backend},
+				bridgedTokens: &contractTokenReader{caller: other.backend},
+			})
+		case InvariantERC721Solvency:
+			w.periodicInvariants = append(w.periodicInvariants, &erc721SolvencyInvariant{
+				invariantBase: base,
+				vault:         c.VaultAddress,
+				token:         c.Token,
+				bridgedToken:  c.BridgedToken,
+				tokenIDs:      c.TokenIDs,
+				tokens:        &contractTokenReader{caller: on.backend},
+				bridgedTokens: &contractTokenReader{caller: other.backend},
+			})
+		case InvariantOutflowLimit:
+			window, err := time.ParseDuration(c.Window)
+			if err != nil {
+				return err
+			}
+
+			w.messageInvariants = append(w.messageInvariants, newOutflowLimitInvariant(base, c.Token, c.Limit, window))
+		case InvariantSignalRoot:
+			signalService, err := signalservice.NewSignalService(c.SignalServiceAddress, on.backend)
+			if err != nil {
+				return err
+			}
+
+			w.periodicInvariants = append(w.periodicInvariants, &signalRootInvariant{
+				invariantBase: base,
+				signalService: signalService,
+				syncedChainID: other.chainID,
+				syncedClient:  other.ethClient,
+			})
+		default:
+			return fmt.Errorf("invariant %s: invalid type %q", c.Name, c.Type)
+		}
+	}
+
+	return nil
+}
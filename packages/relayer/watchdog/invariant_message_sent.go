@@ -0,0 +1,73 @@
+package watchdog
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"strconv"
+
+	"github.com/cyberhorsey/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+// messageSentInvariant checks that a processed message was actually sent on the other chain.
+type messageSentInvariant struct {
+	invariantBase
+
+	// bridge is the bridge which should have sent the message. The source chain of the
+	// `MessageProcessed` event is the destination chain of the message itself, so this is the
+	// bridge of the watchdog's destination chain.
+	bridge        relayer.Bridge
+	bridgeAddress common.Address
+	chainID       *big.Int
+	ethClient     ethClient
+}
+
+func (i *messageSentInvariant) checkMessage(
+	ctx context.Context,
+	msg *queue.QueueMessageProcessedBody,
+) (*violation, error) {
+	// the check is pinned to a block, so it can be verified from the incident report.
+	blockNumber, err := i.ethClient.BlockNumber(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "i.ethClient.BlockNumber")
+	}
+
+	sent, err := i.bridge.IsMessageSent(&bind.CallOpts{
+		Context:     ctx,
+		BlockNumber: new(big.Int).SetUint64(blockNumber),
+	}, msg.Message)
+	if err != nil {
+		return nil, errors.Wrap(err, "i.bridge.IsMessageSent")
+	}
+
+	if sent {
+		slog.Info("dest bridge did send this message", "msgId", msg.Message.Id)
+
+		return nil, nil
+	}
+
+	slog.Warn("dest bridge did not send this message", "msgId", msg.Message.Id)
+
+	// we should alert based on this metric
+	relayer.BridgeMessageNotSent.Inc()
+
+	message := msg.Message
+
+	return &violation{
+		reason:  fmt.Sprintf("message %v was processed but not sent by the other bridge", msg.Message.Id),
+		message: &message,
+		evidence: map[string]string{
+			"call":          "isMessageSent",
+			"result":        strconv.FormatBool(sent),
+			"bridgeAddress": i.bridgeAddress.Hex(),
+			"chainId":       i.chainID.String(),
+			"blockNumber":   strconv.FormatUint(blockNumber, 10),
+		},
+	}, nil
+}
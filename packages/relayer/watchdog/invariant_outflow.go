@@ -0,0 +1,131 @@
+package watchdog
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+// outflow is an amount of a token released by a processed message.
+type outflow struct {
+	msgID  uint64
+	amount *big.Int
+	at     time.Time
+}
+
+// outflowLimitInvariant checks that the amount of a token released by the processed messages
+// stays within a limit over a sliding window. The outflows are kept in memory, so the window
+// starts over when the watchdog restarts.
+type outflowLimitInvariant struct {
+	invariantBase
+
+	// token is the canonical token, the zero address for ETH.
+	token  common.Address
+	limit  *big.Int
+	window time.Duration
+
+	mu       sync.Mutex
+	outflows []outflow
+
+	now func() time.Time
+}
+
+func newOutflowLimitInvariant(
+	base invariantBase,
+	token common.Address,
+	limit *big.Int,
+	window time.Duration,
+) *outflowLimitInvariant {
+	return &outflowLimitInvariant{
+		invariantBase: base,
+		token:         token,
+		limit:         limit,
+		window:        window,
+		now:           time.Now,
+	}
+}
+
+func (i *outflowLimitInvariant) checkMessage(
+	ctx context.Context,
+	msg *queue.QueueMessageProcessedBody,
+) (*violation, error) {
+	amount, err := i.amount(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if amount == nil || amount.Sign() == 0 {
+		return nil, nil
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	now := i.now()
+
+	// drop the outflows which left the window.
+	start := now.Add(-i.window)
+
+	n := 0
+	for n < len(i.outflows) && !i.outflows[n].at.After(start) {
+		n++
+	}
+
+	i.outflows = i.outflows[n:]
+
+	// a message is checked again when it is requeued, it is only counted once.
+	counted := false
+
+	for _, o := range i.outflows {
+		if o.msgID == msg.Message.Id {
+			counted = true
+		}
+	}
+
+	if !counted {
+		i.outflows = append(i.outflows, outflow{msgID: msg.Message.Id, amount: amount, at: now})
+	}
+
+	total := new(big.Int)
+	for _, o := range i.outflows {
+		total.Add(total, o.amount)
+	}
+
+	if total.Cmp(i.limit) <= 0 {
+		return nil, nil
+	}
+
+	return &violation{
+		reason: fmt.Sprintf("outflow of token %s is %v over the last %v, above the limit of %v",
+			i.token.Hex(),
+			total,
+			i.window,
+			i.limit,
+		),
+	}, nil
+}
+
+// amount returns the amount of the invariant's token released by the given message.
+func (i *outflowLimitInvariant) amount(msg *queue.QueueMessageProcessedBody) (*big.Int, error) {
+	if i.token == relayer.ZeroAddress {
+		return msg.Message.Value, nil
+	}
+
+	eventType, canonicalToken, amount, err := relayer.DecodeMessageData(msg.Message.Data, msg.Message.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	if eventType == relayer.EventTypeSendETH || canonicalToken.Address() != i.token {
+		return nil, nil
+	}
+
+	return amount, nil
+}
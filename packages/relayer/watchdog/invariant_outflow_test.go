@@ -0,0 +1,73 @@
+package watchdog
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+func processedETH(id uint64, value int64) *queue.QueueMessageProcessedBody {
+	return &queue.QueueMessageProcessedBody{
+		Message: bridge.IBridgeMessage{
+			Id:    id,
+			Value: big.NewInt(value),
+		},
+	}
+}
+
+func Test_outflowLimitInvariant_checkMessage(t *testing.T) {
+	i := newOutflowLimitInvariant(
+		invariantBase{name: "eth", action: ActionPause},
+		common.Address{},
+		big.NewInt(100),
+		time.Hour,
+	)
+
+	now := time.Now()
+	i.now = func() time.Time { return now }
+
+	v, err := i.checkMessage(context.Background(), processedETH(1, 60))
+	assert.Nil(t, err)
+	assert.Nil(t, v)
+
+	// a requeued message is only counted once.
+	v, err = i.checkMessage(context.Background(), processedETH(1, 60))
+	assert.Nil(t, err)
+	assert.Nil(t, v)
+
+	v, err = i.checkMessage(context.Background(), processedETH(2, 40))
+	assert.Nil(t, err)
+	assert.Nil(t, v)
+
+	v, err = i.checkMessage(context.Background(), processedETH(3, 1))
+	assert.Nil(t, err)
+	assert.NotNil(t, v)
+
+	// the first messages left the window.
+	now = now.Add(time.Hour)
+
+	v, err = i.checkMessage(context.Background(), processedETH(4, 99))
+	assert.Nil(t, err)
+	assert.Nil(t, v)
+}
+
+func Test_outflowLimitInvariant_otherToken(t *testing.T) {
+	i := newOutflowLimitInvariant(
+		invariantBase{name: "token", action: ActionPause},
+		common.HexToAddress("0x1"),
+		big.NewInt(100),
+		time.Hour,
+	)
+
+	// ETH does not count towards the outflow of a token.
+	v, err := i.checkMessage(context.Background(), processedETH(1, 1000))
+	assert.Nil(t, err)
+	assert.Nil(t, v)
+}
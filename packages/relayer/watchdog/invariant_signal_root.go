@@ -0,0 +1,60 @@
+package watchdog
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+// stateRootKind is the kind of the chain data synced to the signal services.
+var stateRootKind = crypto.Keccak256Hash([]byte("STATE_ROOT"))
+
+// signalRootInvariant checks that the latest state root synced to a signal service is the
+// state root of the block of the other chain it was synced from.
+type signalRootInvariant struct {
+	invariantBase
+
+	signalService relayer.SignalService
+
+	// syncedChainID and syncedClient are the chain the signal service syncs the state roots of.
+	syncedChainID *big.Int
+	syncedClient  ethClient
+}
+
+func (i *signalRootInvariant) check(ctx context.Context) (*violation, error) {
+	synced, err := i.signalService.GetSyncedChainData(&bind.CallOpts{
+		Context: ctx,
+	}, i.syncedChainID.Uint64(), stateRootKind, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// nothing was synced yet.
+	if synced.BlockId == 0 {
+		return nil, nil
+	}
+
+	header, err := i.syncedClient.HeaderByNumber(ctx, new(big.Int).SetUint64(synced.BlockId))
+	if err != nil {
+		return nil, err
+	}
+
+	if header.Root == common.Hash(synced.ChainData) {
+		return nil, nil
+	}
+
+	return &violation{
+		reason: fmt.Sprintf("state root %s synced for block %v of chain %v does not match the block's state root %s",
+			common.Hash(synced.ChainData).Hex(),
+			synced.BlockId,
+			i.syncedChainID,
+			header.Root.Hex(),
+		),
+	}, nil
+}
@@ -0,0 +1,60 @@
+package watchdog
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+// rootEthClient is a mock eth client whose blocks have the configured state root.
+type rootEthClient struct {
+	mock.EthClient
+	root common.Hash
+}
+
+func (c *rootEthClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{
+		Number: number,
+		Root:   c.root,
+	}, nil
+}
+
+func Test_signalRootInvariant_check(t *testing.T) {
+	tests := []struct {
+		name         string
+		root         common.Hash
+		wantViolated bool
+	}{
+		{
+			"matches",
+			common.Hash{},
+			false,
+		},
+		{
+			"mismatch",
+			common.HexToHash("0x1"),
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &signalRootInvariant{
+				invariantBase: invariantBase{name: tt.name, action: ActionAlert},
+				signalService: &mock.SignalService{},
+				syncedChainID: big.NewInt(1),
+				syncedClient:  &rootEthClient{root: tt.root},
+			}
+
+			v, err := i.check(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantViolated, v != nil)
+		})
+	}
+}
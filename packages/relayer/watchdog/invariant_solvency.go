@@ -0,0 +1,95 @@
+package watchdog
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// solvencyInvariant checks that a vault holds at least as many canonical tokens as there are
+// bridged tokens on the other chain, so every bridged token can be redeemed. The vault may also
+// lock tokens bridged to other chains, so its balance can exceed the bridged supply.
+type solvencyInvariant struct {
+	invariantBase
+
+	vault        common.Address
+	token        common.Address
+	bridgedToken common.Address
+
+	tokens        tokenReader
+	bridgedTokens tokenReader
+}
+
+func (i *solvencyInvariant) check(ctx context.Context) (*violation, error) {
+	locked, err := i.tokens.balanceOf(ctx, i.token, i.vault)
+	if err != nil {
+		return nil, err
+	}
+
+	supply, err := i.bridgedTokens.totalSupply(ctx, i.bridgedToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if locked.Cmp(supply) >= 0 {
+		return nil, nil
+	}
+
+	return &violation{reason: fmt.Sprintf("vault %s holds %v of token %s, less than the %v bridged tokens %s",
+		i.vault.Hex(),
+		locked,
+		i.token.Hex(),
+		supply,
+		i.bridgedToken.Hex(),
+	)}, nil
+}
+
+// erc721SolvencyInvariant checks that a vault holds the canonical token of each of the given
+// token IDs which exists as a bridged token on the other chain. Bridged ERC721 tokens have no
+// supply, so only the configured token IDs are checked.
+type erc721SolvencyInvariant struct {
+	invariantBase
+
+	vault        common.Address
+	token        common.Address
+	bridgedToken common.Address
+	tokenIDs     []*big.Int
+
+	tokens        tokenReader
+	bridgedTokens tokenReader
+}
+
+func (i *erc721SolvencyInvariant) check(ctx context.Context) (*violation, error) {
+	for _, id := range i.tokenIDs {
+		bridgedOwner, err := i.bridgedTokens.ownerOf(ctx, i.bridgedToken, id)
+		if err != nil {
+			return nil, err
+		}
+
+		// the token ID is not bridged to the other chain.
+		if bridgedOwner == (common.Address{}) {
+			continue
+		}
+
+		owner, err := i.tokens.ownerOf(ctx, i.token, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if owner == i.vault {
+			continue
+		}
+
+		return &violation{reason: fmt.Sprintf(
+			"vault %s does not hold token ID %v of token %s, which is bridged as token %s",
+			i.vault.Hex(),
+			id,
+			i.token.Hex(),
+			i.bridgedToken.Hex(),
+		)}, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,112 @@
+package watchdog
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// fixedTokenReader is a mock token reader returning the configured balance, supply and owners.
+type fixedTokenReader struct {
+	balance int64
+	supply  int64
+	owners  map[int64]common.Address
+}
+
+func (r *fixedTokenReader) balanceOf(
+	ctx context.Context,
+	token common.Address,
+	owner common.Address,
+) (*big.Int, error) {
+	return big.NewInt(r.balance), nil
+}
+
+func (r *fixedTokenReader) totalSupply(ctx context.Context, token common.Address) (*big.Int, error) {
+	return big.NewInt(r.supply), nil
+}
+
+func (r *fixedTokenReader) ownerOf(ctx context.Context, token common.Address, id *big.Int) (common.Address, error) {
+	return r.owners[id.Int64()], nil
+}
+
+func Test_solvencyInvariant_check(t *testing.T) {
+	tests := []struct {
+		name         string
+		locked       int64
+		supply       int64
+		wantViolated bool
+	}{
+		{"solvent", 100, 100, false},
+		{"lockedForOtherChains", 150, 100, false},
+		{"insolvent", 99, 100, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &solvencyInvariant{
+				invariantBase: invariantBase{name: tt.name, action: ActionAlert},
+				vault:         common.HexToAddress("0x1"),
+				token:         common.HexToAddress("0x2"),
+				bridgedToken:  common.HexToAddress("0x3"),
+				tokens:        &fixedTokenReader{balance: tt.locked},
+				bridgedTokens: &fixedTokenReader{supply: tt.supply},
+			}
+
+			v, err := i.check(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantViolated, v != nil)
+		})
+	}
+}
+
+func Test_erc721SolvencyInvariant_check(t *testing.T) {
+	vault := common.HexToAddress("0x1")
+	user := common.HexToAddress("0x4")
+
+	tests := []struct {
+		name         string
+		owners       map[int64]common.Address
+		bridged      map[int64]common.Address
+		wantViolated bool
+	}{
+		{
+			"solvent",
+			map[int64]common.Address{1: vault, 2: vault},
+			map[int64]common.Address{1: user, 2: user},
+			false,
+		},
+		{
+			"notBridged",
+			map[int64]common.Address{1: vault, 2: user},
+			map[int64]common.Address{1: user},
+			false,
+		},
+		{
+			"insolvent",
+			map[int64]common.Address{1: vault, 2: user},
+			map[int64]common.Address{1: user, 2: user},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &erc721SolvencyInvariant{
+				invariantBase: invariantBase{name: tt.name, action: ActionAlert},
+				vault:         vault,
+				token:         common.HexToAddress("0x2"),
+				bridgedToken:  common.HexToAddress("0x3"),
+				tokenIDs:      []*big.Int{big.NewInt(1), big.NewInt(2)},
+				tokens:        &fixedTokenReader{owners: tt.owners},
+				bridgedTokens: &fixedTokenReader{owners: tt.bridged},
+			}
+
+			v, err := i.check(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantViolated, v != nil)
+		})
+	}
+}
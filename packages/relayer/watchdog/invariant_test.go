@@ -0,0 +1,148 @@
+package watchdog
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeInvariants(t *testing.T, data string) string {
+	path := filepath.Join(t.TempDir(), "invariants.json")
+
+	assert.Nil(t, os.WriteFile(path, []byte(data), 0600))
+
+	return path
+}
+
+func Test_LoadInvariants(t *testing.T) {
+	path := writeInvariants(t, `[
+		{"type": "messageSent", "action": "pause"},
+		{
+			"type": "erc20Solvency",
+			"name": "usdc",
+			"action": "alert",
+			"chain": "src",
+			"vaultAddress": "0x1000000000000000000000000000000000000001",
+			"token": "0x2000000000000000000000000000000000000002",
+			"bridgedToken": "0x3000000000000000000000000000000000000003"
+		},
+		{
+			"type": "erc721Solvency",
+			"action": "alert",
+			"chain": "dest",
+			"vaultAddress": "0x1000000000000000000000000000000000000001",
+			"token": "0x2000000000000000000000000000000000000002",
+			"bridgedToken": "0x3000000000000000000000000000000000000003",
+			"tokenIds": [1, 2]
+		},
+		{"type": "outflowLimit", "action": "propose", "limit": 1000000000000000000000, "window": "1h"},
+		{
+			"type": "signalRoot",
+			"action": "pause",
+			"chain": "dest",
+			"signalServiceAddress": "0x4000000000000000000000000000000000000004"
+		}
+	]`)
+
+	invariants, err := LoadInvariants(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(invariants))
+
+	assert.Equal(t, "messageSent", invariants[0].Name)
+	assert.Equal(t, ActionPause, invariants[0].Action)
+
+	assert.Equal(t, "usdc", invariants[1].Name)
+	assert.Equal(t, common.HexToAddress("0x1000000000000000000000000000000000000001"), invariants[1].VaultAddress)
+
+	assert.Equal(t, []*big.Int{big.NewInt(1), big.NewInt(2)}, invariants[2].TokenIDs)
+
+	limit, _ := new(big.Int).SetString("1000000000000000000000", 10)
+	assert.Equal(t, limit, invariants[3].Limit)
+	assert.Equal(t, ActionPropose, invariants[3].Action)
+
+	assert.Equal(t, "dest", invariants[4].Chain)
+}
+
+func Test_LoadInvariants_invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{
+			"empty",
+			`[]`,
+		},
+		{
+			"invalidAction",
+			`[{"type": "messageSent", "action": "ignore"}]`,
+		},
+		{
+			"invalidType",
+			`[{"type": "unknown", "action": "alert"}]`,
+		},
+		{
+			"duplicateName",
+			`[{"type": "messageSent", "action": "alert"}, {"type": "messageSent", "action": "pause"}]`,
+		},
+		{
+			"solvencyMissingChain",
+			`[{
+				"type": "erc20Solvency",
+				"action": "alert",
+				"vaultAddress": "0x1000000000000000000000000000000000000001",
+				"token": "0x2000000000000000000000000000000000000002",
+				"bridgedToken": "0x3000000000000000000000000000000000000003"
+			}]`,
+		},
+		{
+			"solvencyMissingToken",
+			`[{"type": "erc721Solvency", "action": "alert", "chain": "src"}]`,
+		},
+		{
+			"erc721MissingTokenIds",
+			`[{
+				"type": "erc721Solvency",
+				"action": "alert",
+				"chain": "src",
+				"vaultAddress": "0x1000000000000000000000000000000000000001",
+				"token": "0x2000000000000000000000000000000000000002",
+				"bridgedToken": "0x3000000000000000000000000000000000000003"
+			}]`,
+		},
+		{
+			"erc1155Solvency",
+			`[{
+				"type": "erc1155Solvency",
+				"action": "alert",
+				"chain": "src",
+				"vaultAddress": "0x1000000000000000000000000000000000000001",
+				"token": "0x2000000000000000000000000000000000000002",
+				"bridgedToken": "0x3000000000000000000000000000000000000003",
+				"tokenIds": [1]
+			}]`,
+		},
+		{
+			"outflowMissingLimit",
+			`[{"type": "outflowLimit", "action": "alert", "window": "1h"}]`,
+		},
+		{
+			"outflowInvalidWindow",
+			`[{"type": "outflowLimit", "action": "alert", "limit": 1, "window": "1 hour"}]`,
+		},
+		{
+			"signalRootMissingAddress",
+			`[{"type": "signalRoot", "action": "alert", "chain": "src"}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadInvariants(writeInvariants(t, tt.data))
+			assert.NotNil(t, err)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package watchdog
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
+)
+
+// tokenReader reads the balances, supplies and owners of the tokens checked by the solvency invariants.
+type tokenReader interface {
+	balanceOf(ctx context.Context, token common.Address, owner common.Address) (*big.Int, error)
+	totalSupply(ctx context.Context, token common.Address) (*big.Int, error)
+	// ownerOf returns the owner of the given ERC721 token ID, the zero address if the token
+	// does not exist.
+	ownerOf(ctx context.Context, token common.Address, id *big.Int) (common.Address, error)
+}
+
+// contractTokenReader reads the tokens from the chain.
+type contractTokenReader struct {
+	caller bind.ContractCaller
+}
+
+func (r *contractTokenReader) balanceOf(
+	ctx context.Context,
+	token common.Address,
+	owner common.Address,
+) (*big.Int, error) {
+	values, err := r.call(ctx, token, encoding.TokenABI, "balanceOf", owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.ConvertType(values[0], new(big.Int)).(*big.Int), nil
+}
+
+func (r *contractTokenReader) totalSupply(ctx context.Context, token common.Address) (*big.Int, error) {
+	values, err := r.call(ctx, token, encoding.TokenABI, "totalSupply")
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.ConvertType(values[0], new(big.Int)).(*big.Int), nil
+}
+
+func (r *contractTokenReader) ownerOf(
+	ctx context.Context,
+	token common.Address,
+	id *big.Int,
+) (common.Address, error) {
+	values, err := r.call(ctx, token, encoding.ERC721ABI, "ownerOf", id)
+	if err != nil {
+		// ownerOf reverts for the tokens which were never minted, or were burnt.
+		if isRevert(err) {
+			return common.Address{}, nil
+		}
+
+		return common.Address{}, err
+	}
+
+	return values[0].(common.Address), nil
+}
+
+// call calls a view method of the token and returns its unpacked outputs.
+func (r *contractTokenReader) call(
+	ctx context.Context,
+	token common.Address,
+	tokenABI *abi.ABI,
+	method string,
+	args ...interface{},
+) ([]interface{}, error) {
+	data, err := tokenABI.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := r.caller.CallContract(ctx, ethereum.CallMsg{
+		To:   &token,
+		Data: data,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s of %s: %w", method, token.Hex(), err)
+	}
+
+	values, err := tokenABI.Unpack(method, out)
+	if err != nil {
+		return nil, fmt.Errorf("%s of %s: %w", method, token.Hex(), err)
+	}
+
+	return values, nil
+}
+
+// isRevert reports whether the given call error is a revert of the called contract, rather than
+// an error of the node.
+func isRevert(err error) bool {
+	return strings.Contains(err.Error(), vm.ErrExecutionReverted.Error())
+}
@@ -23,7 +23,6 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/taikoxyz/taiko-mono/packages/relayer"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
-	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/repo"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/utils"
@@ -37,6 +36,7 @@ type ethClient interface {
 	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
 	BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error)
 	HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
 	SuggestGasPrice(ctx context.Context) (*big.Int, error)
 	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
 	ChainID(ctx context.Context) (*big.Int, error)
@@ -46,13 +46,17 @@ type ethClient interface {
 type Watchdog struct {
 	cancel context.CancelFunc
 
-	eventRepo relayer.EventRepository
+	eventRepo    relayer.EventRepository
+	incidentRepo relayer.IncidentRepository
 
 	queue queue.Queue
 
 	srcEthClient  ethClient
 	destEthClient ethClient
 
+	srcBackend  bind.ContractBackend
+	destBackend bind.ContractBackend
+
 	ecdsaKey *ecdsa.PrivateKey
 
 	srcBridge  relayer.Bridge
@@ -78,6 +82,16 @@ type Watchdog struct {
 	srcTxmgr  txmgr.TxManager
 	destTxmgr txmgr.TxManager
 
+	messageInvariants  []messageInvariant
+	periodicInvariants []periodicInvariant
+
+	mu       sync.Mutex
+	violated map[string]bool
+	proposed map[string]bool
+
+	incidents     map[string]*incident
+	openIncidents map[string]string
+
 	cfg *Config
 }
 
@@ -102,6 +116,11 @@ func InitFromConfig(ctx context.Context, w *Watchdog, cfg *Config) error {
 		return err
 	}
 
+	incidentRepository, err := repo.NewIncidentRepository(db)
+	if err != nil {
+		return err
+	}
+
 	srcEthClient, err := ethclient.Dial(cfg.SrcRPCUrl)
 	if err != nil {
 		return err
@@ -165,10 +184,14 @@ func InitFromConfig(ctx context.Context, w *Watchdog, cfg *Config) error {
 	}
 
 	w.eventRepo = eventRepository
+	w.incidentRepo = incidentRepository
 
 	w.srcEthClient = srcEthClient
 	w.destEthClient = destEthClient
 
+	w.srcBackend = srcEthClient
+	w.destBackend = destEthClient
+
 	w.destBridge = destBridge
 	w.srcBridge = srcBridge
 
@@ -191,6 +214,15 @@ func InitFromConfig(ctx context.Context, w *Watchdog, cfg *Config) error {
 
 	w.cfg = cfg
 
+	w.violated = make(map[string]bool)
+	w.proposed = make(map[string]bool)
+	w.incidents = make(map[string]*incident)
+	w.openIncidents = make(map[string]string)
+
+	if err := w.newInvariants(cfg.Invariants); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -238,6 +270,24 @@ func (w *Watchdog) Start() error {
 
 	go w.eventLoop(ctx)
 
+	for _, inv := range w.cfg.Invariants {
+		if inv.Action == ActionApprove {
+			if err := w.resumeIncidents(ctx); err != nil {
+				return err
+			}
+
+			w.startApprovalServer(ctx)
+
+			break
+		}
+	}
+
+	if len(w.periodicInvariants) > 0 {
+		w.wg.Add(1)
+
+		go w.invariantLoop(ctx)
+	}
+
 	go func() {
 		if err := backoff.Retry(func() error {
 			return utils.ScanBlocks(ctx, w.srcEthClient, &w.wg)
@@ -282,123 +332,89 @@ func (w *Watchdog) eventLoop(ctx context.Context) {
 	}
 }
 
-// checkMessage checks a MessageReceived event message and makes sure
-// that the message was actually sent on the source chain. If it wasn't,
-// we send a suspend transaction.
+// checkMessage checks a MessageProcessed event message against the message invariants,
+// and acts on the violated ones.
 func (w *Watchdog) checkMessage(ctx context.Context, msg queue.Message) error {
 	msgBody := &queue.QueueMessageProcessedBody{}
 	if err := json.Unmarshal(msg.Body, msgBody); err != nil {
 		return errors.Wrap(err, "json.Unmarshal")
 	}
 
-	// check if the source chain sent this message.
-	// source chain will be `destBridge`, because this indexer
-	// responds to `MessageProcessed` events. to the source chain
-	// of the `MessageProcessed` event, is the destination chain
-	// of the actual message itself. So the `destBridge` is the bridge
-	// which should have sent the message originally.
-	sent, err := w.destBridge.IsMessageSent(nil, msgBody.Message)
-	if err != nil {
-		return errors.Wrap(err, "w.destBridge.IsMessageSent")
-	}
-
-	// if so, do nothing, acknowledge message
-	if sent {
-		slog.Info("dest bridge did send this message. returning early",
-			"sent", sent,
-		)
-
-		return nil
-	}
-
-	slog.Warn("dest bridge did not send this message", "msgId", msgBody.Message.Id)
+	for _, inv := range w.messageInvariants {
+		v, err := inv.checkMessage(ctx, msgBody)
+		if err != nil {
+			relayer.WatchdogInvariantCheckErrors.WithLabelValues(inv.Name()).Inc()
 
-	// we should alert based on this metric
-	relayer.BridgeMessageNotSent.Inc()
-
-	pauseReceipt, err := w.pauseBridge(ctx, w.srcBridge, w.cfg.SrcBridgeAddress, w.srcTxmgr)
-	if err != nil {
-		return err
-	}
-
-	if pauseReceipt != nil {
-		slog.Info("Mined pause tx",
-			"txHash", pauseReceipt.TxHash.Hex(),
-			"bridgeAddress", w.cfg.SrcBridgeAddress.Hex(),
-		)
-
-		if pauseReceipt.Status != types.ReceiptStatusSuccessful {
-			slog.Error("Error pausing bridge", "bridgeAddress", w.cfg.SrcBridgeAddress)
+			return errors.Wrap(err, "inv.checkMessage")
+		}
 
-			relayer.BridgePausedErrors.Inc()
+		if v == nil {
+			continue
+		}
 
+		if err := w.act(ctx, inv, v); err != nil {
 			return err
 		}
 	}
 
-	relayer.BridgePaused.Inc()
-
-	pauseReceipt, err = w.pauseBridge(ctx, w.destBridge, w.cfg.DestBridgeAddress, w.destTxmgr)
-	if err != nil {
-		return err
-	}
+	return nil
+}
 
-	if pauseReceipt != nil {
-		slog.Info("Mined pause tx",
-			"txHash", pauseReceipt.TxHash.Hex(),
-			"bridgeAddress", w.cfg.DestBridgeAddress.Hex(),
-		)
+// invariantLoop checks the periodic invariants every invariant interval.
+func (w *Watchdog) invariantLoop(ctx context.Context) {
+	defer w.wg.Done()
 
-		if pauseReceipt.Status != types.ReceiptStatusSuccessful {
-			slog.Error("Error pausing bridge", "bridgeAddress", w.cfg.DestBridgeAddress)
+	t := time.NewTicker(w.cfg.InvariantInterval)
+	defer t.Stop()
 
-			relayer.BridgePausedErrors.Inc()
+	for {
+		w.checkInvariants(ctx)
 
-			return err
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
 		}
 	}
+}
 
-	relayer.BridgePaused.Inc()
+// checkInvariants checks the periodic invariants, and acts on the ones which became violated
+// since they were last checked.
+func (w *Watchdog) checkInvariants(ctx context.Context) {
+	for _, inv := range w.periodicInvariants {
+		v, err := inv.check(ctx)
+		if err != nil {
+			relayer.WatchdogInvariantCheckErrors.WithLabelValues(inv.Name()).Inc()
 
-	return nil
-}
+			slog.Error("error checking invariant", "invariant", inv.Name(), "error", err)
 
-func (w *Watchdog) pauseBridge(
-	ctx context.Context,
-	bridge relayer.Bridge,
-	bridgeAddress common.Address,
-	mgr txmgr.TxManager,
-) (*types.Receipt, error) {
-	paused, err := bridge.Paused(&bind.CallOpts{
-		Context: ctx,
-	})
-	if err != nil {
-		return nil, err
-	}
+			continue
+		}
 
-	if paused {
-		slog.Info("bridge already paused")
+		w.mu.Lock()
+		wasViolated := w.violated[inv.Name()]
+		w.violated[inv.Name()] = v != nil
+		w.mu.Unlock()
 
-		return nil, nil
-	}
+		if v == nil {
+			relayer.WatchdogInvariantViolated.WithLabelValues(inv.Name()).Set(0)
 
-	data, err := encoding.BridgeABI.Pack("pause")
-	if err != nil {
-		return nil, errors.Wrap(err, "encoding.BridgeABI.Pack")
-	}
+			continue
+		}
 
-	// pause the src bridge, which is the DESTINATION of the original message.
-	candidate := txmgr.TxCandidate{
-		TxData: data,
-		Blobs:  nil,
-		To:     &bridgeAddress,
-	}
+		relayer.WatchdogInvariantViolated.WithLabelValues(inv.Name()).Set(1)
 
-	receipt, err := mgr.Send(ctx, candidate)
-	if err != nil {
-		slog.Warn("Failed to send pause transaction", "error", err.Error())
-		return nil, err
-	}
+		if wasViolated {
+			continue
+		}
+
+		if err := w.act(ctx, inv, v); err != nil {
+			slog.Error("error acting on invariant", "invariant", inv.Name(), "error", err)
 
-	return receipt, nil
+			// act again on the next check.
+			w.mu.Lock()
+			w.violated[inv.Name()] = false
+			w.mu.Unlock()
+		}
+	}
 }
@@ -1,12 +1,74 @@
 package watchdog
 
 import (
+	"context"
+	"encoding/json"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
 )
 
+// sendingTxManager is a mock transaction manager recording the sent transactions.
+type sendingTxManager struct {
+	mock.TxManager
+	sent []txmgr.TxCandidate
+}
+
+func (t *sendingTxManager) Send(ctx context.Context, candidate txmgr.TxCandidate) (*types.Receipt, error) {
+	t.sent = append(t.sent, candidate)
+
+	return &types.Receipt{Status: types.ReceiptStatusSuccessful}, nil
+}
+
+// countingInvariant is a periodic invariant which is violated when configured to, and counts
+// its checks.
+type countingInvariant struct {
+	invariantBase
+	violated bool
+	checks   int
+}
+
+func (i *countingInvariant) check(ctx context.Context) (*violation, error) {
+	i.checks++
+
+	if !i.violated {
+		return nil, nil
+	}
+
+	return &violation{reason: "violated"}, nil
+}
+
+func newTestWatchdog() *Watchdog {
+	return &Watchdog{
+		incidentRepo:  mock.NewIncidentRepository(),
+		srcBridge:     &mock.Bridge{},
+		destBridge:    &mock.Bridge{},
+		srcTxmgr:      &sendingTxManager{},
+		destTxmgr:     &sendingTxManager{},
+		destEthClient: &mock.EthClient{},
+		srcChainId:    big.NewInt(1),
+		destChainId:   big.NewInt(2),
+		violated:      make(map[string]bool),
+		proposed:      make(map[string]bool),
+		incidents:     make(map[string]*incident),
+		openIncidents: make(map[string]string),
+		cfg: &Config{
+			SrcBridgeAddress:  common.HexToAddress(srcBridgeAddr),
+			DestBridgeAddress: common.HexToAddress(destBridgeAddr),
+		},
+	}
+}
+
 func Test_Name(t *testing.T) {
 	w := Watchdog{}
 
@@ -21,3 +83,81 @@ func Test_queueName(t *testing.T) {
 
 	assert.Equal(t, "1-2-MessageProcessed-queue", w.queueName())
 }
+
+func Test_checkMessage_notSent(t *testing.T) {
+	w := newTestWatchdog()
+	assert.Nil(t, w.newInvariants(DefaultInvariants))
+
+	body, err := json.Marshal(queue.QueueMessageProcessedBody{
+		Message: bridge.IBridgeMessage{Id: 1, Value: big.NewInt(1)},
+	})
+	assert.Nil(t, err)
+
+	// the mock bridge never sent the message, so both bridges are paused.
+	assert.Nil(t, w.checkMessage(context.Background(), queue.Message{Body: body}))
+
+	assert.Equal(t, 1, len(w.srcTxmgr.(*sendingTxManager).sent))
+	assert.Equal(t, common.HexToAddress(srcBridgeAddr), *w.srcTxmgr.(*sendingTxManager).sent[0].To)
+	assert.Equal(t, 1, len(w.destTxmgr.(*sendingTxManager).sent))
+	assert.Equal(t, common.HexToAddress(destBridgeAddr), *w.destTxmgr.(*sendingTxManager).sent[0].To)
+}
+
+func Test_checkInvariants_actsOnce(t *testing.T) {
+	w := newTestWatchdog()
+
+	inv := &countingInvariant{
+		invariantBase: invariantBase{name: "counting", action: ActionPause},
+		violated:      true,
+	}
+	w.periodicInvariants = []periodicInvariant{inv}
+
+	w.checkInvariants(context.Background())
+	w.checkInvariants(context.Background())
+
+	// the bridges are paused when the invariant becomes violated, not on every check.
+	assert.Equal(t, 2, inv.checks)
+	assert.Equal(t, 1, len(w.srcTxmgr.(*sendingTxManager).sent))
+
+	inv.violated = false
+	w.checkInvariants(context.Background())
+
+	inv.violated = true
+	w.checkInvariants(context.Background())
+
+	assert.Equal(t, 2, len(w.srcTxmgr.(*sendingTxManager).sent))
+}
+
+func Test_propose(t *testing.T) {
+	var proposals []adminProposal
+
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		var p adminProposal
+
+		assert.Nil(t, json.NewDecoder(req.Body).Decode(&p))
+
+		proposals = append(proposals, p)
+	}))
+	defer srv.Close()
+
+	w := newTestWatchdog()
+	w.cfg.ProposalURL = srv.URL
+
+	inv := &countingInvariant{
+		invariantBase: invariantBase{name: "counting", action: ActionPropose},
+	}
+
+	assert.Nil(t, w.act(context.Background(), inv, &violation{reason: "violated"}))
+
+	// a proposal is only opened once for each invariant.
+	assert.Nil(t, w.act(context.Background(), inv, &violation{reason: "violated"}))
+
+	assert.Equal(t, 1, len(proposals))
+	assert.Equal(t, "counting", proposals[0].Invariant)
+	assert.Equal(t, "violated", proposals[0].Reason)
+	assert.Equal(t, 2, len(proposals[0].Transactions))
+	assert.Equal(t, uint64(1), proposals[0].Transactions[0].ChainID)
+	assert.Equal(t, common.HexToAddress(srcBridgeAddr).Hex(), proposals[0].Transactions[0].To)
+
+	// proposing does not pause the bridges.
+	assert.Equal(t, 0, len(w.srcTxmgr.(*sendingTxManager).sent))
+}
@@ -0,0 +1,151 @@
+package relayer
+
+import (
+	"context"
+	"time"
+)
+
+// WebhookEvent is a lifecycle transition of a bridge message, which webhooks are notified of.
+type WebhookEvent string
+
+var (
+	// WebhookEventMessageSent is published when a MessageSent event is indexed.
+	WebhookEventMessageSent WebhookEvent = "message.sent"
+	// WebhookEventMessageProcessable is published when the processor built the proof of a message
+	// and found it profitable, right before sending its processMessage transaction.
+	WebhookEventMessageProcessable WebhookEvent = "message.processable"
+	// WebhookEventMessageProcessed is published when a message is processed on the destination chain,
+	// from its MessageStatusChanged event only, which is also emitted when a retried message is done.
+	WebhookEventMessageProcessed WebhookEvent = "message.processed"
+	// WebhookEventMessageRetriable is published when a message invocation failed, and it can be retried.
+	WebhookEventMessageRetriable WebhookEvent = "message.retriable"
+	// WebhookEventMessageFailed is published when a message failed permanently, and it can be recalled.
+	WebhookEventMessageFailed WebhookEvent = "message.failed"
+	// WebhookEventMessageRecalled is published when a message is recalled on the source chain.
+	WebhookEventMessageRecalled WebhookEvent = "message.recalled"
+)
+
+// WebhookEvents are all the webhook events.
+var WebhookEvents = []WebhookEvent{
+	WebhookEventMessageSent,
+	WebhookEventMessageProcessable,
+	WebhookEventMessageProcessed,
+	WebhookEventMessageRetriable,
+	WebhookEventMessageFailed,
+	WebhookEventMessageRecalled,
+}
+
+// WebhookEventFromStatus returns the webhook event of a message status change, and false
+// if the status is not a lifecycle transition webhooks are notified of.
+func WebhookEventFromStatus(status EventStatus) (WebhookEvent, bool) {
+	switch status {
+	case EventStatusDone:
+		return WebhookEventMessageProcessed, true
+	case EventStatusRetriable:
+		return WebhookEventMessageRetriable, true
+	case EventStatusFailed:
+		return WebhookEventMessageFailed, true
+	case EventStatusRecalled:
+		return WebhookEventMessageRecalled, true
+	default:
+		return "", false
+	}
+}
+
+// WebhookDeliveryStatus is the status of a webhook delivery.
+type WebhookDeliveryStatus string
+
+var (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+// Webhook is a registered webhook, notified of the lifecycle transitions of the messages
+// matching its filters. Empty filters match all messages.
+type Webhook struct {
+	ID     int    `json:"id"`
+	URL    string `json:"url"`
+	Secret string `json:"-"`
+	// Address filters the messages by their source or destination owner.
+	Address string `json:"address"`
+	// Token filters the messages by their canonical token address.
+	Token string `json:"token"`
+	// Events is a comma separated list of the webhook events to notify of, all events if empty.
+	Events    string    `json:"events"`
+	Enabled   bool      `json:"enabled"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// WebhookNotification is the payload delivered to webhooks.
+type WebhookNotification struct {
+	Event                 WebhookEvent `json:"event"`
+	MsgHash               string       `json:"msgHash"`
+	Status                string       `json:"status"`
+	SrcChainID            uint64       `json:"srcChainID"`
+	DestChainID           uint64       `json:"destChainID"`
+	SrcOwner              string       `json:"srcOwner"`
+	DestOwner             string       `json:"destOwner"`
+	CanonicalTokenAddress string       `json:"canonicalTokenAddress"`
+	Amount                string       `json:"amount"`
+	ChainID               uint64       `json:"chainID"`
+	BlockID               uint64       `json:"blockID"`
+	TxHash                string       `json:"txHash"`
+}
+
+// WebhookDelivery is a row of the webhook delivery log.
+type WebhookDelivery struct {
+	ID             int                   `json:"id"`
+	WebhookID      int                   `json:"webhookID"`
+	Event          WebhookEvent          `json:"event"`
+	MsgHash        string                `json:"msgHash"`
+	Payload        string                `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       uint64                `json:"attempts"`
+	ResponseStatus int                   `json:"responseStatus"`
+	Error          string                `json:"error"`
+	NextAttemptAt  time.Time             `json:"nextAttemptAt"`
+	CreatedAt      time.Time             `json:"createdAt"`
+	UpdatedAt      time.Time             `json:"updatedAt"`
+}
+
+type SaveWebhookOpts struct {
+	URL     string
+	Secret  string
+	Address string
+	Token   string
+	Events  string
+}
+
+type SaveWebhookDeliveryOpts struct {
+	WebhookID int
+	Event     WebhookEvent
+	MsgHash   string
+	Payload   string
+}
+
+type UpdateWebhookDeliveryOpts struct {
+	Status         WebhookDeliveryStatus
+	Attempts       uint64
+	ResponseStatus int
+	Error          string
+	NextAttemptAt  time.Time
+}
+
+// WebhookRepository is used to interact with webhooks and their delivery log in the store
+type WebhookRepository interface {
+	// Save registers an enabled webhook.
+	Save(ctx context.Context, opts *SaveWebhookOpts) (*Webhook, error)
+	FindAll(ctx context.Context) ([]*Webhook, error)
+	FindAllEnabled(ctx context.Context) ([]*Webhook, error)
+	UpdateEnabled(ctx context.Context, id int, enabled bool) error
+	FirstByID(ctx context.Context, id int) (*Webhook, error)
+	// SaveDelivery saves a pending delivery, a delivery of the same event and message
+	// to the same webhook is only saved once.
+	SaveDelivery(ctx context.Context, opts *SaveWebhookDeliveryOpts) error
+	// ClaimDueDeliveries returns the pending deliveries whose next attempt is due, and postpones
+	// their next attempt by the lease, so they are not claimed by other relayer instances meanwhile.
+	ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, id int, opts *UpdateWebhookDeliveryOpts) error
+}
@@ -10,13 +10,14 @@ import (
 )
 
 var (
-	commonCategory   = "COMMON"
-	metricsCategory  = "METRICS"
-	loggingCategory  = "LOGGING"
-	driverCategory   = "DRIVER"
-	proposerCategory = "PROPOSER"
-	proverCategory   = "PROVER"
-	txmgrCategory    = "TX_MANAGER"
+	commonCategory          = "COMMON"
+	metricsCategory         = "METRICS"
+	loggingCategory         = "LOGGING"
+	driverCategory          = "DRIVER"
+	proposerCategory        = "PROPOSER"
+	proverCategory          = "PROVER"
+	txmgrCategory           = "TX_MANAGER"
+	forcedInclusionCategory = "FORCED_INCLUSION"
 )
 
 // Required flags used by all client software.
@@ -155,6 +156,43 @@ var (
 		Category: commonCategory,
 		EnvVars:  []string{"PROVER_SET"},
 	}
+	PacayaForkHeight = &cli.Uint64Flag{
+		Name: "fork.pacaya",
+		Usage: "Pacaya fork height, only used when the chain is still before the Pacaya fork, " +
+			"0 means using the pre-defined height of the network",
+		Category: commonCategory,
+		EnvVars:  []string{"FORK_PACAYA"},
+	}
+)
+
+// Configuration file and network preset flags, which are only available at the application level.
+var (
+	ConfigFile = &cli.StringFlag{
+		Name: "config",
+		Usage: "Path to a YAML or TOML configuration `file`, command line flags and " +
+			"environment variables take precedence over it",
+		Category: commonCategory,
+		EnvVars:  []string{"CONFIG_FILE"},
+	}
+	ConfigProfile = &cli.StringFlag{
+		Name:     "config.profile",
+		Usage:    "Network profile (e.g. mainnet, hekla, devnet) in the configuration file to use",
+		Category: commonCategory,
+		EnvVars:  []string{"CONFIG_PROFILE"},
+	}
+	Network = &cli.StringFlag{
+		Name: "network",
+		Usage: "Name of a network preset in chain registry (e.g. mainnet, hekla), whose contract addresses " +
+			"will be used as the default values of the corresponding flags",
+		Category: commonCategory,
+		EnvVars:  []string{"NETWORK"},
+	}
+	ChainRegistryFile = &cli.StringFlag{
+		Name:     "chain.registry",
+		Usage:    "Path to a JSON `file` with custom network presets, which will be added to the chain registry",
+		Category: commonCategory,
+		EnvVars:  []string{"CHAIN_REGISTRY"},
+	}
 )
 
 // CommonFlags All common flags.
@@ -174,6 +212,15 @@ var CommonFlags = []cli.Flag{
 	BackOffRetryInterval,
 	RPCTimeout,
 	L1PrivateEndpoint,
+	PacayaForkHeight,
+}
+
+// ConfigFileFlags All configuration file and network preset flags.
+var ConfigFileFlags = []cli.Flag{
+	ConfigFile,
+	ConfigProfile,
+	Network,
+	ChainRegistryFile,
 }
 
 // MergeFlags merges the given flag slices.
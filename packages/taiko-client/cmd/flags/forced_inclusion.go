@@ -0,0 +1,67 @@
+package flags
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Required flags used by forced inclusion submitter.
+var (
+	ForcedInclusionTx = &cli.StringFlag{
+		Name:     "forcedInclusion.tx",
+		Usage:    "RLP encoded signed L2 transaction in hex, which will be submitted as a forced inclusion",
+		Required: true,
+		Category: forcedInclusionCategory,
+		EnvVars:  []string{"FORCED_INCLUSION_TX"},
+	}
+	ForcedInclusionPrivKey = &cli.StringFlag{
+		Name:     "forcedInclusion.privKey",
+		Usage:    "Private key of the L1 account, who will send ForcedInclusionStore.storeForcedInclusion transaction",
+		Required: true,
+		Category: forcedInclusionCategory,
+		EnvVars:  []string{"FORCED_INCLUSION_PRIV_KEY"},
+	}
+)
+
+// Optional flags used by forced inclusion submitter.
+var (
+	ForcedInclusionFollow = &cli.BoolFlag{
+		Name:     "forcedInclusion.follow",
+		Usage:    "Follow the inbox until the forced inclusion transaction is included in a L2 block",
+		Value:    true,
+		Category: forcedInclusionCategory,
+		EnvVars:  []string{"FORCED_INCLUSION_FOLLOW"},
+	}
+	ForcedInclusionPollInterval = &cli.DurationFlag{
+		Name:     "forcedInclusion.pollInterval",
+		Usage:    "Time interval to poll the forced inclusion status",
+		Value:    12 * time.Second,
+		Category: forcedInclusionCategory,
+		EnvVars:  []string{"FORCED_INCLUSION_POLL_INTERVAL"},
+	}
+	ForcedInclusionJSONOutput = &cli.BoolFlag{
+		Name:     "forcedInclusion.json",
+		Usage:    "Print the forced inclusion status as JSON lines to stdout, logs will be written to stderr",
+		Category: forcedInclusionCategory,
+		EnvVars:  []string{"FORCED_INCLUSION_JSON"},
+	}
+)
+
+// ForcedInclusionFlags All forced inclusion submitter flags.
+var ForcedInclusionFlags = MergeFlags([]cli.Flag{
+	L1WSEndpoint,
+	L2HTTPEndpoint,
+	TaikoL1Address,
+	TaikoL2Address,
+	ForcedInclusionStoreAddress,
+	ForcedInclusionTx,
+	ForcedInclusionPrivKey,
+	ForcedInclusionFollow,
+	ForcedInclusionPollInterval,
+	ForcedInclusionJSONOutput,
+	Verbosity,
+	LogJSON,
+	RPCTimeout,
+	PacayaForkHeight,
+}, TxmgrFlags)
@@ -66,6 +66,36 @@ var (
 		Value:    0,
 		EnvVars:  []string{"EPOCH_ALLOW_ZERO_TIP_INTERVAL"},
 	}
+	// L1 fee related.
+	MaxL1BaseFee = &cli.Float64Flag{
+		Name:     "epoch.maxBaseFee",
+		Usage:    "Maximum L1 base fee (in GWei) to propose, 0 means no limit",
+		Category: proposerCategory,
+		Value:    0,
+		EnvVars:  []string{"EPOCH_MAX_BASE_FEE"},
+	}
+	MaxL1BlobBaseFee = &cli.Float64Flag{
+		Name:     "epoch.maxBlobBaseFee",
+		Usage:    "Maximum L1 blob base fee (in GWei) to propose with blobs, 0 means no limit",
+		Category: proposerCategory,
+		Value:    0,
+		EnvVars:  []string{"EPOCH_MAX_BLOB_BASE_FEE"},
+	}
+	MaxFeeDeferral = &cli.DurationFlag{
+		Name: "epoch.maxFeeDeferral",
+		Usage: "Maximum time to defer proposing when the L1 base fee is above the median of recent blocks, " +
+			"0 means no deferral",
+		Category: proposerCategory,
+		Value:    0,
+		EnvVars:  []string{"EPOCH_MAX_FEE_DEFERRAL"},
+	}
+	FeeHistoryBlocks = &cli.Uint64Flag{
+		Name:     "epoch.feeHistoryBlocks",
+		Usage:    "Number of recent L1 blocks used to calculate the median base fee for proposing deferral",
+		Category: proposerCategory,
+		Value:    20,
+		EnvVars:  []string{"EPOCH_FEE_HISTORY_BLOCKS"},
+	}
 	// Transactions pool related.
 	TxPoolLocals = &cli.StringSliceFlag{
 		Name:     "txPool.locals",
@@ -127,6 +157,10 @@ var ProposerFlags = MergeFlags(CommonFlags, []cli.Flag{
 	MinTip,
 	MinProposingInternal,
 	AllowZeroTipInterval,
+	MaxL1BaseFee,
+	MaxL1BlobBaseFee,
+	MaxFeeDeferral,
+	FeeHistoryBlocks,
 	MaxProposedTxListsPerEpoch,
 	BlobAllowed,
 	FallbackToCalldata,
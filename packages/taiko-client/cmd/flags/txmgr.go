@@ -86,6 +86,26 @@ var (
 		Category: txmgrCategory,
 		EnvVars:  []string{"TX_GAS_LIMIT"},
 	}
+	BundleBuilderEndpoints = &cli.StringSliceFlag{
+		Name:     "tx.bundle.builders",
+		Usage:    "RPC endpoints of the block builders which transactions will be sent to as bundles",
+		Category: txmgrCategory,
+		EnvVars:  []string{"TX_BUNDLE_BUILDERS"},
+	}
+	BundleTargetBlocks = &cli.Uint64Flag{
+		Name:     "tx.bundle.targetBlocks",
+		Usage:    "Number of consecutive L1 blocks each bundle will be submitted for",
+		Value:    3,
+		Category: txmgrCategory,
+		EnvVars:  []string{"TX_BUNDLE_TARGET_BLOCKS"},
+	}
+	BundleMaxMissedBlocks = &cli.Uint64Flag{
+		Name:     "tx.bundle.maxMissedBlocks",
+		Usage:    "Number of missed L1 blocks after which the transaction will be sent to the public mempool",
+		Value:    10,
+		Category: txmgrCategory,
+		EnvVars:  []string{"TX_BUNDLE_MAX_MISSED_BLOCKS"},
+	}
 )
 
 var TxmgrFlags = []cli.Flag{
@@ -100,4 +120,7 @@ var TxmgrFlags = []cli.Flag{
 	TxNotInMempoolTimeout,
 	ReceiptQueryInterval,
 	TxGasLimit,
+	BundleBuilderEndpoints,
+	BundleTargetBlocks,
+	BundleMaxMissedBlocks,
 }
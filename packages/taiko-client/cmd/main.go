@@ -9,7 +9,9 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/utils"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/forcedinclusion"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/version"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/configfile"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover"
 )
@@ -24,6 +26,8 @@ func main() {
 	app.Description = "Client software implementation in Golang for Taiko protocol"
 	app.Authors = []*cli.Author{{Name: "Taiko Labs", Email: "info@taiko.xyz"}}
 	app.EnableBashCompletion = true
+	app.Flags = flags.ConfigFileFlags
+	app.Before = configfile.Before
 
 	// All supported sub commands.
 	app.Commands = []*cli.Command{
@@ -48,6 +52,13 @@ func main() {
 			Description: "Taiko prover software",
 			Action:      utils.SubcommandAction(new(prover.Prover)),
 		},
+		{
+			Name:        "forced-inclusion",
+			Flags:       flags.ForcedInclusionFlags,
+			Usage:       "Submits a signed L2 transaction as a forced inclusion",
+			Description: "Posts a signed L2 transaction to ForcedInclusionStore, and follows it until included in L2",
+			Action:      utils.OneshotSubcommandAction(new(forcedinclusion.Submitter)),
+		},
 	}
 
 	if err := app.Run(os.Args); err != nil {
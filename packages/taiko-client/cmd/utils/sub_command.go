@@ -61,3 +61,28 @@ func SubcommandAction(app SubcommandApplication) cli.ActionFunc {
 		return nil
 	}
 }
+
+// OneshotSubcommandAction returns an action which runs the given application until its Start returns,
+// instead of waiting for an interrupt signal, the application will be stopped on interrupt signals.
+func OneshotSubcommandAction(app SubcommandApplication) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		logger.InitLogger(c)
+
+		ctx, ctxClose := signal.NotifyContext(
+			context.Background(),
+			os.Interrupt,
+			syscall.SIGTERM,
+			syscall.SIGQUIT,
+		)
+		defer ctxClose()
+
+		if err := app.InitFromCli(ctx, c); err != nil {
+			return err
+		}
+		defer app.Close(ctx)
+
+		log.Info("Starting Taiko client application", "name", app.Name())
+
+		return app.Start()
+	}
+}
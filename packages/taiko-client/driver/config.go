@@ -90,6 +90,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 			L2EngineEndpoint:        c.String(flags.L2AuthEndpoint.Name),
 			JwtSecret:               string(jwtSecret),
 			Timeout:                 c.Duration(flags.RPCTimeout.Name),
+			PacayaForkHeight:        c.Uint64(flags.PacayaForkHeight.Name),
 		}
 		p2pConfigs    *p2p.Config
 		signerConfigs p2p.SignerSetup
@@ -0,0 +1,68 @@
+package forcedinclusion
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
+	pkgFlags "github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/flags"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+// Config contains all configurations to initialize a forced inclusion submitter.
+type Config struct {
+	*rpc.ClientConfig
+	L1PrivKey    *ecdsa.PrivateKey
+	Tx           *types.Transaction
+	Follow       bool
+	PollInterval time.Duration
+	JSONOutput   bool
+	TxmgrConfigs *txmgr.CLIConfig
+}
+
+// NewConfigFromCliContext initializes a Config instance from
+// command line flags.
+func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
+	l1PrivKey, err := crypto.ToECDSA(common.FromHex(c.String(flags.ForcedInclusionPrivKey.Name)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid L1 private key: %w", err)
+	}
+
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(common.FromHex(c.String(flags.ForcedInclusionTx.Name))); err != nil {
+		return nil, fmt.Errorf("invalid forced inclusion transaction: %w", err)
+	}
+
+	if c.Duration(flags.ForcedInclusionPollInterval.Name) <= 0 {
+		return nil, fmt.Errorf("invalid poll interval: %s", c.Duration(flags.ForcedInclusionPollInterval.Name))
+	}
+
+	return &Config{
+		ClientConfig: &rpc.ClientConfig{
+			L1Endpoint:                  c.String(flags.L1WSEndpoint.Name),
+			L2Endpoint:                  c.String(flags.L2HTTPEndpoint.Name),
+			TaikoL1Address:              common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
+			TaikoL2Address:              common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
+			ForcedInclusionStoreAddress: common.HexToAddress(c.String(flags.ForcedInclusionStoreAddress.Name)),
+			Timeout:                     c.Duration(flags.RPCTimeout.Name),
+			PacayaForkHeight:            c.Uint64(flags.PacayaForkHeight.Name),
+		},
+		L1PrivKey:    l1PrivKey,
+		Tx:           tx,
+		Follow:       c.Bool(flags.ForcedInclusionFollow.Name),
+		PollInterval: c.Duration(flags.ForcedInclusionPollInterval.Name),
+		JSONOutput:   c.Bool(flags.ForcedInclusionJSONOutput.Name),
+		TxmgrConfigs: pkgFlags.InitTxmgrConfigsFromCli(
+			c.String(flags.L1WSEndpoint.Name),
+			l1PrivKey,
+			c,
+		),
+	}, nil
+}
@@ -0,0 +1,350 @@
+package forcedinclusion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	pacayaBindings "github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/pacaya"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/utils"
+)
+
+// maxPollsAfterConsumed is the max number of status polls after the forced inclusion has been consumed,
+// before the transaction is considered as dropped.
+var maxPollsAfterConsumed = 10
+
+// Submitter posts a signed L2 transaction to ForcedInclusionStore, and follows the inbox
+// until the transaction is included in a L2 block.
+type Submitter struct {
+	*Config
+
+	rpc   *rpc.Client
+	txMgr txmgr.TxManager
+	out   io.Writer
+
+	ctx context.Context
+}
+
+// InitFromCli initializes the given submitter instance based on the command line flags.
+func (s *Submitter) InitFromCli(ctx context.Context, c *cli.Context) error {
+	cfg, err := NewConfigFromCliContext(c)
+	if err != nil {
+		return err
+	}
+
+	return s.InitFromConfig(ctx, cfg, nil)
+}
+
+// InitFromConfig initializes the submitter instance based on the given configurations.
+func (s *Submitter) InitFromConfig(ctx context.Context, cfg *Config, txMgr txmgr.TxManager) (err error) {
+	s.ctx = ctx
+	s.Config = cfg
+	s.out = os.Stdout
+
+	// Keep stdout clean for the JSON status output.
+	if cfg.JSONOutput {
+		log.SetDefault(log.NewLogger(log.NewTerminalHandlerWithLevel(os.Stderr, log.LevelInfo, false)))
+	}
+
+	if s.rpc, err = rpc.NewClient(s.ctx, cfg.ClientConfig); err != nil {
+		return fmt.Errorf("initialize rpc clients error: %w", err)
+	}
+	if s.rpc.PacayaClients.ForcedInclusionStore == nil {
+		return errors.New("ForcedInclusionStore address is not set")
+	}
+
+	if cfg.Tx.ChainId().Cmp(s.rpc.L2.ChainID) != 0 {
+		return fmt.Errorf("transaction chain ID mismatch, expected %s, got %s", s.rpc.L2.ChainID, cfg.Tx.ChainId())
+	}
+
+	if txMgr == nil {
+		if txMgr, err = txmgr.NewSimpleTxManager(
+			"forcedInclusion",
+			log.Root(),
+			&metrics.TxMgrMetrics,
+			*cfg.TxmgrConfigs,
+		); err != nil {
+			return err
+		}
+	}
+	s.txMgr = txMgr
+
+	return nil
+}
+
+// Name returns the application name.
+func (s *Submitter) Name() string {
+	return "forced-inclusion"
+}
+
+// Start submits the forced inclusion, and follows it until it's done if the follow mode is enabled.
+func (s *Submitter) Start() error {
+	status, err := s.includedStatus(s.ctx)
+	if err != nil {
+		return err
+	}
+	if status != nil {
+		log.Info("Transaction has already been included", "txHash", s.Tx.Hash())
+		return status.print(s.out, s.JSONOutput)
+	}
+
+	inclusion, index, storeTxHash, err := s.submit(s.ctx)
+	if err != nil {
+		return err
+	}
+
+	var polls int
+	for {
+		if status, err = s.status(s.ctx, inclusion, index); err != nil {
+			return err
+		}
+		status.StoreTxHash = storeTxHash
+
+		if status.State == StateConsumed {
+			if polls++; polls > maxPollsAfterConsumed {
+				status.State = StateDropped
+			}
+		}
+
+		if err := status.print(s.out, s.JSONOutput); err != nil {
+			return err
+		}
+
+		if status.Done() || !s.Follow {
+			break
+		}
+
+		select {
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		case <-time.After(s.PollInterval):
+		}
+	}
+
+	if status.State == StateDropped {
+		return fmt.Errorf("forced inclusion consumed, but transaction %s is not included in L2", s.Tx.Hash())
+	}
+
+	return nil
+}
+
+// Close closes the submitter instance.
+func (s *Submitter) Close(_ context.Context) {
+	if s.txMgr != nil {
+		s.txMgr.Close()
+	}
+}
+
+// submit builds the blob with the transaction, and posts it to ForcedInclusionStore, returns the
+// stored forced inclusion and its index in the queue.
+func (s *Submitter) submit(
+	ctx context.Context,
+) (*pacayaBindings.IForcedInclusionStoreForcedInclusion, uint64, common.Hash, error) {
+	txListBytes, err := utils.EncodeAndCompressTxList(types.Transactions{s.Tx})
+	if err != nil {
+		return nil, 0, common.Hash{}, err
+	}
+
+	var blob = &eth.Blob{}
+	if err := blob.FromData(txListBytes); err != nil {
+		return nil, 0, common.Hash{}, err
+	}
+
+	feeInGwei, err := s.rpc.PacayaClients.ForcedInclusionStore.FeeInGwei(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, 0, common.Hash{}, encoding.TryParsingCustomError(err)
+	}
+
+	data, err := encoding.ForcedInclusionStoreABI.Pack(
+		"storeForcedInclusion",
+		uint8(0),
+		uint32(0),
+		uint32(len(txListBytes)),
+	)
+	if err != nil {
+		return nil, 0, common.Hash{}, err
+	}
+
+	log.Info(
+		"Submitting forced inclusion",
+		"txHash", s.Tx.Hash(),
+		"size", len(txListBytes),
+		"feeInGwei", feeInGwei,
+	)
+
+	receipt, err := s.txMgr.Send(ctx, txmgr.TxCandidate{
+		TxData: data,
+		Blobs:  []*eth.Blob{blob},
+		To:     &s.ForcedInclusionStoreAddress,
+		Value:  new(big.Int).Mul(new(big.Int).SetUint64(feeInGwei), big.NewInt(params.GWei)),
+	})
+	if err != nil {
+		return nil, 0, common.Hash{}, encoding.TryParsingCustomError(err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, 0, common.Hash{}, fmt.Errorf("forced inclusion transaction %s reverted", receipt.TxHash)
+	}
+
+	var inclusion *pacayaBindings.IForcedInclusionStoreForcedInclusion
+	for _, l := range receipt.Logs {
+		if l.Address != s.ForcedInclusionStoreAddress {
+			continue
+		}
+		event, err := s.rpc.PacayaClients.ForcedInclusionStore.ParseForcedInclusionStored(*l)
+		if err != nil {
+			continue
+		}
+		inclusion = &event.ForcedInclusion
+		break
+	}
+	if inclusion == nil {
+		return nil, 0, common.Hash{}, fmt.Errorf("ForcedInclusionStored event not found in %s", receipt.TxHash)
+	}
+
+	index, err := s.findIndex(ctx, inclusion)
+	if err != nil {
+		return nil, 0, common.Hash{}, err
+	}
+
+	log.Info(
+		"Forced inclusion stored",
+		"storeTxHash", receipt.TxHash,
+		"index", index,
+		"blobHash", common.Hash(inclusion.BlobHash),
+		"createdAtBatchID", inclusion.CreatedAtBatchId,
+	)
+
+	return inclusion, index, receipt.TxHash, nil
+}
+
+// findIndex finds the queue index of the given forced inclusion, by searching the queue backwards from its tail.
+func (s *Submitter) findIndex(
+	ctx context.Context,
+	inclusion *pacayaBindings.IForcedInclusionStoreForcedInclusion,
+) (uint64, error) {
+	opts := &bind.CallOpts{Context: ctx}
+
+	head, err := s.rpc.PacayaClients.ForcedInclusionStore.Head(opts)
+	if err != nil {
+		return 0, encoding.TryParsingCustomError(err)
+	}
+	tail, err := s.rpc.PacayaClients.ForcedInclusionStore.Tail(opts)
+	if err != nil {
+		return 0, encoding.TryParsingCustomError(err)
+	}
+
+	for index := tail; index > head; index-- {
+		stored, err := s.rpc.PacayaClients.ForcedInclusionStore.GetForcedInclusion(
+			opts,
+			new(big.Int).SetUint64(index-1),
+		)
+		if err != nil {
+			return 0, encoding.TryParsingCustomError(err)
+		}
+		if stored.BlobHash == inclusion.BlobHash && stored.BlobCreatedIn == inclusion.BlobCreatedIn {
+			return index - 1, nil
+		}
+	}
+
+	return 0, fmt.Errorf("forced inclusion %s not found in queue", common.Hash(inclusion.BlobHash))
+}
+
+// status fetches the latest status of the given forced inclusion.
+func (s *Submitter) status(
+	ctx context.Context,
+	inclusion *pacayaBindings.IForcedInclusionStoreForcedInclusion,
+	index uint64,
+) (*Status, error) {
+	status, err := s.includedStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if status == nil {
+		status = &Status{TxHash: s.Tx.Hash()}
+	}
+	status.Index = index
+	status.BlobHash = inclusion.BlobHash
+	status.CreatedAtBatchID = inclusion.CreatedAtBatchId
+
+	var (
+		opts           = &bind.CallOpts{Context: ctx}
+		store          = s.rpc.PacayaClients.ForcedInclusionStore
+		head           uint64
+		lastProcessed  uint64
+		inclusionDelay uint8
+	)
+	if head, err = store.Head(opts); err != nil {
+		return nil, encoding.TryParsingCustomError(err)
+	}
+	if lastProcessed, err = store.LastProcessedAtBatchId(opts); err != nil {
+		return nil, encoding.TryParsingCustomError(err)
+	}
+	if inclusionDelay, err = store.InclusionDelay(opts); err != nil {
+		return nil, encoding.TryParsingCustomError(err)
+	}
+	stats2, err := s.rpc.PacayaClients.TaikoInbox.GetStats2(opts)
+	if err != nil {
+		return nil, encoding.TryParsingCustomError(err)
+	}
+
+	status.NextBatchID = stats2.NumBatches
+	status.DeadlineBatchID = estimateDeadline(
+		head,
+		index,
+		lastProcessed,
+		inclusion.CreatedAtBatchId,
+		uint64(inclusionDelay),
+	)
+	status.BatchesRemaining = batchesRemaining(status.NextBatchID, status.DeadlineBatchID)
+
+	switch {
+	case status.State == StateIncluded:
+	case index < head:
+		status.State = StateConsumed
+	case status.BatchesRemaining == 0:
+		status.State = StateDue
+	default:
+		status.State = StatePending
+	}
+
+	return status, nil
+}
+
+// includedStatus returns the included status if the transaction has been included in L2, otherwise nil.
+func (s *Submitter) includedStatus(ctx context.Context) (*Status, error) {
+	receipt, err := s.rpc.L2.TransactionReceipt(ctx, s.Tx.Hash())
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var (
+		blockNumber = receipt.BlockNumber.Uint64()
+		blockHash   = receipt.BlockHash
+	)
+	return &Status{
+		State:         StateIncluded,
+		TxHash:        s.Tx.Hash(),
+		L2BlockNumber: &blockNumber,
+		L2BlockHash:   &blockHash,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package forcedinclusion
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// State is the state of a forced inclusion.
+type State string
+
+// All possible forced inclusion states.
+const (
+	// StatePending means the forced inclusion is waiting in the queue.
+	StatePending State = "pending"
+	// StateDue means the forced inclusion must be processed in the next batch.
+	StateDue State = "due"
+	// StateConsumed means the forced inclusion has been consumed by a batch, but the transaction
+	// has not been found in L2 yet.
+	StateConsumed State = "consumed"
+	// StateIncluded means the transaction has been included in a L2 block.
+	StateIncluded State = "included"
+	// StateDropped means the forced inclusion has been consumed, but the transaction
+	// is not included in L2, e.g. because of an invalid nonce.
+	StateDropped State = "dropped"
+)
+
+// Status is the status of a forced inclusion transaction.
+type Status struct {
+	State            State        `json:"state"`
+	TxHash           common.Hash  `json:"txHash"`
+	StoreTxHash      common.Hash  `json:"storeTxHash"`
+	Index            uint64       `json:"index"`
+	BlobHash         common.Hash  `json:"blobHash"`
+	CreatedAtBatchID uint64       `json:"createdAtBatchId"`
+	NextBatchID      uint64       `json:"nextBatchId"`
+	DeadlineBatchID  uint64       `json:"deadlineBatchId"`
+	BatchesRemaining uint64       `json:"batchesRemaining"`
+	L2BlockNumber    *uint64      `json:"l2BlockNumber,omitempty"`
+	L2BlockHash      *common.Hash `json:"l2BlockHash,omitempty"`
+}
+
+// Done returns whether the status is final.
+func (s *Status) Done() bool {
+	return s.State == StateIncluded || s.State == StateDropped
+}
+
+// print writes the status to the given writer as a JSON line if jsonOutput is true,
+// otherwise logs it.
+func (s *Status) print(w io.Writer, jsonOutput bool) error {
+	if jsonOutput {
+		return json.NewEncoder(w).Encode(s)
+	}
+
+	ctx := []interface{}{
+		"state", s.State,
+		"txHash", s.TxHash,
+		"index", s.Index,
+		"createdAtBatchID", s.CreatedAtBatchID,
+		"nextBatchID", s.NextBatchID,
+		"deadlineBatchID", s.DeadlineBatchID,
+		"batchesRemaining", s.BatchesRemaining,
+	}
+	if s.L2BlockNumber != nil {
+		ctx = append(ctx, "l2BlockNumber", *s.L2BlockNumber, "l2BlockHash", s.L2BlockHash)
+	}
+	log.Info("Forced inclusion status", ctx...)
+
+	return nil
+}
+
+// estimateDeadline estimates the batch ID before which the forced inclusion in the given queue index must
+// be processed, assuming that every forced inclusion ahead of it is processed right at its deadline.
+func estimateDeadline(head, index, lastProcessedAtBatchID, createdAtBatchID, inclusionDelay uint64) uint64 {
+	if index < head {
+		return 0
+	}
+
+	return max(lastProcessedAtBatchID, createdAtBatchID) + inclusionDelay*(index-head+1)
+}
+
+// batchesRemaining returns how many batches can still be proposed before the given deadline.
+func batchesRemaining(nextBatchID, deadlineBatchID uint64) uint64 {
+	if nextBatchID >= deadlineBatchID {
+		return 0
+	}
+
+	return deadlineBatchID - nextBatchID
+}
@@ -0,0 +1,55 @@
+package forcedinclusion
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateDeadline(t *testing.T) {
+	// The oldest forced inclusion, same as ForcedInclusionStore.getOldestForcedInclusionDeadline.
+	require.Equal(t, uint64(15), estimateDeadline(3, 3, 10, 8, 5))
+	require.Equal(t, uint64(17), estimateDeadline(3, 3, 10, 12, 5))
+	// Forced inclusions ahead in the queue.
+	require.Equal(t, uint64(27), estimateDeadline(3, 5, 10, 12, 5))
+	// Already consumed.
+	require.Zero(t, estimateDeadline(3, 2, 10, 12, 5))
+}
+
+func TestBatchesRemaining(t *testing.T) {
+	require.Equal(t, uint64(5), batchesRemaining(10, 15))
+	require.Zero(t, batchesRemaining(15, 15))
+	require.Zero(t, batchesRemaining(16, 15))
+}
+
+func TestStatusJSONOutput(t *testing.T) {
+	var (
+		buf         bytes.Buffer
+		blockNumber = uint64(100)
+		blockHash   = common.HexToHash("0x01")
+		status      = &Status{
+			State:            StateIncluded,
+			TxHash:           common.HexToHash("0x02"),
+			Index:            3,
+			DeadlineBatchID:  15,
+			BatchesRemaining: 5,
+			L2BlockNumber:    &blockNumber,
+			L2BlockHash:      &blockHash,
+		}
+	)
+	require.True(t, status.Done())
+	require.Nil(t, status.print(&buf, true))
+
+	var decoded map[string]interface{}
+	require.Nil(t, json.Unmarshal(buf.Bytes(), &decoded))
+	require.Equal(t, "included", decoded["state"])
+	require.Equal(t, float64(5), decoded["batchesRemaining"])
+	require.Equal(t, float64(100), decoded["l2BlockNumber"])
+
+	buf.Reset()
+	require.Nil(t, (&Status{State: StatePending}).print(&buf, true))
+	require.NotContains(t, buf.String(), "l2BlockNumber")
+}
@@ -38,6 +38,9 @@ var (
 	ProposerProposeByCalldata      = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_propose_by_calldata"})
 	ProposerProposeByBlob          = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_propose_by_blob"})
 	ProposerCostEstimationError    = factory.NewGauge(prometheus.GaugeOpts{Name: "proposer_cost_estimation_error"})
+	ProposerFeeDeferredCounter     = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_fee_deferred"})
+	ProposerFeeDeferredTime        = factory.NewGauge(prometheus.GaugeOpts{Name: "proposer_fee_deferred_time"})
+	ProposerFeeDeferralCostSaved   = factory.NewGauge(prometheus.GaugeOpts{Name: "proposer_fee_deferral_cost_saved"})
 
 	// Prover
 	ProverLatestVerifiedIDGauge      = factory.NewGauge(prometheus.GaugeOpts{Name: "prover_latestVerified_id"})
@@ -110,6 +113,13 @@ var (
 		Name: "prover_proof_submission_reverted",
 	})
 
+	// Bundle
+	BundleSentCounter          = factory.NewCounter(prometheus.CounterOpts{Name: "bundle_sent"})
+	BundleSendErrorCounter     = factory.NewCounter(prometheus.CounterOpts{Name: "bundle_send_error"})
+	BundleIncludedCounter      = factory.NewCounter(prometheus.CounterOpts{Name: "bundle_included"})
+	BundleFallbackCounter      = factory.NewCounter(prometheus.CounterOpts{Name: "bundle_fallback"})
+	BundleInclusionBlocksGauge = factory.NewGauge(prometheus.GaugeOpts{Name: "bundle_inclusion_blocks"})
+
 	// TxManager
 	TxMgrMetrics   = txmgrMetrics.MakeTxMetrics("client", factory)
 	P2PNodeMetrics = p2pNodeMetrics.NewMetrics("client")
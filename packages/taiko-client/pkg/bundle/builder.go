@@ -0,0 +1,58 @@
+package bundle
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// SendBundleArgs represents the arguments for an `eth_sendBundle` call.
+type SendBundleArgs struct {
+	Txs               []hexutil.Bytes `json:"txs"`
+	BlockNumber       hexutil.Uint64  `json:"blockNumber"`
+	RevertingTxHashes []common.Hash   `json:"revertingTxHashes"`
+}
+
+// SendBundleResult represents the result of an `eth_sendBundle` call.
+type SendBundleResult struct {
+	BundleHash common.Hash `json:"bundleHash"`
+}
+
+// BuilderClient is a JSON-RPC client for a single block builder endpoint.
+type BuilderClient struct {
+	endpoint string
+	client   *rpc.Client
+}
+
+// NewBuilderClient creates a new BuilderClient instance.
+func NewBuilderClient(ctx context.Context, endpoint string) (*BuilderClient, error) {
+	client, err := rpc.DialContext(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial block builder %s: %w", endpoint, err)
+	}
+
+	return &BuilderClient{endpoint: endpoint, client: client}, nil
+}
+
+// Endpoint returns the endpoint of the block builder.
+func (c *BuilderClient) Endpoint() string {
+	return c.endpoint
+}
+
+// SendBundle sends the given bundle to the block builder.
+func (c *BuilderClient) SendBundle(ctx context.Context, args *SendBundleArgs) (*SendBundleResult, error) {
+	var result *SendBundleResult
+	if err := c.client.CallContext(ctx, &result, "eth_sendBundle", args); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Close closes the underlying RPC connection.
+func (c *BuilderClient) Close() {
+	c.client.Close()
+}
@@ -0,0 +1,473 @@
+package bundle
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/holiman/uint256"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+)
+
+var (
+	defaultTargetBlocks    uint64 = 3
+	defaultMaxMissedBlocks uint64 = 10
+	defaultPollInterval           = 3 * time.Second
+
+	errNoBuilderAccepted = errors.New("no block builder accepted the bundle")
+)
+
+// Config contains the configurations to initialize a bundle transaction manager.
+type Config struct {
+	// BuilderEndpoints is the list of block builder RPC endpoints which accept `eth_sendBundle`.
+	BuilderEndpoints []string
+	// TargetBlocks is the number of consecutive blocks each bundle is submitted for.
+	TargetBlocks uint64
+	// MaxMissedBlocks is the number of blocks after which the transaction will be sent
+	// to the public mempool instead.
+	MaxMissedBlocks uint64
+	// PollInterval is the interval for checking the inclusion of the bundle.
+	PollInterval time.Duration
+}
+
+// ETHBackend is the set of L1 methods used by the bundle transaction manager.
+type ETHBackend interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// TxManager is a transaction manager which submits transactions as bundles to a list of
+// block builders, with revert protection, and falls back to the public mempool when the
+// bundle is not included after a number of blocks.
+//
+// The bundles share the nonce space of the account with the fallback transaction manager, so the
+// transactions sent directly to the public mempool must go through FallbackTxMgr: they are never
+// in flight while a bundle is crafted, and the nonce of a bundle is the pending nonce of the account,
+// which counts them once they are sent. The fallback transaction manager caches the nonce it last
+// used, so once a bundle is included, its next transaction is resent if that cached nonce was too low.
+type TxManager struct {
+	cfg           *Config
+	backend       ETHBackend
+	chainID       *big.Int
+	privateKey    *ecdsa.PrivateKey
+	from          common.Address
+	builders      []*BuilderClient
+	fallbackTxMgr txmgr.TxManager
+	// nonceLock is held exclusively by a pending bundle, and shared by the transactions
+	// sent through FallbackTxMgr.
+	nonceLock sync.RWMutex
+	// nonceStale is set when a bundle is included, until the fallback transaction manager
+	// sends its next transaction with the nonce after the bundle.
+	nonceStale atomic.Bool
+	closed     atomic.Bool
+}
+
+// NewTxManager creates a new bundle TxManager instance.
+func NewTxManager(
+	ctx context.Context,
+	cfg *Config,
+	backend ETHBackend,
+	chainID *big.Int,
+	privateKey *ecdsa.PrivateKey,
+	fallbackTxMgr txmgr.TxManager,
+) (*TxManager, error) {
+	if len(cfg.BuilderEndpoints) == 0 {
+		return nil, errors.New("empty block builder endpoints")
+	}
+
+	if cfg.TargetBlocks == 0 {
+		cfg.TargetBlocks = defaultTargetBlocks
+	}
+	if cfg.MaxMissedBlocks == 0 {
+		cfg.MaxMissedBlocks = defaultMaxMissedBlocks
+	}
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = defaultPollInterval
+	}
+
+	builders := make([]*BuilderClient, 0, len(cfg.BuilderEndpoints))
+	for _, endpoint := range cfg.BuilderEndpoints {
+		builder, err := NewBuilderClient(ctx, endpoint)
+		if err != nil {
+			return nil, err
+		}
+		builders = append(builders, builder)
+	}
+
+	return &TxManager{
+		cfg:           cfg,
+		backend:       backend,
+		chainID:       chainID,
+		privateKey:    privateKey,
+		from:          crypto.PubkeyToAddress(privateKey.PublicKey),
+		builders:      builders,
+		fallbackTxMgr: fallbackTxMgr,
+	}, nil
+}
+
+// Send implements the txmgr.TxManager interface. It signs the given candidate, submits it as a
+// bundle to all block builders, and waits for its inclusion. If the bundle is still not included
+// after `MaxMissedBlocks` blocks, and all the blocks it targets have passed, the candidate will be
+// sent through the fallback transaction manager.
+func (m *TxManager) Send(ctx context.Context, candidate txmgr.TxCandidate) (*types.Receipt, error) {
+	if m.closed.Load() {
+		return nil, txmgr.ErrClosed
+	}
+
+	// Make sure there is only one pending bundle at a time, and no pending fallback transaction,
+	// since all of them share the same nonce space.
+	m.nonceLock.Lock()
+	defer m.nonceLock.Unlock()
+
+	tx, err := m.craftTx(ctx, candidate)
+	if err != nil {
+		return nil, err
+	}
+
+	startHeight, err := m.backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		lastTargetHeight = startHeight
+		ticker           = time.NewTicker(m.cfg.PollInterval)
+	)
+	defer ticker.Stop()
+
+	for {
+		head, err := m.backend.BlockNumber(ctx)
+		if err != nil {
+			log.Warn("Failed to fetch L1 head", "error", err)
+		} else {
+			receipt, err := m.backend.TransactionReceipt(ctx, tx.Hash())
+			if err == nil && receipt != nil {
+				log.Info(
+					"Bundle included",
+					"txHash", tx.Hash(),
+					"blockNumber", receipt.BlockNumber,
+					"missedBlocks", head-startHeight,
+				)
+				metrics.BundleIncludedCounter.Add(1)
+				metrics.BundleInclusionBlocksGauge.Set(float64(receipt.BlockNumber.Uint64() - startHeight))
+				m.nonceStale.Store(true)
+				return receipt, nil
+			}
+
+			// All the target blocks of the bundle have passed without including it.
+			if head >= lastTargetHeight {
+				// The bundle has not been included after `MaxMissedBlocks` blocks, fall back to the public
+				// mempool, now that the builders can no longer include the bundle with the same nonce.
+				if head >= startHeight+m.cfg.MaxMissedBlocks {
+					return m.fallback(ctx, candidate, tx, head-startHeight)
+				}
+
+				// Submit the bundle for the next target blocks.
+				if err := m.sendBundle(ctx, tx, head+1, head+m.cfg.TargetBlocks); err != nil {
+					log.Warn("Failed to send bundle", "txHash", tx.Hash(), "error", err)
+				}
+				lastTargetHeight = head + m.cfg.TargetBlocks
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// FallbackTxMgr returns the fallback transaction manager, sharing the nonce space of the bundles.
+func (m *TxManager) FallbackTxMgr() txmgr.TxManager {
+	return &sharedNonceTxManager{TxManager: m.fallbackTxMgr, bundleTxMgr: m}
+}
+
+// sharedNonceTxManager is a transaction manager whose transactions are never in flight while
+// a bundle is pending.
+type sharedNonceTxManager struct {
+	txmgr.TxManager
+	bundleTxMgr *TxManager
+}
+
+// Send implements the txmgr.TxManager interface.
+func (m *sharedNonceTxManager) Send(ctx context.Context, candidate txmgr.TxCandidate) (*types.Receipt, error) {
+	m.bundleTxMgr.nonceLock.RLock()
+	if !m.bundleTxMgr.nonceStale.Load() {
+		defer m.bundleTxMgr.nonceLock.RUnlock()
+
+		return m.TxManager.Send(ctx, candidate)
+	}
+	m.bundleTxMgr.nonceLock.RUnlock()
+
+	// The first transaction after a bundle is sent alone, so that no other transaction is
+	// signed with the cached nonce in the meantime.
+	m.bundleTxMgr.nonceLock.Lock()
+	defer m.bundleTxMgr.nonceLock.Unlock()
+
+	return m.bundleTxMgr.sendFallback(ctx, candidate)
+}
+
+// SendAsync implements the txmgr.TxManager interface.
+func (m *sharedNonceTxManager) SendAsync(
+	ctx context.Context,
+	candidate txmgr.TxCandidate,
+	ch chan txmgr.SendResponse,
+) {
+	if cap(ch) == 0 {
+		panic("SendAsync: channel must be buffered")
+	}
+
+	go func() {
+		receipt, err := m.Send(ctx, candidate)
+		ch <- txmgr.SendResponse{Receipt: receipt, Err: err}
+	}()
+}
+
+// SendAsync implements the txmgr.TxManager interface.
+func (m *TxManager) SendAsync(ctx context.Context, candidate txmgr.TxCandidate, ch chan txmgr.SendResponse) {
+	if cap(ch) == 0 {
+		panic("SendAsync: channel must be buffered")
+	}
+
+	go func() {
+		receipt, err := m.Send(ctx, candidate)
+		ch <- txmgr.SendResponse{Receipt: receipt, Err: err}
+	}()
+}
+
+// From implements the txmgr.TxManager interface.
+func (m *TxManager) From() common.Address {
+	return m.from
+}
+
+// BlockNumber implements the txmgr.TxManager interface.
+func (m *TxManager) BlockNumber(ctx context.Context) (uint64, error) {
+	return m.backend.BlockNumber(ctx)
+}
+
+// API implements the txmgr.TxManager interface.
+func (m *TxManager) API() rpc.API {
+	return m.fallbackTxMgr.API()
+}
+
+// Close implements the txmgr.TxManager interface.
+func (m *TxManager) Close() {
+	if m.closed.Swap(true) {
+		return
+	}
+	for _, builder := range m.builders {
+		builder.Close()
+	}
+}
+
+// IsClosed implements the txmgr.TxManager interface.
+func (m *TxManager) IsClosed() bool {
+	return m.closed.Load()
+}
+
+// SuggestGasPriceCaps implements the txmgr.TxManager interface.
+func (m *TxManager) SuggestGasPriceCaps(ctx context.Context) (*big.Int, *big.Int, *big.Int, error) {
+	return m.fallbackTxMgr.SuggestGasPriceCaps(ctx)
+}
+
+// sendBundle sends the given transaction as a bundle to all block builders, targeting
+// each block in the range [fromHeight, toHeight].
+func (m *TxManager) sendBundle(ctx context.Context, tx *types.Transaction, fromHeight, toHeight uint64) error {
+	rawTx, err := tx.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("failed to encode transaction: %w", err)
+	}
+
+	var accepted bool
+	for height := fromHeight; height <= toHeight; height++ {
+		args := &SendBundleArgs{
+			Txs:         []hexutil.Bytes{rawTx},
+			BlockNumber: hexutil.Uint64(height),
+			// No reverting transaction is allowed, so the bundle will be dropped
+			// by the builders if the transaction reverts.
+			RevertingTxHashes: []common.Hash{},
+		}
+		for _, builder := range m.builders {
+			result, err := builder.SendBundle(ctx, args)
+			if err != nil {
+				log.Debug(
+					"Block builder rejected the bundle",
+					"builder", builder.Endpoint(),
+					"targetBlock", height,
+					"error", err,
+				)
+				metrics.BundleSendErrorCounter.Add(1)
+				continue
+			}
+
+			log.Debug(
+				"Bundle sent",
+				"builder", builder.Endpoint(),
+				"targetBlock", height,
+				"bundleHash", result.BundleHash,
+			)
+			metrics.BundleSentCounter.Add(1)
+			accepted = true
+		}
+	}
+
+	if !accepted {
+		return errNoBuilderAccepted
+	}
+
+	log.Info(
+		"Bundle submitted to block builders",
+		"txHash", tx.Hash(),
+		"nonce", tx.Nonce(),
+		"fromBlock", fromHeight,
+		"toBlock", toHeight,
+		"builders", len(m.builders),
+	)
+
+	return nil
+}
+
+// fallback sends the given candidate through the fallback transaction manager.
+func (m *TxManager) fallback(
+	ctx context.Context,
+	candidate txmgr.TxCandidate,
+	tx *types.Transaction,
+	missedBlocks uint64,
+) (*types.Receipt, error) {
+	log.Warn(
+		"Bundle not included, falling back to public mempool",
+		"txHash", tx.Hash(),
+		"missedBlocks", missedBlocks,
+	)
+	metrics.BundleFallbackCounter.Add(1)
+
+	return m.sendFallback(ctx, candidate)
+}
+
+// sendFallback sends the given candidate through the fallback transaction manager, with the
+// nonce lock held exclusively. After an included bundle, the nonce cached by the fallback
+// transaction manager can be the one of the bundle: the transaction is then rejected as
+// "nonce too low", which makes the fallback transaction manager drop its cached nonce, and
+// it is resent once with the nonce fetched again.
+func (m *TxManager) sendFallback(ctx context.Context, candidate txmgr.TxCandidate) (*types.Receipt, error) {
+	receipt, err := m.fallbackTxMgr.Send(ctx, candidate)
+	if err != nil && m.nonceStale.Load() && isNonceTooLow(err) {
+		log.Info("Resending transaction with the nonce after the last bundle", "error", err)
+		receipt, err = m.fallbackTxMgr.Send(ctx, candidate)
+	}
+
+	// the fallback transaction manager either used the nonce after the bundle, or
+	// dropped its cached nonce on failure.
+	m.nonceStale.Store(false)
+
+	return receipt, err
+}
+
+// isNonceTooLow returns whether the given error is a "nonce too low" rejection, which the
+// transaction managers return as the error message of the RPC.
+func isNonceTooLow(err error) bool {
+	return errors.Is(err, core.ErrNonceTooLow) || strings.Contains(err.Error(), core.ErrNonceTooLow.Error())
+}
+
+// craftTx creates a signed transaction from the given candidate.
+func (m *TxManager) craftTx(ctx context.Context, candidate txmgr.TxCandidate) (*types.Transaction, error) {
+	gasTipCap, baseFee, blobBaseFee, err := m.fallbackTxMgr.SuggestGasPriceCaps(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gas price info: %w", err)
+	}
+	gasFeeCap := new(big.Int).Add(gasTipCap, new(big.Int).Mul(baseFee, common.Big2))
+
+	nonce, err := m.backend.PendingNonceAt(ctx, m.from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nonce: %w", err)
+	}
+
+	var (
+		sidecar    *types.BlobTxSidecar
+		blobHashes []common.Hash
+	)
+	if len(candidate.Blobs) > 0 {
+		if candidate.To == nil {
+			return nil, errors.New("blob txs cannot deploy contracts")
+		}
+		if blobBaseFee == nil {
+			return nil, errors.New("expected non-nil blobBaseFee")
+		}
+		if sidecar, blobHashes, err = txmgr.MakeSidecar(candidate.Blobs); err != nil {
+			return nil, fmt.Errorf("failed to make sidecar: %w", err)
+		}
+	}
+
+	gasLimit := candidate.GasLimit
+	if gasLimit == 0 {
+		callMsg := ethereum.CallMsg{
+			From:      m.from,
+			To:        candidate.To,
+			GasTipCap: gasTipCap,
+			GasFeeCap: gasFeeCap,
+			Data:      candidate.TxData,
+			Value:     candidate.Value,
+		}
+		if len(blobHashes) > 0 {
+			callMsg.BlobGasFeeCap = blobBaseFee
+			callMsg.BlobHashes = blobHashes
+		}
+		if gasLimit, err = m.backend.EstimateGas(ctx, callMsg); err != nil {
+			return nil, fmt.Errorf("failed to estimate gas: %w", err)
+		}
+	}
+
+	value := candidate.Value
+	if value == nil {
+		value = common.Big0
+	}
+
+	var txData types.TxData
+	if sidecar != nil {
+		txData = &types.BlobTx{
+			ChainID:    uint256.MustFromBig(m.chainID),
+			Nonce:      nonce,
+			GasTipCap:  uint256.MustFromBig(gasTipCap),
+			GasFeeCap:  uint256.MustFromBig(gasFeeCap),
+			Gas:        gasLimit,
+			To:         *candidate.To,
+			Value:      uint256.MustFromBig(value),
+			Data:       candidate.TxData,
+			BlobFeeCap: uint256.MustFromBig(new(big.Int).Mul(blobBaseFee, common.Big2)),
+			BlobHashes: blobHashes,
+			Sidecar:    sidecar,
+		}
+	} else {
+		txData = &types.DynamicFeeTx{
+			ChainID:   m.chainID,
+			Nonce:     nonce,
+			GasTipCap: gasTipCap,
+			GasFeeCap: gasFeeCap,
+			Gas:       gasLimit,
+			To:        candidate.To,
+			Value:     value,
+			Data:      candidate.TxData,
+		}
+	}
+
+	return types.SignNewTx(m.privateKey, types.LatestSignerForChainID(m.chainID), txData)
+}
@@ -0,0 +1,276 @@
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// stubBuilder is a block builder which records all received bundles.
+type stubBuilder struct {
+	mutex   sync.Mutex
+	bundles []*SendBundleArgs
+}
+
+func (b *stubBuilder) SendBundle(_ context.Context, args *SendBundleArgs) (*SendBundleResult, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.bundles = append(b.bundles, args)
+	return &SendBundleResult{BundleHash: common.BytesToHash([]byte{byte(len(b.bundles))})}, nil
+}
+
+func (b *stubBuilder) received() []*SendBundleArgs {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.bundles
+}
+
+// includedTxHash is the transaction hash of the receipts returned by stubBackend.
+var includedTxHash = common.HexToHash("0x1234")
+
+// stubBackend is a L1 backend which mines a new block on each BlockNumber call, and
+// includes the queried transaction at the given height.
+type stubBackend struct {
+	mutex         sync.Mutex
+	head          uint64
+	pendingNonce  uint64
+	includedAt    uint64
+	queriedTxHash common.Hash
+}
+
+func (b *stubBackend) BlockNumber(_ context.Context) (uint64, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.head++
+	return b.head, nil
+}
+
+func (b *stubBackend) PendingNonceAt(_ context.Context, _ common.Address) (uint64, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.pendingNonce, nil
+}
+
+func (b *stubBackend) EstimateGas(_ context.Context, _ ethereum.CallMsg) (uint64, error) {
+	return 21_000, nil
+}
+
+func (b *stubBackend) TransactionReceipt(_ context.Context, txHash common.Hash) (*types.Receipt, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.queriedTxHash = txHash
+	if b.includedAt != 0 && b.head >= b.includedAt {
+		return &types.Receipt{TxHash: includedTxHash, BlockNumber: new(big.Int).SetUint64(b.includedAt)}, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+// stubFallbackTxMgr is a public mempool transaction manager which records whether it is used, and
+// the head of the backend when it is. When release is set, its transactions are pending until release
+// is closed, and then increase the pending nonce of the backend. Its first nonceTooLow transactions are
+// rejected as "nonce too low".
+type stubFallbackTxMgr struct {
+	txmgr.TxManager
+	sent        bool
+	sentAt      uint64
+	attempts    int
+	nonceTooLow int
+	backend     *stubBackend
+	started     chan struct{}
+	release     chan struct{}
+}
+
+func (m *stubFallbackTxMgr) Send(_ context.Context, _ txmgr.TxCandidate) (*types.Receipt, error) {
+	m.attempts++
+	if m.attempts <= m.nonceTooLow {
+		return nil, fmt.Errorf("failed to send transaction: %w", core.ErrNonceTooLow)
+	}
+
+	if m.backend != nil {
+		m.backend.mutex.Lock()
+		m.sentAt = m.backend.head
+		m.backend.mutex.Unlock()
+	}
+
+	if m.release != nil {
+		close(m.started)
+		<-m.release
+
+		m.backend.mutex.Lock()
+		m.backend.pendingNonce++
+		m.backend.mutex.Unlock()
+	}
+
+	m.sent = true
+	return &types.Receipt{Status: types.ReceiptStatusSuccessful}, nil
+}
+
+func (m *stubFallbackTxMgr) SuggestGasPriceCaps(_ context.Context) (*big.Int, *big.Int, *big.Int, error) {
+	return common.Big1, common.Big1, common.Big1, nil
+}
+
+func newTestTxManager(
+	t *testing.T,
+	backend *stubBackend,
+	fallback *stubFallbackTxMgr,
+) (*TxManager, *stubBuilder) {
+	builder := new(stubBuilder)
+	server := rpc.NewServer()
+	require.Nil(t, server.RegisterName("eth", builder))
+
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	m, err := NewTxManager(
+		context.Background(),
+		&Config{
+			BuilderEndpoints: []string{httpServer.URL},
+			TargetBlocks:     2,
+			MaxMissedBlocks:  5,
+			PollInterval:     time.Millisecond,
+		},
+		backend,
+		common.Big1,
+		key,
+		fallback,
+	)
+	require.Nil(t, err)
+	t.Cleanup(m.Close)
+
+	return m, builder
+}
+
+func TestNewTxManagerEmptyBuilders(t *testing.T) {
+	_, err := NewTxManager(context.Background(), &Config{}, nil, common.Big1, nil, nil)
+	require.ErrorContains(t, err, "empty block builder endpoints")
+}
+
+func TestSendBundleIncluded(t *testing.T) {
+	var (
+		backend  = &stubBackend{includedAt: 3, pendingNonce: 1}
+		fallback = &stubFallbackTxMgr{}
+		to       = common.BytesToAddress([]byte{1})
+	)
+	m, builder := newTestTxManager(t, backend, fallback)
+
+	receipt, err := m.Send(context.Background(), txmgr.TxCandidate{To: &to})
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), receipt.BlockNumber.Uint64())
+	require.Equal(t, includedTxHash, receipt.TxHash)
+	require.False(t, fallback.sent)
+
+	bundles := builder.received()
+	require.NotEmpty(t, bundles)
+	for _, bundle := range bundles {
+		require.Len(t, bundle.Txs, 1)
+		require.Empty(t, bundle.RevertingTxHashes)
+	}
+	require.Equal(t, uint64(3), uint64(bundles[0].BlockNumber))
+
+	var tx types.Transaction
+	require.Nil(t, tx.UnmarshalBinary(bundles[0].Txs[0]))
+	require.Equal(t, backend.queriedTxHash, tx.Hash())
+	require.Equal(t, uint64(1), tx.Nonce())
+}
+
+func TestSendBundleFallback(t *testing.T) {
+	var (
+		backend  = &stubBackend{}
+		fallback = &stubFallbackTxMgr{backend: backend}
+		to       = common.BytesToAddress([]byte{1})
+	)
+	m, builder := newTestTxManager(t, backend, fallback)
+
+	receipt, err := m.Send(context.Background(), txmgr.TxCandidate{To: &to})
+	require.Nil(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	require.True(t, fallback.sent)
+
+	// The fallback transaction is only sent once all the blocks targeted by the bundles have passed.
+	bundles := builder.received()
+	require.NotEmpty(t, bundles)
+	require.GreaterOrEqual(t, fallback.sentAt, uint64(bundles[len(bundles)-1].BlockNumber))
+}
+
+func TestSendFallbackAfterBundleResyncsNonce(t *testing.T) {
+	var (
+		backend  = &stubBackend{includedAt: 3, pendingNonce: 1}
+		fallback = &stubFallbackTxMgr{nonceTooLow: 1}
+		to       = common.BytesToAddress([]byte{1})
+	)
+	m, _ := newTestTxManager(t, backend, fallback)
+
+	_, err := m.Send(context.Background(), txmgr.TxCandidate{To: &to})
+	require.Nil(t, err)
+
+	// The fallback transaction manager signs its first transaction after the bundle with the
+	// nonce of the bundle, and the transaction is resent.
+	receipt, err := m.FallbackTxMgr().Send(context.Background(), txmgr.TxCandidate{To: &to})
+	require.Nil(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	require.Equal(t, 2, fallback.attempts)
+
+	// Without a bundle in between, the "nonce too low" errors are returned.
+	fallback.attempts, fallback.nonceTooLow = 0, 1
+
+	_, err = m.FallbackTxMgr().Send(context.Background(), txmgr.TxCandidate{To: &to})
+	require.ErrorIs(t, err, core.ErrNonceTooLow)
+	require.Equal(t, 1, fallback.attempts)
+}
+
+func TestSendBundleSharesNonceWithFallback(t *testing.T) {
+	var (
+		backend  = &stubBackend{includedAt: 3, pendingNonce: 1}
+		fallback = &stubFallbackTxMgr{backend: backend, started: make(chan struct{}), release: make(chan struct{})}
+		to       = common.BytesToAddress([]byte{1})
+	)
+	m, builder := newTestTxManager(t, backend, fallback)
+
+	go func() {
+		_, err := m.FallbackTxMgr().Send(context.Background(), txmgr.TxCandidate{To: &to})
+		require.Nil(t, err)
+	}()
+	<-fallback.started
+
+	receiptCh := make(chan *types.Receipt, 1)
+	go func() {
+		receipt, err := m.Send(context.Background(), txmgr.TxCandidate{To: &to})
+		require.Nil(t, err)
+		receiptCh <- receipt
+	}()
+
+	// The bundle is not crafted while the fallback transaction is pending.
+	time.Sleep(50 * time.Millisecond)
+	require.Empty(t, builder.received())
+
+	close(fallback.release)
+	receipt := <-receiptCh
+
+	require.Equal(t, includedTxHash, receipt.TxHash)
+
+	var tx types.Transaction
+	require.Nil(t, tx.UnmarshalBinary(builder.received()[0].Txs[0]))
+	require.Equal(t, backend.queriedTxHash, tx.Hash())
+	require.Equal(t, uint64(2), tx.Nonce())
+}
@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/log"
-	"github.com/ethereum/go-ethereum/params"
 )
 
 // ChainConfig is the core config which determines the blockchain settings.
@@ -19,7 +18,7 @@ type ChainConfig struct {
 	PacayaForkHeight *big.Int
 }
 
-// NewChainConfig creates a new ChainConfig instance.
+// NewChainConfig creates a new ChainConfig instance, the network description is taken from the chain registry.
 func NewChainConfig(chainID *big.Int, ontakeForkHeight uint64, pacayaForkHeight uint64) *ChainConfig {
 	cfg := &ChainConfig{
 		ChainID:          chainID,
@@ -38,21 +37,14 @@ func NewChainConfig(chainID *big.Int, ontakeForkHeight uint64, pacayaForkHeight
 	return cfg
 }
 
-// NetworkNames are user friendly names to use in the chain spec banner.
-var NetworkNames = map[uint64]string{
-	params.TaikoMainnetNetworkID.Uint64():     "Taiko Mainnet",
-	params.HeklaNetworkID.Uint64():            "Taiko Hekla Testnet",
-	params.TaikoInternalL2ANetworkID.Uint64(): "Taiko Internal Devnet",
-}
-
 // Description returns a human-readable description of ChainConfig.
 func (c *ChainConfig) Description() string {
 	var banner string
 
 	// Create some basic network config output
-	network := NetworkNames[c.ChainID.Uint64()]
-	if network == "" {
-		network = "unknown"
+	network := "unknown"
+	if preset, ok := Chains.Get(c.ChainID.Uint64()); ok {
+		network = preset.Description
 	}
 	banner += fmt.Sprintf("Chain ID:  %v (%s)\n", c.ChainID.Uint64(), network)
 
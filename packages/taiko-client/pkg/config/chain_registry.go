@@ -0,0 +1,197 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// DevnetNetworkName is the name of the preset which will be used for unknown chains.
+const DevnetNetworkName = "devnet"
+
+// ChainContracts contains the protocol contract addresses of a Taiko network.
+type ChainContracts struct {
+	TaikoL1              common.Address `json:"taikoL1"`
+	TaikoL2              common.Address `json:"taikoL2"`
+	TaikoToken           common.Address `json:"taikoToken"`
+	TaikoWrapper         common.Address `json:"taikoWrapper"`
+	ForcedInclusionStore common.Address `json:"forcedInclusionStore"`
+	ProverSet            common.Address `json:"proverSet"`
+	PreconfWhitelist     common.Address `json:"preconfWhitelist"`
+}
+
+// ChainPreset describes a Taiko network deployment.
+type ChainPreset struct {
+	// Name is the user-friendly network name, also used for the `--network` flag.
+	Name string `json:"name"`
+	// Description is shown in the chain spec banner.
+	Description string `json:"description"`
+	// ChainID is the L2 chain ID of the network.
+	ChainID uint64 `json:"chainId"`
+	// L1ChainID is the chain ID of the L1 network.
+	L1ChainID uint64 `json:"l1ChainId"`
+	// PacayaForkHeight is the Pacaya fork height, used before the fork is activated in protocol.
+	PacayaForkHeight uint64 `json:"pacayaForkHeight"`
+	// GenesisHash is the L2 genesis block hash, it won't be checked if it's empty.
+	GenesisHash common.Hash `json:"genesisHash"`
+	// Contracts contains the protocol contract addresses.
+	Contracts ChainContracts `json:"contracts"`
+}
+
+// ChainRegistry is a registry of all known Taiko networks, indexed by L2 chain ID.
+type ChainRegistry struct {
+	presets map[uint64]*ChainPreset
+	mutex   sync.RWMutex
+}
+
+// builtinChainPresets are the presets of all official Taiko networks.
+var builtinChainPresets = []*ChainPreset{
+	{
+		Name:             "mainnet",
+		Description:      "Taiko Mainnet",
+		ChainID:          params.TaikoMainnetNetworkID.Uint64(),
+		L1ChainID:        params.MainnetChainConfig.ChainID.Uint64(),
+		PacayaForkHeight: 0,
+		Contracts: ChainContracts{
+			TaikoL1:    common.HexToAddress("0x06a9Ab27c7e2255df1815E6CC0168d7755Feb19a"),
+			TaikoL2:    common.HexToAddress("0x1670000000000000000000000000000000010001"),
+			TaikoToken: common.HexToAddress("0x10dea67478c5F8C5E2D90e5E9B26dBe60c54d800"),
+			ProverSet:  common.HexToAddress("0x3022Ed0346CCE0c08268c8ad081458AfD95E8763"),
+		},
+	},
+	{
+		Name:             "hekla",
+		Description:      "Taiko Hekla Testnet",
+		ChainID:          params.HeklaNetworkID.Uint64(),
+		L1ChainID:        params.HoleskyChainConfig.ChainID.Uint64(),
+		PacayaForkHeight: 1_299_888,
+		Contracts: ChainContracts{
+			TaikoL1:              common.HexToAddress("0x79C9109b764609df928d16fC4a91e9081F7e87DB"),
+			TaikoL2:              common.HexToAddress("0x1670090000000000000000000000000000010001"),
+			TaikoToken:           common.HexToAddress("0x6490E12d480549D333499236fF2Ba6676C296011"),
+			TaikoWrapper:         common.HexToAddress("0x8698690dEeDB923fA0A674D3f65896B0031BF7c9"),
+			ForcedInclusionStore: common.HexToAddress("0x54231533B8d8Ac2f4F9B05377B617EFA9be080Fd"),
+			ProverSet:            common.HexToAddress("0xD3f681bD6B49887A48cC9C9953720903967E9DC0"),
+		},
+	},
+	{
+		Name:             "preconfDevnet",
+		Description:      "Taiko Preconfirmation Devnet",
+		ChainID:          params.PreconfDevnetNetworkID.Uint64(),
+		PacayaForkHeight: 0,
+	},
+	{
+		Name:             DevnetNetworkName,
+		Description:      "Taiko Internal Devnet",
+		ChainID:          params.TaikoInternalL2ANetworkID.Uint64(),
+		PacayaForkHeight: 10,
+	},
+}
+
+// Chains is the global chain registry, which contains all built-in presets by default.
+var Chains = NewChainRegistry()
+
+// NewChainRegistry creates a new ChainRegistry instance with all built-in presets.
+func NewChainRegistry() *ChainRegistry {
+	r := &ChainRegistry{presets: make(map[uint64]*ChainPreset)}
+	for _, preset := range builtinChainPresets {
+		r.Register(preset)
+	}
+
+	return r
+}
+
+// Register adds the given preset to the registry, an existing preset with the same chain ID will be replaced.
+func (r *ChainRegistry) Register(preset *ChainPreset) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.presets[preset.ChainID] = preset
+}
+
+// LoadFile loads the user-supplied presets from the given JSON file, which contains an array of presets,
+// presets with the same chain ID as an existing one will replace it.
+func (r *ChainRegistry) LoadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var presets []*ChainPreset
+	if err := json.Unmarshal(data, &presets); err != nil {
+		return fmt.Errorf("failed to decode chain registry file %s: %w", path, err)
+	}
+
+	for _, preset := range presets {
+		if preset.ChainID == 0 || preset.Name == "" {
+			return fmt.Errorf("invalid chain preset in %s, both name and chainId are required", path)
+		}
+		if existing, ok := r.GetByName(preset.Name); ok && existing.ChainID != preset.ChainID {
+			return fmt.Errorf("duplicate chain preset name %s in %s", preset.Name, path)
+		}
+
+		r.Register(preset)
+		log.Info("Registered chain preset", "name", preset.Name, "chainID", preset.ChainID)
+	}
+
+	return nil
+}
+
+// Get returns the preset of the given L2 chain ID.
+func (r *ChainRegistry) Get(chainID uint64) (*ChainPreset, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	preset, ok := r.presets[chainID]
+	return preset, ok
+}
+
+// GetByName returns the preset of the given network name.
+func (r *ChainRegistry) GetByName(name string) (*ChainPreset, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for _, preset := range r.presets {
+		if strings.EqualFold(preset.Name, name) {
+			return preset, true
+		}
+	}
+
+	return nil, false
+}
+
+// Names returns all the registered network names, in alphabetical order.
+func (r *ChainRegistry) Names() []string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	names := make([]string, 0, len(r.presets))
+	for _, preset := range r.presets {
+		names = append(names, preset.Name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// PacayaForkHeight returns the pre-defined Pacaya fork height of the given L2 chain ID, the
+// devnet preset will be used for unknown chains.
+func (r *ChainRegistry) PacayaForkHeight(chainID uint64) uint64 {
+	if preset, ok := r.Get(chainID); ok {
+		return preset.PacayaForkHeight
+	}
+
+	log.Debug("Unknown chain, using devnet Pacaya fork height", "chainID", chainID)
+	if preset, ok := r.GetByName(DevnetNetworkName); ok {
+		return preset.PacayaForkHeight
+	}
+
+	return 0
+}
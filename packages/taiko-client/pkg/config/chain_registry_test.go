@@ -0,0 +1,62 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuiltinChainPresets(t *testing.T) {
+	r := NewChainRegistry()
+
+	hekla, ok := r.Get(params.HeklaNetworkID.Uint64())
+	require.True(t, ok)
+	require.Equal(t, "hekla", hekla.Name)
+	require.Equal(t, uint64(1_299_888), r.PacayaForkHeight(params.HeklaNetworkID.Uint64()))
+
+	mainnet, ok := r.GetByName("Mainnet")
+	require.True(t, ok)
+	require.Equal(t, params.TaikoMainnetNetworkID.Uint64(), mainnet.ChainID)
+
+	// Unknown chains use the devnet preset.
+	require.Equal(t, uint64(10), r.PacayaForkHeight(1))
+}
+
+func TestChainRegistryLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "chains.json")
+	require.Nil(t, os.WriteFile(path, []byte(`[
+		{
+			"name": "custom",
+			"description": "Custom Taiko L2",
+			"chainId": 888,
+			"l1ChainId": 17000,
+			"pacayaForkHeight": 100,
+			"genesisHash": "0x0000000000000000000000000000000000000000000000000000000000000001",
+			"contracts": {
+				"taikoL1": "0x0000000000000000000000000000000000000002",
+				"taikoL2": "0x0000000000000000000000000000000000000003"
+			}
+		}
+	]`), 0600))
+
+	r := NewChainRegistry()
+	require.Nil(t, r.LoadFile(path))
+
+	preset, ok := r.GetByName("custom")
+	require.True(t, ok)
+	require.Equal(t, uint64(888), preset.ChainID)
+	require.Equal(t, common.HexToHash("0x01"), preset.GenesisHash)
+	require.Equal(t, common.HexToAddress("0x02"), preset.Contracts.TaikoL1)
+	require.Equal(t, uint64(100), r.PacayaForkHeight(888))
+	require.Contains(t, r.Names(), "custom")
+
+	require.Nil(t, os.WriteFile(path, []byte(`[{"name": "hekla", "chainId": 889}]`), 0600))
+	require.ErrorContains(t, r.LoadFile(path), "duplicate chain preset name")
+
+	require.Nil(t, os.WriteFile(path, []byte(`[{"chainId": 889}]`), 0600))
+	require.ErrorContains(t, r.LoadFile(path), "both name and chainId are required")
+}
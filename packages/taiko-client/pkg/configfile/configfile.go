@@ -0,0 +1,375 @@
+package configfile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v3"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/config"
+)
+
+const (
+	// profileKey is the reserved key for selecting a network profile in the configuration file.
+	profileKey = "profile"
+	// profilesKey is the reserved key for defining network profiles in the configuration file.
+	profilesKey = "profiles"
+	// metadataKey is the key of the loaded configuration file in the application metadata.
+	metadataKey = "configFile"
+)
+
+// File represents a loaded configuration file, all keys in the file are the flag names of
+// the corresponding sub-command. Nested tables are flattened with dots, so `l1: { ws: ... }`
+// is the same as `l1.ws: ...`. A file can also define network profiles under the `profiles` key,
+// the profile selected by the `profile` key or the `--config.profile` flag will be merged
+// into the top-level values, while the top-level values take precedence.
+type File struct {
+	Path    string
+	Profile string
+	Values  map[string]string
+
+	flags      []cli.Flag
+	overridden map[string]bool
+	modTime    time.Time
+}
+
+// Load loads the configuration file in the given path, and validates all its keys against the given flags.
+func Load(path string, profile string, cmdFlags []cli.Flag) (*File, error) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := decode(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile == "" {
+		if p, ok := raw[profileKey]; ok {
+			if profile, ok = p.(string); !ok {
+				return nil, fmt.Errorf("invalid %s value in %s: %v", profileKey, path, p)
+			}
+		}
+	}
+
+	values := make(map[string]string)
+	if profile != "" {
+		profiles, ok := raw[profilesKey].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("no profiles defined in %s", path)
+		}
+		profileValues, ok := profiles[profile].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("profile %s not found in %s", profile, path)
+		}
+		if err := flatten("", profileValues, values); err != nil {
+			return nil, err
+		}
+	}
+
+	delete(raw, profileKey)
+	delete(raw, profilesKey)
+	if err := flatten("", raw, values); err != nil {
+		return nil, err
+	}
+
+	// Ensure that there is no unknown key in the configuration file.
+	var unknownKeys []string
+	for key := range values {
+		if lookupFlag(cmdFlags, key) == nil {
+			unknownKeys = append(unknownKeys, key)
+		}
+	}
+	if len(unknownKeys) != 0 {
+		sort.Strings(unknownKeys)
+		return nil, fmt.Errorf("unknown keys in %s: %s", path, strings.Join(unknownKeys, ", "))
+	}
+
+	return &File{
+		Path:       path,
+		Profile:    profile,
+		Values:     values,
+		flags:      cmdFlags,
+		overridden: make(map[string]bool),
+		modTime:    stat.ModTime(),
+	}, nil
+}
+
+// Before is a cli.BeforeFunc which loads the custom chain registry set by the `--chain.registry` flag,
+// the network preset set by the `--network` flag and the configuration file set by the `--config` flag,
+// then applies their values to the flags of the sub-command which will be run. The values are applied
+// through the flags' environment variables, so they take effect before the required flags checking, while
+// the command line flags and existing environment variables still take precedence. Configuration file
+// values take precedence over the network preset values.
+func Before(c *cli.Context) error {
+	if path := c.String(flags.ChainRegistryFile.Name); path != "" {
+		if err := config.Chains.LoadFile(path); err != nil {
+			return err
+		}
+	}
+
+	cmd := c.App.Command(c.Args().First())
+	if cmd == nil {
+		return nil
+	}
+
+	values := make(map[string]string)
+	if network := c.String(flags.Network.Name); network != "" {
+		preset, ok := config.Chains.GetByName(network)
+		if !ok {
+			return fmt.Errorf(
+				"unknown network %s, available networks: %s",
+				network,
+				strings.Join(config.Chains.Names(), ", "),
+			)
+		}
+		for key, value := range presetValues(preset) {
+			if lookupFlag(cmd.Flags, key) != nil {
+				values[key] = value
+			}
+		}
+
+		log.Info("Using network preset", "name", preset.Name, "chainID", preset.ChainID)
+	}
+
+	var file *File
+	if path := c.String(flags.ConfigFile.Name); path != "" {
+		var err error
+		if file, err = Load(path, c.String(flags.ConfigProfile.Name), cmd.Flags); err != nil {
+			return err
+		}
+		for key, value := range file.Values {
+			values[key] = value
+		}
+
+		log.Info("Loaded configuration file", "path", file.Path, "profile", file.Profile, "keys", len(file.Values))
+	}
+
+	overridden, err := apply(cmd.Flags, values, c.Args().Tail())
+	if err != nil {
+		return err
+	}
+
+	if file != nil {
+		file.overridden = overridden
+		if c.App.Metadata == nil {
+			c.App.Metadata = make(map[string]interface{})
+		}
+		c.App.Metadata[metadataKey] = file
+	}
+
+	return nil
+}
+
+// FromContext returns the configuration file loaded by Before, or nil if there is no configuration file.
+func FromContext(c *cli.Context) *File {
+	if c.App == nil || c.App.Metadata == nil {
+		return nil
+	}
+
+	file, ok := c.App.Metadata[metadataKey].(*File)
+	if !ok {
+		return nil
+	}
+
+	return file
+}
+
+// Watch polls the configuration file in the given interval, and once the file has been changed, sends
+// the values of the given keys to the given channel. Keys which are set by command line flags or
+// environment variables are ignored.
+func (f *File) Watch(ctx context.Context, interval time.Duration, keys []string, ch chan<- map[string]string) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			stat, err := os.Stat(f.Path)
+			if err != nil {
+				log.Warn("Failed to stat configuration file", "path", f.Path, "error", err)
+				continue
+			}
+			if !stat.ModTime().After(f.modTime) {
+				continue
+			}
+
+			reloaded, err := Load(f.Path, f.Profile, f.flags)
+			if err != nil {
+				log.Warn("Failed to reload configuration file", "path", f.Path, "error", err)
+				continue
+			}
+			f.modTime = reloaded.modTime
+
+			values := make(map[string]string)
+			for _, key := range keys {
+				if value, ok := reloaded.Values[key]; ok && !f.overridden[key] {
+					values[key] = value
+				}
+			}
+			if len(values) == 0 {
+				continue
+			}
+
+			log.Info("Configuration file reloaded", "path", f.Path, "values", values)
+
+			select {
+			case ch <- values:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// apply applies the given values to the environment variables of the corresponding flags, the flags which are
+// set in the given command line arguments or by existing environment variables are skipped, and returned.
+func apply(cmdFlags []cli.Flag, values map[string]string, args []string) (map[string]bool, error) {
+	overridden := make(map[string]bool)
+	for key, value := range values {
+		flag := lookupFlag(cmdFlags, key)
+
+		envFlag, ok := flag.(cli.DocGenerationFlag)
+		if !ok || len(envFlag.GetEnvVars()) == 0 {
+			return nil, fmt.Errorf("flag %s can not be set by configuration file", key)
+		}
+
+		if isSetInArgs(flag, args) || isSetInEnv(envFlag.GetEnvVars()) {
+			overridden[key] = true
+			continue
+		}
+
+		if err := os.Setenv(envFlag.GetEnvVars()[0], value); err != nil {
+			return nil, err
+		}
+	}
+
+	return overridden, nil
+}
+
+// presetValues returns the flag values of the given network preset.
+func presetValues(preset *config.ChainPreset) map[string]string {
+	values := make(map[string]string)
+	for key, address := range map[string]common.Address{
+		flags.TaikoL1Address.Name:              preset.Contracts.TaikoL1,
+		flags.TaikoL2Address.Name:              preset.Contracts.TaikoL2,
+		flags.TaikoTokenAddress.Name:           preset.Contracts.TaikoToken,
+		flags.TaikoWrapperAddress.Name:         preset.Contracts.TaikoWrapper,
+		flags.ForcedInclusionStoreAddress.Name: preset.Contracts.ForcedInclusionStore,
+		flags.ProverSetAddress.Name:            preset.Contracts.ProverSet,
+		flags.PreconfWhitelistAddress.Name:     preset.Contracts.PreconfWhitelist,
+	} {
+		if address != (common.Address{}) {
+			values[key] = address.Hex()
+		}
+	}
+
+	return values
+}
+
+// decode decodes the given YAML or TOML file into a map.
+func decode(path string) (map[string]interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := make(map[string]interface{})
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("failed to decode YAML file %s: %w", path, err)
+		}
+	case ".toml":
+		if err := toml.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("failed to decode TOML file %s: %w", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported configuration file format: %s", path)
+	}
+
+	return raw, nil
+}
+
+// flatten flattens the given nested map into the given values map, with dot separated keys.
+func flatten(prefix string, raw map[string]interface{}, values map[string]string) error {
+	for key, value := range raw {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if err := flatten(key, v, values); err != nil {
+				return err
+			}
+		case []interface{}:
+			items := make([]string, 0, len(v))
+			for _, item := range v {
+				items = append(items, fmt.Sprint(item))
+			}
+			values[key] = strings.Join(items, ",")
+		case nil:
+			return errors.New("empty value for key " + key)
+		default:
+			values[key] = fmt.Sprint(v)
+		}
+	}
+
+	return nil
+}
+
+// lookupFlag finds the flag with the given name or alias.
+func lookupFlag(cmdFlags []cli.Flag, name string) cli.Flag {
+	for _, flag := range cmdFlags {
+		for _, flagName := range flag.Names() {
+			if flagName == name {
+				return flag
+			}
+		}
+	}
+
+	return nil
+}
+
+// isSetInArgs checks whether the given flag is set in the command line arguments.
+func isSetInArgs(flag cli.Flag, args []string) bool {
+	for _, arg := range args {
+		trimmed := strings.TrimLeft(arg, "-")
+		if trimmed == arg {
+			continue
+		}
+		for _, name := range flag.Names() {
+			if trimmed == name || strings.HasPrefix(trimmed, name+"=") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isSetInEnv checks whether any of the given environment variables is set.
+func isSetInEnv(envVars []string) bool {
+	for _, envVar := range envVars {
+		if _, ok := os.LookupEnv(envVar); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,216 @@
+package configfile
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
+)
+
+var testYAML = `
+profile: hekla
+profiles:
+  hekla:
+    taikoL1: "0x79C9109b764609df928d16fC4a91e9081F7e87DB"
+    fork.pacaya: 1299888
+  devnet:
+    taikoL1: "0x0000000000000000000000000000000000000001"
+l1:
+  ws: ws://localhost:8546
+epoch.minTip: 0.01
+txPool.locals:
+  - "0x0000000000000000000000000000000000000002"
+  - "0x0000000000000000000000000000000000000003"
+`
+
+var testTOML = `
+profile = "devnet"
+"epoch.interval" = "12s"
+
+[profiles.devnet]
+taikoL1 = "0x0000000000000000000000000000000000000001"
+
+[l1]
+ws = "ws://localhost:8546"
+`
+
+func writeFile(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.Nil(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestLoadYAML(t *testing.T) {
+	file, err := Load(writeFile(t, "config.yaml", testYAML), "", flags.ProposerFlags)
+	require.Nil(t, err)
+	require.Equal(t, "hekla", file.Profile)
+	require.Equal(t, "0x79C9109b764609df928d16fC4a91e9081F7e87DB", file.Values[flags.TaikoL1Address.Name])
+	require.Equal(t, "1299888", file.Values[flags.PacayaForkHeight.Name])
+	require.Equal(t, "ws://localhost:8546", file.Values[flags.L1WSEndpoint.Name])
+	require.Equal(t, "0.01", file.Values[flags.MinTip.Name])
+	require.Equal(
+		t,
+		"0x0000000000000000000000000000000000000002,0x0000000000000000000000000000000000000003",
+		file.Values[flags.TxPoolLocals.Name],
+	)
+}
+
+func TestLoadProfileOverride(t *testing.T) {
+	file, err := Load(writeFile(t, "config.yml", testYAML), "devnet", flags.ProposerFlags)
+	require.Nil(t, err)
+	require.Equal(t, "devnet", file.Profile)
+	require.Equal(t, "0x0000000000000000000000000000000000000001", file.Values[flags.TaikoL1Address.Name])
+	require.Empty(t, file.Values[flags.PacayaForkHeight.Name])
+
+	_, err = Load(writeFile(t, "config.yml", testYAML), "mainnet", flags.ProposerFlags)
+	require.ErrorContains(t, err, "profile mainnet not found")
+}
+
+func TestLoadTOML(t *testing.T) {
+	file, err := Load(writeFile(t, "config.toml", testTOML), "", flags.ProposerFlags)
+	require.Nil(t, err)
+	require.Equal(t, "devnet", file.Profile)
+	require.Equal(t, "0x0000000000000000000000000000000000000001", file.Values[flags.TaikoL1Address.Name])
+	require.Equal(t, "ws://localhost:8546", file.Values[flags.L1WSEndpoint.Name])
+	require.Equal(t, "12s", file.Values[flags.ProposeInterval.Name])
+}
+
+func TestLoadUnknownKeys(t *testing.T) {
+	// `epoch.minTip` is a proposer flag, which is unknown to the driver.
+	_, err := Load(writeFile(t, "config.yaml", testYAML), "", flags.DriverFlags)
+	require.ErrorContains(t, err, "unknown keys")
+	require.ErrorContains(t, err, flags.MinTip.Name)
+
+	_, err = Load(writeFile(t, "config.json", "{}"), "", flags.DriverFlags)
+	require.ErrorContains(t, err, "unsupported configuration file format")
+}
+
+func TestBefore(t *testing.T) {
+	path := writeFile(t, "config.yaml", testYAML)
+	t.Setenv(flags.MinTip.EnvVars[0], "0.5")
+
+	var (
+		minTip   float64
+		l1WS     string
+		taikoL1  string
+		interval time.Duration
+	)
+	app := cli.NewApp()
+	app.Flags = flags.ConfigFileFlags
+	app.Before = Before
+	app.Commands = []*cli.Command{{
+		Name: "proposer",
+		Flags: []cli.Flag{
+			flags.L1WSEndpoint,
+			flags.TaikoL1Address,
+			flags.PacayaForkHeight,
+			flags.MinTip,
+			flags.ProposeInterval,
+			flags.TxPoolLocals,
+		},
+		Action: func(c *cli.Context) error {
+			minTip = c.Float64(flags.MinTip.Name)
+			l1WS = c.String(flags.L1WSEndpoint.Name)
+			taikoL1 = c.String(flags.TaikoL1Address.Name)
+			interval = c.Duration(flags.ProposeInterval.Name)
+			require.NotNil(t, FromContext(c))
+			return nil
+		},
+	}}
+
+	// Ensure that all environment variables set by the configuration file will be restored.
+	for _, envVar := range []string{
+		flags.L1WSEndpoint.EnvVars[0],
+		flags.TaikoL1Address.EnvVars[0],
+		flags.PacayaForkHeight.EnvVars[0],
+		flags.TxPoolLocals.EnvVars[0],
+	} {
+		t.Setenv(envVar, "")
+		require.Nil(t, os.Unsetenv(envVar))
+	}
+
+	require.Nil(t, app.Run([]string{
+		"TestBefore",
+		"--" + flags.ConfigFile.Name, path,
+		"proposer",
+		"--" + flags.L1WSEndpoint.Name, "ws://127.0.0.1:18546",
+	}))
+
+	// Command line flags and environment variables take precedence over the configuration file.
+	require.Equal(t, "ws://127.0.0.1:18546", l1WS)
+	require.Equal(t, 0.5, minTip)
+	require.Equal(t, "0x79C9109b764609df928d16fC4a91e9081F7e87DB", taikoL1)
+	require.Zero(t, interval)
+}
+
+func TestWatch(t *testing.T) {
+	path := writeFile(t, "config.toml", testTOML)
+	file, err := Load(path, "", flags.ProposerFlags)
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch := make(chan map[string]string, 1)
+	go file.Watch(ctx, 10*time.Millisecond, []string{flags.ProposeInterval.Name, flags.MinTip.Name}, ch)
+
+	require.Nil(t, os.WriteFile(path, []byte("\"epoch.minTip\" = 1.5\n"+testTOML), 0600))
+	require.Nil(t, os.Chtimes(path, time.Now().Add(time.Minute), time.Now().Add(time.Minute)))
+
+	select {
+	case values := <-ch:
+		require.Equal(t, map[string]string{
+			flags.ProposeInterval.Name: "12s",
+			flags.MinTip.Name:          "1.5",
+		}, values)
+	case <-time.After(5 * time.Second):
+		t.Fatal("configuration file reload timeout")
+	}
+}
+
+func TestBeforeNetworkPreset(t *testing.T) {
+	for _, envVar := range []string{
+		flags.TaikoL1Address.EnvVars[0],
+		flags.TaikoL1Address.EnvVars[1],
+		flags.TaikoTokenAddress.EnvVars[0],
+	} {
+		t.Setenv(envVar, "")
+		require.Nil(t, os.Unsetenv(envVar))
+	}
+
+	var taikoL1, taikoToken string
+	app := cli.NewApp()
+	app.Flags = flags.ConfigFileFlags
+	app.Before = Before
+	app.Commands = []*cli.Command{{
+		Name:  "driver",
+		Flags: []cli.Flag{flags.TaikoL1Address, flags.TaikoTokenAddress},
+		Action: func(c *cli.Context) error {
+			taikoL1 = c.String(flags.TaikoL1Address.Name)
+			taikoToken = c.String(flags.TaikoTokenAddress.Name)
+			require.Nil(t, FromContext(c))
+			return nil
+		},
+	}}
+
+	require.Nil(t, app.Run([]string{
+		"TestBeforeNetworkPreset",
+		"--" + flags.Network.Name, "hekla",
+		"driver",
+		"--" + flags.TaikoTokenAddress.Name, "0x0000000000000000000000000000000000000001",
+	}))
+	require.Equal(t, "0x79C9109b764609df928d16fC4a91e9081F7e87DB", taikoL1)
+	require.Equal(t, "0x0000000000000000000000000000000000000001", taikoToken)
+
+	require.ErrorContains(t, app.Run([]string{
+		"TestBeforeNetworkPreset",
+		"--" + flags.Network.Name, "unknown",
+		"driver",
+	}), "unknown network")
+}
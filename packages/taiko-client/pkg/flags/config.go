@@ -2,6 +2,7 @@ package flags
 
 import (
 	"crypto/ecdsa"
+	"fmt"
 
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
@@ -9,6 +10,7 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/bundle"
 )
 
 // InitTxmgrConfigsFromCli initializes the transaction manager configs from the command line flags.
@@ -29,3 +31,27 @@ func InitTxmgrConfigsFromCli(l1Endpoint string, privateKey *ecdsa.PrivateKey, c
 		TxNotInMempoolTimeout:     c.Duration(flags.TxNotInMempoolTimeout.Name),
 	}
 }
+
+// InitBundleConfigsFromCli initializes the bundle transaction manager configs from the command line flags,
+// returns nil if no block builder endpoint is given. Both the bundle and the private mempool transaction
+// managers would replace the public one, so they can not be set together.
+func InitBundleConfigsFromCli(c *cli.Context) (*bundle.Config, error) {
+	if len(c.StringSlice(flags.BundleBuilderEndpoints.Name)) == 0 {
+		return nil, nil
+	}
+
+	if c.String(flags.L1PrivateEndpoint.Name) != "" {
+		return nil, fmt.Errorf(
+			"--%s and --%s can not be set together",
+			flags.BundleBuilderEndpoints.Name,
+			flags.L1PrivateEndpoint.Name,
+		)
+	}
+
+	return &bundle.Config{
+		BuilderEndpoints: c.StringSlice(flags.BundleBuilderEndpoints.Name),
+		TargetBlocks:     c.Uint64(flags.BundleTargetBlocks.Name),
+		MaxMissedBlocks:  c.Uint64(flags.BundleMaxMissedBlocks.Name),
+		PollInterval:     c.Duration(flags.ReceiptQueryInterval.Name),
+	}, nil
+}
@@ -10,18 +10,14 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
-	"github.com/ethereum/go-ethereum/params"
 
 	ontakeBindings "github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/ontake"
 	pacayaBindings "github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/pacaya"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/config"
 )
 
 const (
-	defaultTimeout                = 1 * time.Minute
-	pacayaForkHeightDevnet        = 10
-	pacayaForkHeightHekla         = 1_299_888
-	pacayaForkHeklaMainnet        = 0
-	pacayaForkHeightPreconfDevnet = 0
+	defaultTimeout = 1 * time.Minute
 )
 
 // OntakeClients contains all smart contract clients for Ontake fork.
@@ -86,6 +82,7 @@ type ClientConfig struct {
 	L2EngineEndpoint              string
 	JwtSecret                     string
 	Timeout                       time.Duration
+	PacayaForkHeight              uint64
 }
 
 // NewClient initializes all RPC clients used by Taiko client software.
@@ -163,7 +160,7 @@ func NewClient(ctx context.Context, cfg *ClientConfig) (*Client, error) {
 	ctxWithTimeout, cancel := CtxWithTimeoutOrDefault(ctx, defaultTimeout)
 	defer cancel()
 	// Initialize the fork height numbers.
-	if err := c.initForkHeightConfigs(ctxWithTimeout); err != nil {
+	if err := c.initForkHeightConfigs(ctxWithTimeout, cfg.PacayaForkHeight); err != nil {
 		return nil, fmt.Errorf("failed to initialize fork height configs: %w", err)
 	}
 
@@ -328,8 +325,9 @@ func (c *Client) initPacayaClients(cfg *ClientConfig) error {
 	return nil
 }
 
-// initForkHeightConfigs initializes the fork heights in protocol.
-func (c *Client) initForkHeightConfigs(ctx context.Context) error {
+// initForkHeightConfigs initializes the fork heights in protocol, if the given Pacaya fork height is not zero,
+// it will be used instead of the one in chain registry before the Pacaya fork.
+func (c *Client) initForkHeightConfigs(ctx context.Context, pacayaForkHeight uint64) error {
 	protocolConfigs, err := c.PacayaClients.TaikoInbox.PacayaConfig(&bind.CallOpts{Context: ctx})
 	// If failed to get protocol configs, we are assuming the current chain is still before the Pacaya fork,
 	// use pre-defined Pacaya fork height.
@@ -338,16 +336,10 @@ func (c *Client) initForkHeightConfigs(ctx context.Context) error {
 			"Failed to get protocol configs, using pre-defined Pacaya fork height",
 			"error", err,
 		)
-		switch c.L2.ChainID.Uint64() {
-		case params.HeklaNetworkID.Uint64():
-			c.PacayaClients.ForkHeight = pacayaForkHeightHekla
-		case params.TaikoMainnetNetworkID.Uint64():
-			c.PacayaClients.ForkHeight = pacayaForkHeklaMainnet
-		case params.PreconfDevnetNetworkID.Uint64():
-			c.PacayaClients.ForkHeight = pacayaForkHeightPreconfDevnet
-		default:
-			log.Debug("Using devnet Pacaya fork height", "height", pacayaForkHeightDevnet)
-			c.PacayaClients.ForkHeight = pacayaForkHeightDevnet
+		if pacayaForkHeight != 0 {
+			c.PacayaClients.ForkHeight = pacayaForkHeight
+		} else {
+			c.PacayaClients.ForkHeight = config.Chains.PacayaForkHeight(c.L2.ChainID.Uint64())
 		}
 
 		log.Info(
@@ -417,6 +417,15 @@ func (c *EthClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
 	return c.ethClient.SuggestGasTipCap(ctxWithTimeout)
 }
 
+// BlobBaseFee retrieves the blob base fee of the next block, as computed by the node for its
+// current fork.
+func (c *EthClient) BlobBaseFee(ctx context.Context) (*big.Int, error) {
+	ctxWithTimeout, cancel := CtxWithTimeoutOrDefault(ctx, c.timeout)
+	defer cancel()
+
+	return c.ethClient.BlobBaseFee(ctxWithTimeout)
+}
+
 // FeeHistory retrieves the fee market history.
 func (c *EthClient) FeeHistory(
 	ctx context.Context,
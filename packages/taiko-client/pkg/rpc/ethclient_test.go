@@ -156,6 +156,13 @@ func TestSuggestGasTipCap(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestBlobBaseFee(t *testing.T) {
+	client := newTestClientWithTimeout(t)
+
+	_, err := client.L1.BlobBaseFee(context.Background())
+	require.Nil(t, err)
+}
+
 func TestFeeHistory(t *testing.T) {
 	client := newTestClientWithTimeout(t)
 
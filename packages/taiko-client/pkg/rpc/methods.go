@@ -87,6 +87,18 @@ func (c *Client) ensureGenesisMatched(ctx context.Context) error {
 		return err
 	}
 
+	// Node's genesis header must match the one in chain registry, if it's given.
+	if preset, ok := config.Chains.Get(c.L2.ChainID.Uint64()); ok &&
+		preset.GenesisHash != (common.Hash{}) &&
+		preset.GenesisHash != nodeGenesis.Hash() {
+		return fmt.Errorf(
+			"genesis header hash mismatch, node: %s, chain registry (%s): %s",
+			nodeGenesis.Hash(),
+			preset.Name,
+			preset.GenesisHash,
+		)
+	}
+
 	var (
 		l2GenesisHash common.Hash
 		filterOpts    = &bind.FilterOpts{
@@ -12,6 +12,8 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/bundle"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/configfile"
 	pkgFlags "github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/flags"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/jwt"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
@@ -29,6 +31,10 @@ type Config struct {
 	MinTip                     uint64
 	MinProposingInternal       time.Duration
 	AllowZeroTipInterval       uint64
+	MaxL1BaseFee               uint64
+	MaxL1BlobBaseFee           uint64
+	MaxFeeDeferral             time.Duration
+	FeeHistoryBlocks           uint64
 	MaxProposedTxListsPerEpoch uint64
 	ProposeBlockTxGasLimit     uint64
 	BlobAllowed                bool
@@ -36,6 +42,8 @@ type Config struct {
 	RevertProtectionEnabled    bool
 	TxmgrConfigs               *txmgr.CLIConfig
 	PrivateTxmgrConfigs        *txmgr.CLIConfig
+	BundleConfigs              *bundle.Config
+	ConfigFile                 *configfile.File
 }
 
 // NewConfigFromCliContext initializes a Config instance from
@@ -51,6 +59,11 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, fmt.Errorf("invalid L1 proposer private key: %w", err)
 	}
 
+	bundleConfigs, err := pkgFlags.InitBundleConfigsFromCli(c)
+	if err != nil {
+		return nil, err
+	}
+
 	l2SuggestedFeeRecipient := c.String(flags.L2SuggestedFeeRecipient.Name)
 	if !common.IsHexAddress(l2SuggestedFeeRecipient) {
 		return nil, fmt.Errorf("invalid L2 suggested fee recipient address: %s", l2SuggestedFeeRecipient)
@@ -71,6 +84,20 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, err
 	}
 
+	maxL1BaseFee, err := utils.GWeiToWei(c.Float64(flags.MaxL1BaseFee.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	maxL1BlobBaseFee, err := utils.GWeiToWei(c.Float64(flags.MaxL1BlobBaseFee.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Duration(flags.MaxFeeDeferral.Name) > 0 && c.Uint64(flags.FeeHistoryBlocks.Name) == 0 {
+		return nil, fmt.Errorf("--%s must be greater than 0 when proposing deferral is enabled", flags.FeeHistoryBlocks.Name)
+	}
+
 	maxProposedTxListsPerEpoch := c.Uint64(flags.MaxProposedTxListsPerEpoch.Name)
 	if maxProposedTxListsPerEpoch > rpc.MaxBlobNums {
 		return nil, fmt.Errorf("max proposed tx lists per epoch should not exceed %d, got: %d",
@@ -92,6 +119,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 			TaikoTokenAddress:           common.HexToAddress(c.String(flags.TaikoTokenAddress.Name)),
 			Timeout:                     c.Duration(flags.RPCTimeout.Name),
 			ProverSetAddress:            common.HexToAddress(c.String(flags.ProverSetAddress.Name)),
+			PacayaForkHeight:            c.Uint64(flags.PacayaForkHeight.Name),
 		},
 		L1ProposerPrivKey:          l1ProposerPrivKey,
 		L2SuggestedFeeRecipient:    common.HexToAddress(l2SuggestedFeeRecipient),
@@ -102,6 +130,10 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		MinProposingInternal:       c.Duration(flags.MinProposingInternal.Name),
 		MaxProposedTxListsPerEpoch: maxProposedTxListsPerEpoch,
 		AllowZeroTipInterval:       c.Uint64(flags.AllowZeroTipInterval.Name),
+		MaxL1BaseFee:               maxL1BaseFee.Uint64(),
+		MaxL1BlobBaseFee:           maxL1BlobBaseFee.Uint64(),
+		MaxFeeDeferral:             c.Duration(flags.MaxFeeDeferral.Name),
+		FeeHistoryBlocks:           c.Uint64(flags.FeeHistoryBlocks.Name),
 		ProposeBlockTxGasLimit:     c.Uint64(flags.TxGasLimit.Name),
 		BlobAllowed:                c.Bool(flags.BlobAllowed.Name),
 		FallbackToCalldata:         c.Bool(flags.FallbackToCalldata.Name),
@@ -116,5 +148,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 			l1ProposerPrivKey,
 			c,
 		),
+		BundleConfigs: bundleConfigs,
+		ConfigFile:    configfile.FromContext(c),
 	}, nil
 }
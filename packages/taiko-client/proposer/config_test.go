@@ -101,10 +101,22 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContextTxPoolLocalsErr() {
 	}), "invalid account in --txpool.locals")
 }
 
+func (s *ProposerTestSuite) TestNewConfigFromCliContextBundleAndPrivateMempoolErr() {
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextBundleAndPrivateMempoolErr",
+		"--" + flags.L1ProposerPrivKey.Name, encoding.GoldenTouchPrivKey,
+		"--" + flags.L1PrivateEndpoint.Name, l1Endpoint,
+		"--" + flags.BundleBuilderEndpoints.Name, l1Endpoint,
+	}), "can not be set together")
+}
+
 func (s *ProposerTestSuite) SetupApp() *cli.App {
 	app := cli.NewApp()
 	app.Flags = []cli.Flag{
 		&cli.StringFlag{Name: flags.L1WSEndpoint.Name},
+		&cli.StringFlag{Name: flags.L1PrivateEndpoint.Name},
 		&cli.StringFlag{Name: flags.L2HTTPEndpoint.Name},
 		&cli.StringFlag{Name: flags.TaikoL1Address.Name},
 		&cli.StringFlag{Name: flags.TaikoL2Address.Name},
@@ -0,0 +1,204 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/utils"
+)
+
+// l1Fees contains the L1 fees which will be paid by a proposing transaction.
+type l1Fees struct {
+	baseFee     *big.Int
+	blobBaseFee *big.Int
+}
+
+// feeScheduler decides whether proposing should be deferred because of the L1 fees. There are two
+// strategies, the hard ceilings of L1 base fee and blob base fee, and the deferral which waits for
+// cheaper blocks when the L1 base fee is above the median of recent blocks, for at most maxDeferral.
+type feeScheduler struct {
+	maxBaseFee     uint64
+	maxBlobBaseFee uint64
+	maxDeferral    time.Duration
+
+	// Fees when the current deferral started, and when the deferral ended.
+	deferredSince time.Time
+	deferredFees  *l1Fees
+	proposingFees *l1Fees
+}
+
+// newFeeScheduler creates a new feeScheduler instance.
+func newFeeScheduler(maxBaseFee, maxBlobBaseFee uint64, maxDeferral time.Duration) *feeScheduler {
+	return &feeScheduler{
+		maxBaseFee:     maxBaseFee,
+		maxBlobBaseFee: maxBlobBaseFee,
+		maxDeferral:    maxDeferral,
+	}
+}
+
+// enabled returns whether any fee scheduling strategy is enabled.
+func (s *feeScheduler) enabled() bool {
+	return s.maxBaseFee != 0 || s.maxBlobBaseFee != 0 || s.maxDeferral != 0
+}
+
+// shouldDefer checks whether proposing should be deferred with the given L1 fees, medianBaseFee is the median
+// base fee of recent L1 blocks. If mustPropose is true, i.e. the oldest forced inclusion is due or the minimum
+// proposing interval has been reached, proposing will never be deferred.
+func (s *feeScheduler) shouldDefer(
+	now time.Time,
+	fees *l1Fees,
+	medianBaseFee *big.Int,
+	mustPropose bool,
+) (bool, string) {
+	var reason string
+	switch {
+	case mustPropose:
+	case s.maxBaseFee != 0 && fees.baseFee.Cmp(new(big.Int).SetUint64(s.maxBaseFee)) > 0:
+		reason = "base fee above ceiling"
+	case s.maxBlobBaseFee != 0 &&
+		fees.blobBaseFee != nil &&
+		fees.blobBaseFee.Cmp(new(big.Int).SetUint64(s.maxBlobBaseFee)) > 0:
+		reason = "blob base fee above ceiling"
+	case s.maxDeferral != 0 &&
+		medianBaseFee != nil &&
+		fees.baseFee.Cmp(medianBaseFee) > 0 &&
+		(s.deferredSince.IsZero() || now.Sub(s.deferredSince) < s.maxDeferral):
+		reason = "base fee above recent median"
+	}
+
+	if reason == "" {
+		s.proposingFees = fees
+		return false, ""
+	}
+
+	if s.deferredSince.IsZero() {
+		s.deferredSince = now
+		s.deferredFees = fees
+	}
+
+	return true, reason
+}
+
+// recordProposed records the deferral metrics after a proposing transaction is included, the cost saved is
+// estimated by the fees difference between the start and the end of the deferral.
+func (s *feeScheduler) recordProposed(now time.Time, receipt *types.Receipt) {
+	if s.deferredSince.IsZero() {
+		return
+	}
+	defer func() {
+		s.deferredSince = time.Time{}
+		s.deferredFees = nil
+		s.proposingFees = nil
+	}()
+
+	deferred := now.Sub(s.deferredSince)
+	metrics.ProposerFeeDeferredTime.Set(deferred.Seconds())
+
+	if s.deferredFees == nil || s.proposingFees == nil {
+		return
+	}
+	saved := estimateCostSaved(s.deferredFees, s.proposingFees, receipt.GasUsed, receipt.BlobGasUsed)
+	savedGWei, _ := utils.WeiToGWei(saved).Float64()
+	metrics.ProposerFeeDeferralCostSaved.Set(savedGWei)
+
+	log.Info("Proposed after fee deferral", "deferred", deferred, "costSavedGWei", savedGWei)
+}
+
+// estimateCostSaved estimates the cost saved (could be negative) by proposing with the given
+// proposing fees, instead of the given deferred fees.
+func estimateCostSaved(deferredFees, proposingFees *l1Fees, gasUsed, blobGasUsed uint64) *big.Int {
+	saved := new(big.Int).Mul(
+		new(big.Int).Sub(deferredFees.baseFee, proposingFees.baseFee),
+		new(big.Int).SetUint64(gasUsed),
+	)
+	if deferredFees.blobBaseFee != nil && proposingFees.blobBaseFee != nil {
+		saved.Add(saved, new(big.Int).Mul(
+			new(big.Int).Sub(deferredFees.blobBaseFee, proposingFees.blobBaseFee),
+			new(big.Int).SetUint64(blobGasUsed),
+		))
+	}
+
+	return saved
+}
+
+// median returns the median of the given values.
+func median(values []*big.Int) *big.Int {
+	if len(values) == 0 {
+		return nil
+	}
+
+	sorted := make([]*big.Int, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Cmp(sorted[j]) < 0 })
+
+	if len(sorted)%2 == 1 {
+		return sorted[len(sorted)/2]
+	}
+	sum := new(big.Int).Add(sorted[len(sorted)/2-1], sorted[len(sorted)/2])
+	return sum.Div(sum, common.Big2)
+}
+
+// deferProposing checks whether proposing should be deferred because of the current L1 fees.
+func (p *Proposer) deferProposing(ctx context.Context, l2Head uint64, minProposingIntervalReached bool) (bool, error) {
+	if !p.feeScheduler.enabled() {
+		return false, nil
+	}
+
+	feeHistory, err := p.rpc.L1.FeeHistory(ctx, p.FeeHistoryBlocks, nil, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch L1 fee history: %w", err)
+	}
+	if len(feeHistory.BaseFee) == 0 {
+		return false, errors.New("empty L1 fee history")
+	}
+
+	// The last base fee in fee history is the base fee of the next block.
+	fees := &l1Fees{baseFee: feeHistory.BaseFee[len(feeHistory.BaseFee)-1]}
+	if p.BlobAllowed {
+		// The node computes the blob base fee with the update fraction of its current fork.
+		if fees.blobBaseFee, err = p.rpc.L1.BlobBaseFee(ctx); err != nil {
+			return false, fmt.Errorf("failed to fetch L1 blob base fee: %w", err)
+		}
+	}
+
+	mustPropose := p.MinProposingInternal != 0 && minProposingIntervalReached
+	if !mustPropose &&
+		p.rpc.PacayaClients.ForcedInclusionStore != nil &&
+		p.chainConfig.IsPacaya(new(big.Int).SetUint64(l2Head+1)) {
+		if mustPropose, err = p.rpc.PacayaClients.ForcedInclusionStore.IsOldestForcedInclusionDue(
+			&bind.CallOpts{Context: ctx},
+		); err != nil {
+			return false, fmt.Errorf("failed to check forced inclusion deadline: %w", encoding.TryParsingCustomError(err))
+		}
+	}
+
+	deferred, reason := p.feeScheduler.shouldDefer(
+		time.Now(),
+		fees,
+		median(feeHistory.BaseFee[:len(feeHistory.BaseFee)-1]),
+		mustPropose,
+	)
+	if deferred {
+		metrics.ProposerFeeDeferredCounter.Add(1)
+		log.Info(
+			"Defer proposing because of L1 fees",
+			"reason", reason,
+			"baseFee", fees.baseFee,
+			"blobBaseFee", fees.blobBaseFee,
+			"deferredSince", p.feeScheduler.deferredSince,
+		)
+	}
+
+	return deferred, nil
+}
@@ -0,0 +1,76 @@
+package proposer
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeSchedulerCeilings(t *testing.T) {
+	var (
+		s   = newFeeScheduler(100, 10, 0)
+		now = time.Now()
+	)
+	require.True(t, s.enabled())
+
+	deferred, reason := s.shouldDefer(now, &l1Fees{baseFee: big.NewInt(101), blobBaseFee: big.NewInt(1)}, nil, false)
+	require.True(t, deferred)
+	require.Equal(t, "base fee above ceiling", reason)
+
+	deferred, reason = s.shouldDefer(now, &l1Fees{baseFee: big.NewInt(100), blobBaseFee: big.NewInt(11)}, nil, false)
+	require.True(t, deferred)
+	require.Equal(t, "blob base fee above ceiling", reason)
+
+	// Forced inclusion is due or minimum proposing interval is reached.
+	deferred, _ = s.shouldDefer(now, &l1Fees{baseFee: big.NewInt(101), blobBaseFee: big.NewInt(11)}, nil, true)
+	require.False(t, deferred)
+
+	deferred, _ = s.shouldDefer(now, &l1Fees{baseFee: big.NewInt(100), blobBaseFee: big.NewInt(10)}, nil, false)
+	require.False(t, deferred)
+}
+
+func TestFeeSchedulerDeferral(t *testing.T) {
+	var (
+		s         = newFeeScheduler(0, 0, time.Minute)
+		now       = time.Now()
+		medianFee = median([]*big.Int{big.NewInt(30), big.NewInt(10), big.NewInt(20)})
+	)
+	require.Equal(t, int64(20), medianFee.Int64())
+
+	deferred, reason := s.shouldDefer(now, &l1Fees{baseFee: big.NewInt(25)}, medianFee, false)
+	require.True(t, deferred)
+	require.Equal(t, "base fee above recent median", reason)
+	require.Equal(t, now, s.deferredSince)
+
+	// Keep deferring until the max deferral is reached.
+	deferred, _ = s.shouldDefer(now.Add(30*time.Second), &l1Fees{baseFee: big.NewInt(25)}, medianFee, false)
+	require.True(t, deferred)
+	require.Equal(t, now, s.deferredSince)
+
+	deferred, _ = s.shouldDefer(now.Add(time.Minute), &l1Fees{baseFee: big.NewInt(22)}, medianFee, false)
+	require.False(t, deferred)
+
+	s.recordProposed(now.Add(time.Minute), &types.Receipt{GasUsed: 100})
+	require.True(t, s.deferredSince.IsZero())
+	require.Nil(t, s.deferredFees)
+}
+
+func TestEstimateCostSaved(t *testing.T) {
+	require.Equal(t, int64(300+2000), estimateCostSaved(
+		&l1Fees{baseFee: big.NewInt(10), blobBaseFee: big.NewInt(5)},
+		&l1Fees{baseFee: big.NewInt(7), blobBaseFee: big.NewInt(3)},
+		100,
+		1000,
+	).Int64())
+	require.Equal(t, int64(-100), estimateCostSaved(
+		&l1Fees{baseFee: big.NewInt(7)},
+		&l1Fees{baseFee: big.NewInt(8), blobBaseFee: big.NewInt(3)},
+		100,
+		1000,
+	).Int64())
+	require.Equal(t, int64(15), median([]*big.Int{big.NewInt(10), big.NewInt(20)}).Int64())
+	require.Nil(t, median(nil))
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
+	"strconv"
 	"sync"
 	"time"
 
@@ -19,8 +20,10 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/testutils"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/bundle"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/config"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/utils"
@@ -28,6 +31,17 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_submitter/transaction"
 )
 
+var (
+	configFileWatchInterval = 10 * time.Second
+	// reloadableConfigKeys are the configuration file keys which can be hot-reloaded.
+	reloadableConfigKeys = []string{
+		flags.MinTip.Name,
+		flags.ProposeInterval.Name,
+		flags.MinProposingInternal.Name,
+		flags.AllowZeroTipInterval.Name,
+	}
+)
+
 // Proposer keep proposing new transactions from L2 execution engine's tx pool at a fixed interval.
 type Proposer struct {
 	// configurations
@@ -54,6 +68,12 @@ type Proposer struct {
 
 	txmgrSelector *utils.TxMgrSelector
 
+	// L1 fees based proposing scheduler
+	feeScheduler *feeScheduler
+
+	// Hot-reloaded configurations from the configuration file
+	configReloadCh chan map[string]string
+
 	ctx context.Context
 	wg  sync.WaitGroup
 }
@@ -78,6 +98,13 @@ func (p *Proposer) InitFromConfig(
 	p.ctx = ctx
 	p.Config = cfg
 	p.lastProposedAt = time.Now()
+	p.configReloadCh = make(chan map[string]string, 1)
+
+	var maxL1BlobBaseFee uint64
+	if cfg.BlobAllowed {
+		maxL1BlobBaseFee = cfg.MaxL1BlobBaseFee
+	}
+	p.feeScheduler = newFeeScheduler(cfg.MaxL1BaseFee, maxL1BlobBaseFee, cfg.MaxFeeDeferral)
 
 	// RPC clients
 	if p.rpc, err = rpc.NewClient(p.ctx, cfg.ClientConfig); err != nil {
@@ -101,18 +128,42 @@ func (p *Proposer) InitFromConfig(
 		}
 	}
 
-	if privateTxMgr == nil && cfg.PrivateTxmgrConfigs != nil && len(cfg.PrivateTxmgrConfigs.L1RPCURL) > 0 {
-		if privateTxMgr, err = txmgr.NewSimpleTxManager(
-			"privateMempoolProposer",
-			log.Root(),
-			&metrics.TxMgrMetrics,
-			*cfg.PrivateTxmgrConfigs,
-		); err != nil {
-			return err
+	// the given private transaction manager takes precedence over the bundle one, which
+	// NewConfigFromCliContext never configures together with a private mempool.
+	var (
+		selectedTxMgr        txmgr.TxManager = txMgr
+		selectedPrivateTxMgr txmgr.TxManager = privateTxMgr
+	)
+
+	if privateTxMgr == nil {
+		if cfg.BundleConfigs != nil {
+			bundleTxMgr, err := bundle.NewTxManager(
+				p.ctx,
+				cfg.BundleConfigs,
+				p.rpc.L1,
+				p.rpc.L1.ChainID,
+				cfg.L1ProposerPrivKey,
+				txMgr,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to initialize bundle transaction manager: %w", err)
+			}
+
+			// the public transactions share the nonce space of the bundles.
+			selectedTxMgr, selectedPrivateTxMgr = bundleTxMgr.FallbackTxMgr(), bundleTxMgr
+		} else if cfg.PrivateTxmgrConfigs != nil && len(cfg.PrivateTxmgrConfigs.L1RPCURL) > 0 {
+			if selectedPrivateTxMgr, err = txmgr.NewSimpleTxManager(
+				"privateMempoolProposer",
+				log.Root(),
+				&metrics.TxMgrMetrics,
+				*cfg.PrivateTxmgrConfigs,
+			); err != nil {
+				return err
+			}
 		}
 	}
 
-	p.txmgrSelector = utils.NewTxMgrSelector(txMgr, privateTxMgr, nil)
+	p.txmgrSelector = utils.NewTxMgrSelector(selectedTxMgr, selectedPrivateTxMgr, nil)
 	p.chainConfig = config.NewChainConfig(
 		p.rpc.L2.ChainID,
 		p.rpc.OntakeClients.ForkHeight,
@@ -140,6 +191,15 @@ func (p *Proposer) InitFromConfig(
 func (p *Proposer) Start() error {
 	p.wg.Add(1)
 	go p.eventLoop()
+
+	if p.ConfigFile != nil {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.ConfigFile.Watch(p.ctx, configFileWatchInterval, reloadableConfigKeys, p.configReloadCh)
+		}()
+	}
+
 	return nil
 }
 
@@ -156,6 +216,9 @@ func (p *Proposer) eventLoop() {
 		select {
 		case <-p.ctx.Done():
 			return
+		// configuration file has been changed
+		case values := <-p.configReloadCh:
+			p.reloadConfig(values)
 		// proposing interval timer has been reached
 		case <-p.proposingTimer.C:
 			metrics.ProposerProposeEpochCounter.Add(1)
@@ -314,6 +377,15 @@ func (p *Proposer) ProposeOp(ctx context.Context) error {
 		return nil
 	}
 
+	// Check whether proposing should be deferred because of the L1 fees.
+	deferred, err := p.deferProposing(ctx, l2Head, allowEmptyPoolContent)
+	if err != nil {
+		return err
+	}
+	if deferred {
+		return nil
+	}
+
 	// Propose the transactions lists.
 	return p.ProposeTxLists(ctx, txLists, l2Head, parentMetaHash)
 }
@@ -516,6 +588,37 @@ func (p *Proposer) updateProposingTicker() {
 	p.proposingTimer = time.NewTimer(duration)
 }
 
+// reloadConfig updates the hot-reloadable configurations with the given configuration file values.
+func (p *Proposer) reloadConfig(values map[string]string) {
+	for key, value := range values {
+		var err error
+		switch key {
+		case flags.MinTip.Name:
+			var minTip float64
+			if minTip, err = strconv.ParseFloat(value, 64); err != nil {
+				break
+			}
+			var minTipWei *big.Int
+			if minTipWei, err = utils.GWeiToWei(minTip); err != nil {
+				break
+			}
+			p.MinTip = minTipWei.Uint64()
+		case flags.ProposeInterval.Name:
+			p.ProposeInterval, err = time.ParseDuration(value)
+		case flags.MinProposingInternal.Name:
+			p.MinProposingInternal, err = time.ParseDuration(value)
+		case flags.AllowZeroTipInterval.Name:
+			p.AllowZeroTipInterval, err = strconv.ParseUint(value, 10, 64)
+		}
+		if err != nil {
+			log.Warn("Invalid reloaded configuration value", "key", key, "value", value, "error", err)
+			continue
+		}
+
+		log.Info("Proposer configuration reloaded", "key", key, "value", value)
+	}
+}
+
 // SendTx is the function to send a transaction with a selected tx manager.
 func (p *Proposer) SendTx(ctx context.Context, txCandidate *txmgr.TxCandidate) error {
 	txMgr, isPrivate := p.txmgrSelector.Select()
@@ -535,6 +638,9 @@ func (p *Proposer) SendTx(ctx context.Context, txCandidate *txmgr.TxCandidate) e
 	if receipt.Status != types.ReceiptStatusSuccessful {
 		return fmt.Errorf("failed to propose block: %s", receipt.TxHash.Hex())
 	}
+
+	p.feeScheduler.recordProposed(time.Now(), receipt)
+
 	return nil
 }
 
@@ -14,6 +14,7 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/bundle"
 	pkgFlags "github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/flags"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/jwt"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/utils"
@@ -56,9 +57,11 @@ type Config struct {
 	BlockConfirmations                      uint64
 	TxmgrConfigs                            *txmgr.CLIConfig
 	PrivateTxmgrConfigs                     *txmgr.CLIConfig
+	BundleConfigs                           *bundle.Config
 	SGXProofBufferSize                      uint64
 	ZKVMProofBufferSize                     uint64
 	ForceBatchProvingInterval               time.Duration
+	PacayaForkHeight                        uint64
 }
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
@@ -71,6 +74,11 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, fmt.Errorf("invalid L1 prover private key: %w", err)
 	}
 
+	bundleConfigs, err := pkgFlags.InitBundleConfigsFromCli(c)
+	if err != nil {
+		return nil, err
+	}
+
 	var startingBlockID *big.Int
 	if c.IsSet(flags.StartingBlockID.Name) {
 		startingBlockID = new(big.Int).SetUint64(c.Uint64(flags.StartingBlockID.Name))
@@ -167,8 +175,10 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 			l1ProverPrivKey,
 			c,
 		),
+		BundleConfigs:             bundleConfigs,
 		SGXProofBufferSize:        c.Uint64(flags.SGXBatchSize.Name),
 		ZKVMProofBufferSize:       c.Uint64(flags.ZKVMBatchSize.Name),
 		ForceBatchProvingInterval: c.Duration(flags.ForceBatchProvingInterval.Name),
+		PacayaForkHeight:          c.Uint64(flags.PacayaForkHeight.Name),
 	}, nil
 }
@@ -24,6 +24,7 @@ import (
 	pacayaBindings "github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/pacaya"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/version"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/bundle"
 	eventIterator "github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/chain_iterator/event_iterator"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/config"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
@@ -130,6 +131,7 @@ func InitFromConfig(
 		GuardianProverMinorityAddress: cfg.GuardianProverMinorityAddress,
 		GuardianProverMajorityAddress: cfg.GuardianProverMajorityAddress,
 		Timeout:                       cfg.RPCTimeout,
+		PacayaForkHeight:              cfg.PacayaForkHeight,
 	}); err != nil {
 		return err
 	}
@@ -181,10 +183,27 @@ func InitFromConfig(
 		}
 	}
 
+	// the given private transaction manager takes precedence over the bundle one, which
+	// NewConfigFromCliContext never configures together with a private mempool.
 	if privateTxMgr != nil {
 		p.privateTxmgr = privateTxMgr
 	} else {
-		if cfg.PrivateTxmgrConfigs != nil && len(cfg.PrivateTxmgrConfigs.L1RPCURL) > 0 {
+		if cfg.BundleConfigs != nil {
+			bundleTxMgr, err := bundle.NewTxManager(
+				p.ctx,
+				cfg.BundleConfigs,
+				p.rpc.L1,
+				p.rpc.L1.ChainID,
+				cfg.L1ProverPrivKey,
+				p.txmgr,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to initialize bundle transaction manager: %w", err)
+			}
+
+			// the public transactions share the nonce space of the bundles.
+			p.txmgr, p.privateTxmgr = bundleTxMgr.FallbackTxMgr(), bundleTxMgr
+		} else if cfg.PrivateTxmgrConfigs != nil && len(cfg.PrivateTxmgrConfigs.L1RPCURL) > 0 {
 			if p.privateTxmgr, err = txmgr.NewSimpleTxManager(
 				"privateMempoolProver",
 				log.Root(),